@@ -3,8 +3,10 @@ package main
 import (
 	"clipet/internal/game"
 	"clipet/internal/plugin"
+	"clipet/internal/store"
 	"clipet/internal/tui/dev"
 	"fmt"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
 	"github.com/spf13/cobra"
@@ -64,13 +66,14 @@ func doEvolve(pet *game.Pet, toStageID string) error {
 	pet.Stage = game.PetStage(stage.Phase)
 
 	// Reset accumulators for the new stage
-	pet.AccHappiness = 0
-	pet.AccHealth = 0
-	pet.AccPlayful = 0
+	for _, acc := range []string{game.AccHappiness, game.AccHealth, game.AccPlayful} {
+		delete(pet.CustomAttributes, acc)
+	}
 
 	if err := petStore.Save(pet); err != nil {
 		return fmt.Errorf("save: %w", err)
 	}
+	_ = store.History(petStore, store.SourceDev).RecordEvolution(time.Now(), pet, oldID, stage.ID)
 
 	fmt.Printf("evolve: %s -> %s (%s -> %s)\n", oldID, stage.ID, oldPhase, stage.Phase)
 	return nil
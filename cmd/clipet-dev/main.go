@@ -11,16 +11,18 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
 var (
-	registry         *plugin.Registry
-	capabilitiesReg  *capabilities.Registry
-	petStore         *store.JSONStore
-	packDir          string
-	i18nMgr          *i18n.Manager
+	registry        *plugin.Registry
+	capabilitiesReg *capabilities.Registry
+	petStore        store.Store
+	packDir         string
+	profileName     string
+	i18nMgr         *i18n.Manager
 )
 
 func main() {
@@ -45,12 +47,14 @@ func main() {
 	}
 
 	root.PersistentFlags().StringVar(&packDir, "pack-dir", "", "load species pack from directory")
+	root.PersistentFlags().StringVar(&profileName, "profile", "", "save profile to operate on (default: active profile)")
 
 	root.AddCommand(newTimeskipCmd())
 	root.AddCommand(newSetCmd())
 	root.AddCommand(newEvoCmd())
 	root.AddCommand(newValidateCmd())
 	root.AddCommand(newPreviewCmd())
+	root.AddCommand(newMigrateCmd())
 
 	if err := root.Execute(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -87,6 +91,11 @@ func setup() error {
 	if err := registry.LoadFromFS(assets.BuiltinFS, "builtins", plugin.SourceBuiltin); err != nil {
 		return fmt.Errorf("load builtins: %w", err)
 	}
+	achievements, err := plugin.ParseAchievements(assets.AchievementsFS, ".")
+	if err != nil {
+		return fmt.Errorf("load builtin achievements: %w", err)
+	}
+	registry.SetBuiltinAchievements(achievements)
 
 	home, err := os.UserHomeDir()
 	if err == nil {
@@ -118,7 +127,23 @@ func setup() error {
 	// Initialize time system with registries
 	game.InitTimeSystem(registry, capabilitiesReg)
 
-	petStore, err = store.NewJSONStore("")
+	profiles, err := store.NewProfileManager("")
+	if err != nil {
+		return fmt.Errorf("init profiles: %w", err)
+	}
+	backend := store.BackendJSON
+	if cfg, err := config.Load(); err == nil {
+		if profileName == "" {
+			profileName = cfg.ActiveProfile
+		}
+		if cfg.StoreBackend != "" {
+			backend = store.Backend(cfg.StoreBackend)
+		}
+	}
+	if profileName == "" {
+		profileName = store.DefaultProfile
+	}
+	petStore, err = profiles.Open(profileName, backend)
 	if err != nil {
 		return fmt.Errorf("init store: %w", err)
 	}
@@ -150,3 +175,11 @@ func loadPet() (*game.Pet, error) {
 
 	return pet, nil
 }
+
+// recordEdit journals a dev tool field change.
+func recordEdit(pet *game.Pet, field, oldValue, newValue string) {
+	e := store.NewEvent(time.Now(), store.EventEdit, store.SourceDev, pet)
+	e.Subject = field
+	e.Detail = oldValue + " -> " + newValue
+	_ = store.JournalFor(petStore).Append(e)
+}
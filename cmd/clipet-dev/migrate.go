@@ -0,0 +1,76 @@
+package main
+
+import (
+	"clipet/internal/store"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func newMigrateCmd() *cobra.Command {
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "[开发] 升级存档结构版本",
+		Long: `将当前存档升级到最新的 schema 版本。
+
+升级前会自动备份原存档（JSON 存档为 save.json.v<旧版本>.bak，SQLite 存档写入 pet_backups 表）。
+使用 --dry-run 只显示迁移步骤和字段差异，不修改任何文件。`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requirePet(); err != nil {
+				return err
+			}
+
+			migrator, ok := petStore.(store.Migrator)
+			if !ok {
+				return fmt.Errorf("store %s does not support migration", petStore.Path())
+			}
+			res, err := migrator.Migrate(dryRun)
+			if err != nil {
+				return err
+			}
+
+			printMigration(res, dryRun)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "show the migration diff without writing")
+	return cmd
+}
+
+func printMigration(res *store.MigrationResult, dryRun bool) {
+	if !res.Upgraded() {
+		fmt.Printf("save is up to date (schema v%d)\n", res.FromVersion)
+		return
+	}
+
+	fmt.Printf("schema v%d -> v%d\n", res.FromVersion, res.ToVersion)
+	for _, m := range res.Applied {
+		fmt.Printf("  v%d -> v%d: %s\n", m.From, m.From+1, m.Description)
+	}
+
+	fmt.Println()
+	for _, d := range res.Diff() {
+		switch {
+		case d.Before == nil:
+			fmt.Printf("+ %s: %v\n", d.Key, d.After)
+		case d.After == nil:
+			fmt.Printf("- %s: %v\n", d.Key, d.Before)
+		default:
+			fmt.Printf("~ %s: %v -> %v\n", d.Key, d.Before, d.After)
+		}
+	}
+
+	fmt.Println()
+	if dryRun {
+		fmt.Println("dry run: no files were changed")
+		return
+	}
+	if js, ok := petStore.(*store.JSONStore); ok {
+		fmt.Printf("migrated %s (backup: %s)\n", js.Path(), js.BackupPath(res.FromVersion))
+	} else {
+		fmt.Printf("migrated %s (backup kept in pet_backups)\n", petStore.Path())
+	}
+}
@@ -98,6 +98,7 @@ func newSetCmd() *cobra.Command {
 				if err := petStore.Save(pet); err != nil {
 					return fmt.Errorf("save: %w", err)
 				}
+				recordEdit(pet, args[0], old, args[1])
 				fmt.Printf("set %s: %s -> %s\n", args[0], old, args[1])
 				// Note: dev commands do not trigger evolution checks
 				return nil
@@ -134,6 +135,7 @@ func runSetTUI(pet *game.Pet) error {
 		if err := petStore.Save(pet); err != nil {
 			return "", fmt.Errorf("save: %w", err)
 		}
+		recordEdit(pet, field.Key, old, value)
 		return old, nil
 	}
 
@@ -192,12 +194,8 @@ func getCurrentPetValue(pet *game.Pet, key string) string {
 		return strconv.Itoa(pet.GamesWon)
 
 	// Evolution accumulators
-	case "acc_happiness":
-		return strconv.Itoa(pet.AccHappiness)
-	case "acc_health":
-		return strconv.Itoa(pet.AccHealth)
-	case "acc_playful":
-		return strconv.Itoa(pet.AccPlayful)
+	case game.AccHappiness, game.AccHealth, game.AccPlayful:
+		return strconv.Itoa(pet.GetCustomAcc(key))
 	case "night":
 		return strconv.Itoa(pet.NightInteractions)
 	case "day":
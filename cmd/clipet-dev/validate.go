@@ -34,18 +34,23 @@ func newValidateCmd() *cobra.Command {
 			}
 
 			// Validate
-			errs := plugin.Validate(pack)
+			errs, warnings := plugin.Validate(pack)
+			for _, w := range warnings {
+				fmt.Fprintf(os.Stderr, "⚠ %s: %s\n", w.Field, w.Message)
+			}
 			if len(errs) == 0 {
 				stageCount := len(pack.Stages)
 				evoCount := len(pack.Evolutions)
 				frameCount := len(pack.Frames)
 				dlgCount := len(pack.Dialogues)
 				advCount := len(pack.Adventures)
+				crisisCount := len(pack.Crises)
+				itemCount := len(pack.Items)
 
 				fmt.Printf("✓ 物种包 %q 校验通过\n", pack.Species.ID)
 				fmt.Printf("  名称: %s (v%s)\n", pack.Species.Name, pack.Species.Version)
 				fmt.Printf("  阶段: %d, 进化路径: %d\n", stageCount, evoCount)
-				fmt.Printf("  帧集: %d, 对话组: %d, 冒险: %d\n", frameCount, dlgCount, advCount)
+				fmt.Printf("  帧集: %d, 对话组: %d, 冒险: %d, 危机: %d, 物品: %d\n", frameCount, dlgCount, advCount, crisisCount, itemCount)
 				return nil
 			}
 
@@ -12,3 +12,8 @@ var BuiltinFS embed.FS
 //
 //go:embed locales
 var LocalesFS embed.FS
+
+// AchievementsFS contains the built-in achievements (achievements.toml).
+//
+//go:embed achievements.toml
+var AchievementsFS embed.FS
@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"clipet/internal/plugin"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// achievementReport is an achievement unlocked by an action.
+type achievementReport struct {
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Icon        string `json:"icon,omitempty"`
+}
+
+// achievementEntry is one achievement of `clipet achievements`. Hidden
+// achievements keep their name and description secret until unlocked.
+type achievementEntry struct {
+	Key         string    `json:"key"`
+	Species     string    `json:"species,omitempty"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Icon        string    `json:"icon,omitempty"`
+	Hidden      bool      `json:"hidden,omitempty"`
+	Unlocked    bool      `json:"unlocked"`
+	At          time.Time `json:"at,omitzero"`
+	Pet         string    `json:"pet,omitempty"`
+	Progress    int       `json:"progress,omitempty"` // matching events counted so far
+	Needed      int       `json:"needed,omitempty"`   // matching events needed
+}
+
+func newAchievementsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "achievements",
+		Short: "List the achievements of the active profile",
+		Long: `List the achievements of the active profile.
+
+Achievements are unlocked once per profile by any pet of its household and
+are checked after every action, adventure, evolution and time advance.
+Species packs can add their own (achievements.toml).`,
+		Args: cobra.NoArgs,
+		RunE: runAchievements,
+	}
+	cmd.Flags().BoolP("json", "j", false, "Output in JSON format")
+	return cmd
+}
+
+func runAchievements(cmd *cobra.Command, args []string) error {
+	progress, err := profileMgr.Achievements(activeProfile).Load()
+	if err != nil {
+		return err
+	}
+
+	entries := []achievementEntry{}
+	unlocked := 0
+	for _, a := range registry.ListAchievements() {
+		name, description := achievementText(a)
+		e := achievementEntry{
+			Key:         a.Key(),
+			Species:     a.Species,
+			Name:        name,
+			Description: description,
+			Icon:        a.Icon,
+			Hidden:      a.Hidden,
+			Needed:      a.Needed(),
+		}
+		if u, ok := progress.Unlock(a); ok {
+			e.Unlocked, e.At, e.Pet = true, u.At, u.Pet
+			unlocked++
+		} else {
+			e.Progress = progress.Count(a)
+			if a.Hidden {
+				e.Name = i18nMgr.T("cli.achievements.hidden_name")
+				e.Description = i18nMgr.T("cli.achievements.hidden_description")
+				e.Icon = "❔"
+			}
+		}
+		entries = append(entries, e)
+	}
+
+	jsonFlag, _ := cmd.Flags().GetBool("json")
+	if jsonFlag {
+		data, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	fmt.Println(i18nMgr.T("cli.achievements.title", "profile", activeProfile, "unlocked", unlocked, "total", len(entries)))
+	for _, e := range entries {
+		name := e.Name
+		if sp := registry.GetSpecies(e.Species); sp != nil {
+			name = fmt.Sprintf("%s [%s]", name, sp.Species.Name)
+		}
+		if e.Unlocked {
+			fmt.Println(i18nMgr.T("cli.achievements.unlocked", "icon", e.Icon, "name", name,
+				"description", e.Description, "date", e.At.Local().Format("2006-01-02"), "pet", e.Pet))
+			continue
+		}
+		line := i18nMgr.T("cli.achievements.locked", "icon", e.Icon, "name", name, "description", e.Description)
+		if e.Needed > 1 && !e.Hidden {
+			line += "  " + i18nMgr.T("cli.achievements.progress", "count", e.Progress, "needed", e.Needed)
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
+
+// achievementText returns the localized name and description of an
+// achievement. Built-in achievements are translated in the core locales,
+// species achievements by their pack.
+func achievementText(a plugin.Achievement) (name, description string) {
+	name, description = a.Name, a.Description
+	if a.Species != "" {
+		return name, description
+	}
+	key := "game.achievements." + a.ID
+	if msg := i18nMgr.T(key + ".name"); !strings.HasPrefix(msg, key) {
+		name = msg
+	}
+	if msg := i18nMgr.T(key + ".description"); !strings.HasPrefix(msg, key) {
+		description = msg
+	}
+	return name, description
+}
+
+// achievementReports converts unlocked achievements for an action report.
+func achievementReports(unlocked []plugin.Achievement) []achievementReport {
+	var reports []achievementReport
+	for _, a := range unlocked {
+		name, description := achievementText(a)
+		reports = append(reports, achievementReport{Key: a.Key(), Name: name, Description: description, Icon: a.Icon})
+	}
+	return reports
+}
@@ -0,0 +1,232 @@
+package cli
+
+import (
+	"clipet/internal/daemon"
+	"clipet/internal/game"
+	"clipet/internal/plugin"
+	"clipet/internal/store"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// actionReport is the outcome of a CLI action, printed as text or as JSON
+// with --json. It mirrors game.ActionResult with a localized message.
+type actionReport struct {
+	Pet       string             `json:"pet,omitempty"` // set for the partner of a joint action
+	Action    string             `json:"action"`
+	OK        bool               `json:"ok"`
+	ErrorType string             `json:"error_type,omitempty"`
+	Message   string             `json:"message"`
+	Changes   map[string][2]int  `json:"changes"`
+	Animation string             `json:"animation,omitempty"`
+	Dialogue  string             `json:"dialogue,omitempty"`
+	Adventure *adventureReport   `json:"adventure,omitempty"`
+	Evolution *evolutionReport   `json:"evolution,omitempty"`
+	Crises    []game.CrisisEvent `json:"crises,omitempty"`  // crises resolved by the action
+	Partner   *actionReport      `json:"partner,omitempty"` // the housemate's side of play --with
+
+	Achievements []achievementReport `json:"achievements,omitempty"` // unlocked by the action
+}
+
+// newActionCmd creates a care action command with the shared --json flag.
+func newActionCmd(use, short string, runE func(cmd *cobra.Command, args []string) error) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.NoArgs,
+		RunE:  runE,
+	}
+	cmd.Flags().BoolP("json", "j", false, "Output in JSON format")
+	return cmd
+}
+
+// runAction performs one care action and reports the result. When a daemon
+// serves the profile the action is sent to it; otherwise the pet is loaded,
+// changed with do and saved locally.
+func runAction(cmd *cobra.Command, action string, do func(*game.Pet) game.ActionResult) error {
+	if client := dialDaemon(); client != nil {
+		defer client.Close()
+		return runDaemonAction(cmd, client, action)
+	}
+
+	pet, accumulated, err := loadActivePet()
+	if err != nil {
+		return err
+	}
+
+	res := do(pet)
+	_ = store.History(petStore, store.SourceCLI).RecordAction(time.Now(), pet, action, res)
+
+	report := &actionReport{
+		Action:    action,
+		OK:        res.OK,
+		ErrorType: res.ErrorType,
+		Changes:   res.Changes,
+		Animation: string(res.Animation),
+		Crises:    res.Crises,
+	}
+	if res.OK {
+		report.Message = actionSuccessMessage(pet.Name, pet.Species, action)
+		if action == "talk" {
+			report.Dialogue = registry.GetDialogue(pet.Species, pet.StageID, pet.MoodName())
+		}
+	} else {
+		report.Message = localizeActionError(res.ErrorType, res.Message)
+	}
+
+	return finishAction(cmd, pet, accumulated, report)
+}
+
+// runDaemonAction sends the action to the daemon, which records, saves and
+// evolves the pet itself.
+func runDaemonAction(cmd *cobra.Command, client *daemon.Client, action string) error {
+	res, err := client.Act(daemon.ActParams{Action: action, Source: string(store.SourceCLI)})
+	if err != nil {
+		return daemonError(err)
+	}
+
+	report := &actionReport{
+		Action:    res.Action,
+		OK:        res.OK,
+		ErrorType: res.ErrorType,
+		Changes:   res.Changes,
+		Animation: res.Animation,
+		Dialogue:  res.Dialogue,
+		Crises:    res.Crises,
+	}
+	if res.OK {
+		report.Message = actionSuccessMessage(res.Pet, res.Species, action)
+	} else {
+		report.Message = localizeActionError(res.ErrorType, res.Message)
+	}
+	if evo := res.Evolution; evo != nil {
+		report.Evolution = &evolutionReport{From: evo.From, To: evo.To, Phase: evo.Phase}
+	}
+	for _, key := range res.Achievements {
+		if a := registry.GetAchievement(key); a != nil {
+			report.Achievements = append(report.Achievements, achievementReports([]plugin.Achievement{*a})...)
+		}
+	}
+	return printActionReport(cmd, res.Pet, report)
+}
+
+// finishAction saves the pet and evolves it if the action succeeded and it
+// qualifies, then prints the report with the achievements it unlocked. A
+// rejected action leaves the save alone unless loading the pet accumulated
+// offline time.
+func finishAction(cmd *cobra.Command, pet *game.Pet, accumulated bool, report *actionReport) error {
+	if report.OK || accumulated {
+		if err := petStore.Save(pet); err != nil {
+			return fmt.Errorf("save pet: %w", err)
+		}
+	}
+	if report.OK {
+		report.Evolution = autoEvolve(pet)
+	}
+	report.Achievements = achievementReports(pet.TakeAchievements())
+	return printActionReport(cmd, pet.Name, report)
+}
+
+// printActionReport prints the report as JSON or text. In text mode a
+// failed action is returned as an error so the exit status reflects it.
+func printActionReport(cmd *cobra.Command, petName string, report *actionReport) error {
+	if report.Changes == nil {
+		report.Changes = map[string][2]int{}
+	}
+	if report.Partner != nil && report.Partner.Changes == nil {
+		report.Partner.Changes = map[string][2]int{}
+	}
+	if report.Dialogue == "" && report.OK && report.Action == "talk" {
+		report.Dialogue = "......"
+	}
+
+	jsonFlag, _ := cmd.Flags().GetBool("json")
+	if jsonFlag {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if !report.OK {
+		return errors.New(report.Message)
+	}
+	printActionLines(petName, report)
+	if partner := report.Partner; partner != nil {
+		printActionLines(partner.Pet, partner)
+	}
+	return nil
+}
+
+// printActionLines prints the text lines of a successful action.
+func printActionLines(petName string, report *actionReport) {
+	fmt.Println(report.Message)
+	if report.Dialogue != "" {
+		fmt.Println("  💬 " + report.Dialogue)
+	}
+	if len(report.Changes) > 0 {
+		fmt.Println("  " + formatChanges(report.Changes))
+	}
+	for _, c := range report.Crises {
+		fmt.Println(i18nMgr.T("cli.action.crisis_resolved", "name", c.Name, "text", c.Text))
+	}
+	if evo := report.Evolution; evo != nil {
+		fmt.Println(i18nMgr.T("cli.action.evolved", "name", petName, "from", evo.From, "to", evo.To, "phase", evo.Phase))
+	}
+	for _, a := range report.Achievements {
+		fmt.Println(i18nMgr.T("cli.action.achievement", "icon", a.Icon, "name", a.Name, "description", a.Description))
+	}
+}
+
+// actionSuccessMessage returns the localized success line for an action.
+func actionSuccessMessage(petName, species, action string) string {
+	if skillID, ok := strings.CutPrefix(action, "skill:"); ok {
+		return i18nMgr.T("cli.action.success.skill", "name", petName, "skill", registry.GetTraitName(species, skillID))
+	}
+	if itemID, ok := strings.CutPrefix(action, "item:"); ok {
+		name := itemID
+		if item := registry.GetItem(species, itemID); item != nil {
+			name = item.Name
+		}
+		return i18nMgr.T("cli.action.success.item", "name", petName, "item", name)
+	}
+	if itemID, ok := strings.CutPrefix(action, "buy:"); ok {
+		name := itemID
+		if item := registry.GetItem(species, itemID); item != nil {
+			name = item.Name
+		}
+		return i18nMgr.T("cli.action.success.buy", "name", petName, "item", name)
+	}
+	for _, prefix := range []string{"equip", "unequip"} {
+		if itemID, ok := strings.CutPrefix(action, prefix+":"); ok {
+			name := itemID
+			if item := registry.GetItem(species, itemID); item != nil {
+				name = item.Name
+			}
+			return i18nMgr.T("cli.action.success."+prefix, "name", petName, "item", name)
+		}
+	}
+	return i18nMgr.T("cli.action.success."+action, "name", petName)
+}
+
+// localizeActionError converts a game error type to a localized message.
+// Falls back to msg if errType is empty or unknown.
+func localizeActionError(errType, msg string) string {
+	switch errType {
+	case game.ErrEnergyLow, game.ErrHealthLow, game.ErrCooldown, game.ErrDead,
+		game.ErrInvalidAction, game.ErrFullHunger, game.ErrFullEnergy,
+		game.ErrSkillSystem, game.ErrSkillUnknown, game.ErrSkillNotActive,
+		game.ErrNoAdventure, game.ErrAdventureLimit, game.ErrNoItem,
+		game.ErrNotForSale, game.ErrNoCoins, game.ErrInventoryFull, game.ErrNotAccessory,
+		game.ErrPartnerBusy, game.ErrCannotBreed, game.ErrNotGrown, game.ErrBreedMismatch:
+		return i18nMgr.T("game.errors." + errType)
+	}
+	return msg
+}
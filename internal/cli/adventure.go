@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"clipet/internal/game"
+	"clipet/internal/plugin"
+	"clipet/internal/store"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// adventureReport describes the pet's next adventure and, once a choice is
+// made, its outcome.
+type adventureReport struct {
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Choices     []string       `json:"choices"`
+	Choice      int            `json:"choice,omitempty"` // 1-based, 0 until resolved
+	Outcome     string         `json:"outcome,omitempty"`
+	Items       map[string]int `json:"items,omitempty"` // item rewards of the outcome
+}
+
+func newAdventureCmd() *cobra.Command {
+	cmd := newActionCmd("adventure", "Show the next adventure, or resolve it with --choice", runAdventure)
+	cmd.Long = `Show the next adventure and its choices, or resolve it with --choice N.
+
+The adventure stays the same until one is completed, so a script can show it
+first and pick a choice in a later run. Showing it is not an action: with
+--json its report has "ok": false and no error_type.`
+	cmd.Flags().IntP("choice", "c", 0, "Number of the choice to take (1-based)")
+	return cmd
+}
+
+func runAdventure(cmd *cobra.Command, args []string) error {
+	petStore = servedStore(petStore)
+	pet, accumulated, err := loadActivePet()
+	if err != nil {
+		return err
+	}
+
+	report := &actionReport{Action: "adventure"}
+	check := game.CanAdventure(pet)
+	adv := game.NextAdventure(pet, registry)
+	switch {
+	case check.ErrorType == game.ErrAdventureLimit:
+		report.ErrorType = check.ErrorType
+		report.Message = i18nMgr.T("cli.action.adventure_limit", "name", pet.Name, "minutes", int(check.Wait.Minutes())+1)
+	case !check.OK:
+		report.ErrorType = check.ErrorType
+		report.Message = localizeActionError(check.ErrorType, check.Message)
+	case time.Since(pet.LastAdventureAt) < game.CooldownAdventure:
+		remain := game.CooldownAdventure - time.Since(pet.LastAdventureAt)
+		report.ErrorType = game.ErrCooldown
+		report.Message = i18nMgr.T("cli.action.adventure_cooldown", "name", pet.Name, "minutes", int(remain.Minutes())+1)
+	case adv == nil:
+		report.ErrorType = game.ErrNoAdventure
+		report.Message = localizeActionError(game.ErrNoAdventure, "")
+	default:
+		report.Adventure = newAdventureReport(adv)
+		choice, _ := cmd.Flags().GetInt("choice")
+		if !cmd.Flags().Changed("choice") {
+			report.Message = i18nMgr.T("cli.action.adventure_choose")
+			return showAdventure(cmd, pet, report)
+		} else if choice < 1 || choice > len(adv.Choices) {
+			report.ErrorType = game.ErrInvalidAction
+			report.Message = i18nMgr.T("cli.action.invalid_choice", "choice", choice, "max", len(adv.Choices))
+		} else {
+			resolveAdventure(pet, adv, choice, report)
+		}
+	}
+
+	return finishAction(cmd, pet, accumulated, report)
+}
+
+func newAdventureReport(adv *plugin.Adventure) *adventureReport {
+	r := &adventureReport{ID: adv.ID, Name: adv.Name, Description: adv.Description, Choices: []string{}}
+	for _, c := range adv.Choices {
+		r.Choices = append(r.Choices, c.Text)
+	}
+	return r
+}
+
+// showAdventure saves the pet and prints the unresolved adventure in
+// report. Unlike finishAction it does not mark the report OK or evolve the
+// pet, since nothing happened yet.
+func showAdventure(cmd *cobra.Command, pet *game.Pet, report *actionReport) error {
+	if err := petStore.Save(pet); err != nil {
+		return fmt.Errorf("save pet: %w", err)
+	}
+	jsonFlag, _ := cmd.Flags().GetBool("json")
+	if jsonFlag {
+		return printActionReport(cmd, pet.Name, report)
+	}
+	printAdventureChoices(report.Adventure)
+	fmt.Println(report.Message)
+	return nil
+}
+
+// resolveAdventure applies the outcome of the 1-based choice to the pet and
+// records the completed adventure.
+func resolveAdventure(pet *game.Pet, adv *plugin.Adventure, choice int, report *actionReport) {
+	picked := adv.Choices[choice-1]
+	outcome := game.ResolveOutcome(picked)
+	changes := game.ApplyAdventureOutcome(pet, outcome)
+	pet.LastAdventureAt = time.Now()
+
+	_ = store.History(petStore, store.SourceCLI).RecordAdventure(time.Now(), pet, game.AdventureResult{
+		Adventure: *adv,
+		Choice:    picked,
+		Outcome:   outcome,
+		Changes:   changes,
+	})
+
+	report.OK = true
+	report.Changes = changes
+	report.Adventure.Choice = choice
+	report.Adventure.Outcome = outcome.Text
+	report.Adventure.Items = outcome.Items
+	report.Message = i18nMgr.T("cli.action.adventure_result", "adventure", adv.Name, "choice", picked.Text, "outcome", outcome.Text)
+	for _, itemID := range slices.Sorted(maps.Keys(outcome.Items)) {
+		report.Message += "\n  " + i18nMgr.T("cli.action.item_reward", "item", itemName(pet, itemID), "count", outcome.Items[itemID])
+	}
+}
+
+// printAdventureChoices prints an unresolved adventure and its numbered choices.
+func printAdventureChoices(adv *adventureReport) {
+	fmt.Printf("🗺️  %s\n%s\n\n", adv.Name, adv.Description)
+	for i, c := range adv.Choices {
+		fmt.Printf("  %d. %s\n", i+1, c)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"clipet/internal/game"
+	"clipet/internal/plugin"
+	"clipet/internal/store"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func newExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export <file>",
+		Short: "Export the pet to a portable archive",
+		Long: `Export the pet to a portable archive (e.g. pet.clipet).
+
+The archive holds the pet, its pending offline time and custom attributes,
+and the species pack ID and version it needs. Import it on another machine
+or profile with "clipet import".`,
+		Args: cobra.ExactArgs(1),
+		RunE: runExport,
+	}
+	cmd.Flags().BoolP("force", "f", false, "Overwrite an existing file")
+	return cmd
+}
+
+func newImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import a pet from an archive into the active profile",
+		Long: `Import a pet from an archive into the active profile.
+
+The species pack the pet depends on must be installed with a compatible
+version (same major version, not older than the exported one). If the
+profile already has a pet, --yes replaces it after taking a snapshot; the
+replaced pet's journal is kept next to the save. The pet's name must not be
+taken by another pet of the household.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runImport,
+	}
+	cmd.Flags().BoolP("yes", "y", false, "Replace the current pet without asking")
+	return cmd
+}
+
+func runExport(cmd *cobra.Command, args []string) error {
+	pet, err := loadPet()
+	if err != nil {
+		return err
+	}
+
+	pack := registry.GetSpecies(pet.Species)
+	if pack == nil {
+		return errors.New(i18nMgr.T("cli.archive.species_missing", "species", pet.Species, "version", "?"))
+	}
+
+	force, _ := cmd.Flags().GetBool("force")
+	if err := store.WriteArchive(args[0], pet, pack.Species.Version, force); err != nil {
+		return err
+	}
+	fmt.Println(i18nMgr.T("cli.archive.exported",
+		"name", pet.Name, "species", pet.Species, "version", pack.Species.Version, "file", args[0]))
+	return nil
+}
+
+func runImport(cmd *cobra.Command, args []string) error {
+	archive, err := store.ReadArchive(args[0])
+	if err != nil {
+		return err
+	}
+	m := archive.Manifest
+	pet := archive.Pet
+
+	if err := checkArchiveSpecies(m); err != nil {
+		return err
+	}
+	for _, issue := range game.ValidatePet(pet, registry) {
+		if issue.Fix == "" || issue.Kind == game.IssueUnknownStage {
+			return errors.New(i18nMgr.T("cli.archive.incompatible_pet",
+				"name", m.PetName, "problem", describeIssue(issue)))
+		}
+	}
+	game.RepairPet(pet, registry)
+
+	// Names are unique within the household so --pet can find every pet; a
+	// replaced pet may pass its name on
+	if info, err := profileMgr.FindPet(activeProfile, pet.Name); err == nil && info.Slot != activePet {
+		return errors.New(i18nMgr.T("cli.archive.name_taken", "name", pet.Name, "profile", activeProfile))
+	}
+
+	// A running daemon would overwrite the import with its own pet
+	local := petStore
+	petStore = servedStore(petStore)
+
+	replaced, tag := "", "import"
+	if petStore.Exists() {
+		yes, _ := cmd.Flags().GetBool("yes")
+		if !yes {
+			return errors.New(i18nMgr.T("cli.archive.profile_has_pet", "profile", activeProfile))
+		}
+		if old, err := petStore.Load(); err == nil {
+			replaced = old.Name
+		}
+		if snapper, ok := local.(store.Snapshotter); ok {
+			snap, err := snapper.Snapshot("import")
+			if err != nil {
+				return fmt.Errorf("snapshot failed: %w", err)
+			}
+			if snap != nil {
+				tag = snap.ID
+			}
+		}
+	}
+
+	// Time spent inside the archive is not offline time; the offline time
+	// pending at export is kept in AccumulatedOfflineDuration.
+	pet.LastCheckedAt = time.Now()
+	if err := petStore.Save(pet); err != nil {
+		return fmt.Errorf("save imported pet: %w", err)
+	}
+
+	fmt.Println(i18nMgr.T("cli.archive.imported",
+		"name", pet.Name, "species", m.SpeciesID, "version", m.SpeciesVersion, "profile", activeProfile))
+
+	// The replaced pet's history stays with it: its journal is moved aside
+	// and the household's achievements no longer credit it
+	if replaced != "" {
+		path, err := store.JournalFor(local).Archive(tag)
+		if err != nil {
+			return err
+		}
+		if err := store.AchievementsFor(local).Forget(replaced); err != nil {
+			return err
+		}
+		if path != "" {
+			fmt.Println(i18nMgr.T("cli.archive.history_archived", "name", replaced, "path", path))
+		}
+	}
+	return nil
+}
+
+// checkArchiveSpecies verifies that the archive's species pack is installed
+// in a compatible version.
+func checkArchiveSpecies(m store.ArchiveManifest) error {
+	pack := registry.GetSpecies(m.SpeciesID)
+	if pack == nil {
+		return errors.New(i18nMgr.T("cli.archive.species_missing", "species", m.SpeciesID, "version", m.SpeciesVersion))
+	}
+
+	ok, err := plugin.VersionCompatible(pack.Species.Version, m.SpeciesVersion)
+	if err != nil {
+		return fmt.Errorf("species %s: %w", m.SpeciesID, err)
+	}
+	if !ok {
+		return errors.New(i18nMgr.T("cli.archive.species_incompatible",
+			"species", m.SpeciesID, "required", m.SpeciesVersion, "installed", pack.Species.Version))
+	}
+	return nil
+}
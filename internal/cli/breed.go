@@ -0,0 +1,175 @@
+package cli
+
+import (
+	"clipet/internal/game"
+	"clipet/internal/store"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// breedReport is the outcome of `clipet breed`, printed as text or as JSON.
+type breedReport struct {
+	OK      bool          `json:"ok"`
+	Message string        `json:"message"`
+	Parent  *actionReport `json:"parent"`
+	Partner *actionReport `json:"partner"`
+	Egg     *eggReport    `json:"egg,omitempty"`
+}
+
+// eggReport describes the egg laid by two parents.
+type eggReport struct {
+	Name    string         `json:"name"`
+	Slot    string         `json:"slot"`
+	Species string         `json:"species"`
+	Traits  []string       `json:"traits"`
+	Stats   map[string]int `json:"stats"`
+}
+
+func newBreedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "breed <partner> <egg name>",
+		Short: "Let the pet and a grown housemate have an egg",
+		Long: `Let the pet and a grown housemate have an egg.
+
+Both parents must be of the same species, in a phase the species' [breeding]
+rules allow (adult or legend by default), rested and off their breeding
+cooldown. The egg joins the household with a weighted mix of its parents'
+traits and base stats leaning toward theirs.`,
+		Args: cobra.ExactArgs(2),
+		RunE: runBreed,
+	}
+	cmd.Flags().BoolP("json", "j", false, "Output in JSON format")
+	return cmd
+}
+
+func runBreed(cmd *cobra.Command, args []string) error {
+	name := strings.TrimSpace(args[1])
+	if name == "" {
+		return errors.New(i18nMgr.T("cli.init.name_empty"))
+	}
+	if _, err := profileMgr.FindPet(activeProfile, name); err == nil {
+		return errors.New(i18nMgr.T("cli.init.name_taken", "name", name))
+	}
+
+	pet, partner, partnerStore, _, err := loadPair(args[0])
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	egg, res, partnerRes := game.Breed(pet, partner, name)
+	_ = store.History(petStore, store.SourceCLI).RecordAction(now, pet, "breed:"+partner.Name, res)
+	_ = store.History(partnerStore, store.SourceCLI).RecordAction(now, partner, "breed:"+pet.Name, partnerRes)
+
+	report := &breedReport{
+		OK:      res.OK,
+		Parent:  breedActionReport(pet, partner, res),
+		Partner: breedActionReport(partner, pet, partnerRes),
+	}
+	report.Partner.Pet = partner.Name
+	switch {
+	case res.ErrorType == game.ErrPartnerBusy:
+		report.Message = i18nMgr.T("cli.breed.partner_busy", "partner", partner.Name, "reason", report.Partner.Message)
+	case !res.OK:
+		report.Message = report.Parent.Message
+	default:
+		report.Message = i18nMgr.T("cli.breed.success", "name", pet.Name, "partner", partner.Name, "egg", name)
+	}
+
+	if egg != nil {
+		slot, err := saveEgg(egg)
+		if err != nil {
+			return err
+		}
+		report.Egg = &eggReport{
+			Name:    egg.Name,
+			Slot:    slot,
+			Species: egg.Species,
+			Traits:  egg.Traits,
+			Stats: map[string]int{
+				"hunger": egg.Hunger, "happiness": egg.Happiness,
+				"health": egg.Health, "energy": egg.Energy,
+			},
+		}
+		if err := petStore.Save(pet); err != nil {
+			return fmt.Errorf("save pet: %w", err)
+		}
+		if err := partnerStore.Save(partner); err != nil {
+			return fmt.Errorf("save pet: %w", err)
+		}
+	}
+
+	jsonFlag, _ := cmd.Flags().GetBool("json")
+	if jsonFlag {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if !report.OK {
+		return errors.New(report.Message)
+	}
+	fmt.Println(report.Message)
+	fmt.Println("  " + formatChanges(report.Parent.Changes))
+	fmt.Println("  " + formatChanges(report.Partner.Changes))
+	traits := make([]string, len(egg.Traits))
+	for i, id := range egg.Traits {
+		traits[i] = registry.GetTraitName(egg.Species, id)
+	}
+	if len(traits) == 0 {
+		traits = []string{i18nMgr.T("cli.breed.no_traits")}
+	}
+	fmt.Println(i18nMgr.T("cli.breed.traits", "traits", strings.Join(traits, ", ")))
+	fmt.Println(i18nMgr.T("cli.init.pet_hint", "name", egg.Name))
+	return nil
+}
+
+// breedActionReport builds the report of pet's side of breeding with partner.
+func breedActionReport(pet, partner *game.Pet, res game.ActionResult) *actionReport {
+	report := &actionReport{
+		Action:    "breed:" + partner.Name,
+		OK:        res.OK,
+		ErrorType: res.ErrorType,
+		Changes:   res.Changes,
+	}
+	if report.Changes == nil {
+		report.Changes = map[string][2]int{}
+	}
+	switch {
+	case res.ErrorType == game.ErrPartnerBusy:
+		report.Message = i18nMgr.T("cli.breed.partner_waiting")
+	case !res.OK:
+		report.Message = localizeActionError(res.ErrorType, res.Message)
+	}
+	return report
+}
+
+// saveEgg saves a new egg in a free slot of the active profile and records
+// its birth. It returns the slot.
+func saveEgg(egg *game.Pet) (string, error) {
+	slot, err := profileMgr.NewPetSlot(activeProfile)
+	if err != nil {
+		return "", err
+	}
+	st, err := profileMgr.OpenPet(activeProfile, slot, store.Backend(cfg.StoreBackend))
+	if err != nil {
+		return "", fmt.Errorf("init store: %w", err)
+	}
+	st = servedStore(st)
+	if err := st.Save(egg); err != nil {
+		return "", errors.New(i18nMgr.T("cli.init.save_failed", "error", err.Error()))
+	}
+	birth := store.NewEvent(time.Now(), store.EventBirth, store.SourceCLI, egg)
+	birth.Subject = egg.Species
+	birth.Detail = egg.Name
+	_ = store.JournalFor(st).Append(birth)
+	return slot, nil
+}
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"clipet/internal/daemon"
+	"clipet/internal/store"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/spf13/cobra"
+)
+
+func newDaemonCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "daemon",
+		Short: "Run the pet in the background and serve the control socket",
+		Long: `Run the pet in the background and serve the control socket.
+
+The daemon owns one pet of the active profile (see --pet): it advances time on
+a schedule and serves a JSON-RPC 2.0 API (status, act, load, save, subscribe)
+on daemon.sock in the pet's directory. While it runs, every command and the
+TUI load and save that pet through it, and "clipet log --follow" streams new
+events.`,
+		Args: cobra.NoArgs,
+		RunE: runDaemon,
+	}
+	cmd.Flags().Duration("interval", daemon.DefaultInterval, "How often to sync with the save and check the clock")
+	cmd.Flags().Duration("step", daemon.DefaultStep, "Advance time in whole steps of this size")
+	return cmd
+}
+
+func runDaemon(cmd *cobra.Command, args []string) error {
+	interval, _ := cmd.Flags().GetDuration("interval")
+	step, _ := cmd.Flags().GetDuration("step")
+
+	srv := daemon.New(daemon.Options{
+		Store:        petStore,
+		Registry:     registry,
+		Capabilities: capabilitiesReg,
+		Socket:       daemonSocket(),
+		Interval:     interval,
+		Step:         step,
+		Logger:       log.New(os.Stderr, "clipet daemon: ", log.LstdFlags),
+	})
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	err := srv.Run(ctx)
+	if errors.Is(err, daemon.ErrRunning) {
+		return errors.New(i18nMgr.T("cli.daemon.running", "path", srv.Socket()))
+	}
+	return err
+}
+
+// daemonSocket returns the control socket of the active pet.
+func daemonSocket() string {
+	return daemon.SocketPath(petDir())
+}
+
+// dialDaemon connects to the active profile's daemon, or returns nil when
+// none is running.
+func dialDaemon() *daemon.Client {
+	client, err := daemon.Dial(daemonSocket())
+	if err != nil {
+		return nil
+	}
+	return client
+}
+
+// servedStore returns a store that loads and saves through the daemon
+// serving st's pet, or st itself when no daemon is running for it.
+func servedStore(st store.Store) store.Store {
+	client, err := daemon.Dial(daemon.SocketPath(filepath.Dir(st.Path())))
+	if err != nil {
+		return st
+	}
+	return daemon.NewRemoteStore(client, st)
+}
+
+// isServed reports whether st goes through a running daemon, which then
+// advances the pet's time itself.
+func isServed(st store.Store) bool {
+	_, ok := st.(*daemon.RemoteStore)
+	return ok
+}
+
+// daemonError converts a daemon RPC error into a CLI error.
+func daemonError(err error) error {
+	var rpcErr *daemon.RPCError
+	if errors.As(err, &rpcErr) && rpcErr.Code == daemon.CodeNoPet {
+		fmt.Println(i18nMgr.T("cli.status.no_pet"))
+		return fmt.Errorf("no pet")
+	}
+	return errors.New(i18nMgr.T("cli.daemon.request_failed", "error", err.Error()))
+}
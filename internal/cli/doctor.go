@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"clipet/internal/game"
+	"clipet/internal/store"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func newDoctorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check the save for damage and inconsistencies",
+		Long: `Check the save for damage and inconsistencies.
+
+Verifies the save checksum and validates attributes, counters, timestamps
+and the stage against the installed species packs. With --fix, damaged
+saves are recovered from the last good copy, problems are repaired and
+the save is re-signed. A save that only fails its checksum (for example
+after a hand edit) is kept, validated and re-signed.`,
+		Args: cobra.NoArgs,
+		RunE: runDoctor,
+	}
+	cmd.Flags().Bool("fix", false, "Repair the problems found")
+	return cmd
+}
+
+func runDoctor(cmd *cobra.Command, args []string) error {
+	if !petStore.Exists() {
+		fmt.Println(i18nMgr.T("cli.status.no_pet"))
+		return nil
+	}
+	fix, _ := cmd.Flags().GetBool("fix")
+
+	fmt.Println(i18nMgr.T("cli.doctor.checking", "profile", activeProfile, "path", petStore.Path()))
+
+	// 1. File integrity
+	problems := 0
+	checksumOnly := false
+	if v, ok := petStore.(store.Verifier); ok {
+		switch err := v.Verify(); {
+		case err == nil:
+			fmt.Println("  ✓ " + i18nMgr.T("cli.doctor.file_ok"))
+		case errors.Is(err, store.ErrChecksumMismatch):
+			fmt.Println("  ✗ " + i18nMgr.T("cli.doctor.checksum_mismatch"))
+			problems++
+			checksumOnly = true
+		default:
+			fmt.Println("  ✗ " + i18nMgr.T("cli.doctor.file_damaged", "error", err.Error()))
+			problems++
+		}
+	}
+
+	// 2. Load the pet: with --fix the store may fall back to a good copy;
+	// otherwise read the file as-is so nothing on disk changes.
+	pet, err := doctorLoad(fix, checksumOnly)
+	if err != nil {
+		fmt.Println("  ✗ " + i18nMgr.T("cli.doctor.unrecoverable", "error", err.Error()))
+		return errors.New(i18nMgr.T("cli.doctor.restore_hint"))
+	}
+	if rec, ok := petStore.(store.Recoverer); ok && rec.RecoveredFrom() != "" {
+		fmt.Println("  ✓ " + i18nMgr.T("cli.doctor.recovered", "path", rec.RecoveredFrom()))
+	}
+
+	// 3. Pet state
+	var issues []game.PetIssue
+	if fix {
+		issues = game.RepairPet(pet, registry)
+	} else {
+		issues = game.ValidatePet(pet, registry)
+	}
+	if len(issues) == 0 {
+		fmt.Println("  ✓ " + i18nMgr.T("cli.doctor.state_ok"))
+	}
+	for _, issue := range issues {
+		fmt.Println("  ✗ " + describeIssue(issue))
+		problems++
+	}
+
+	if problems == 0 {
+		fmt.Println(i18nMgr.T("cli.doctor.healthy"))
+		return nil
+	}
+	if !fix {
+		fmt.Println(i18nMgr.T("cli.doctor.summary", "count", problems))
+		fmt.Println(i18nMgr.T("cli.doctor.hint"))
+		return nil
+	}
+
+	if err := petStore.Save(pet); err != nil {
+		return fmt.Errorf("save repaired pet: %w", err)
+	}
+	unfixed := 0
+	for _, issue := range issues {
+		if issue.Fix == "" {
+			unfixed++
+		}
+	}
+	fmt.Println(i18nMgr.T("cli.doctor.repaired", "count", problems-unfixed))
+	if unfixed > 0 {
+		fmt.Println(i18nMgr.T("cli.doctor.unfixed", "count", unfixed))
+	}
+	return nil
+}
+
+// doctorLoad reads the pet for checking. A save whose only problem is a
+// checksum mismatch (e.g. edited by hand) is read unverified so --fix can
+// validate and re-sign it instead of discarding the edit.
+func doctorLoad(fix, checksumOnly bool) (*game.Pet, error) {
+	v, verifiable := petStore.(store.Verifier)
+	if !fix || (checksumOnly && verifiable) {
+		if verifiable {
+			return v.LoadUnverified()
+		}
+	}
+	return petStore.Load()
+}
+
+// describeIssue renders a validation issue for the doctor report.
+func describeIssue(issue game.PetIssue) string {
+	text := i18nMgr.T("cli.doctor.issues."+issue.Kind, "field", issue.Field, "value", issue.Value)
+	if issue.Fix == "" {
+		return text + " " + i18nMgr.T("cli.doctor.no_fix")
+	}
+	return text + " " + i18nMgr.T("cli.doctor.fix", "fix", issue.Fix)
+}
@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"clipet/internal/game"
+
+	"github.com/spf13/cobra"
+)
+
+func newEquipCmd() *cobra.Command {
+	cmd := newActionCmd("equip <id>", "Put on an accessory from the pet's inventory", runEquip)
+	cmd.Args = cobra.ExactArgs(1)
+	return cmd
+}
+
+func newUnequipCmd() *cobra.Command {
+	cmd := newActionCmd("unequip <id>", "Take off an accessory the pet is wearing", runUnequip)
+	cmd.Args = cobra.ExactArgs(1)
+	return cmd
+}
+
+func runEquip(cmd *cobra.Command, args []string) error {
+	itemID := args[0]
+	return runAction(cmd, "equip:"+itemID, func(pet *game.Pet) game.ActionResult {
+		return pet.EquipAccessory(itemID)
+	})
+}
+
+func runUnequip(cmd *cobra.Command, args []string) error {
+	itemID := args[0]
+	return runAction(cmd, "unequip:"+itemID, func(pet *game.Pet) game.ActionResult {
+		return pet.UnequipAccessory(itemID)
+	})
+}
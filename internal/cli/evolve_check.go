@@ -2,26 +2,42 @@ package cli
 
 import (
 	"clipet/internal/game"
+	"clipet/internal/store"
 	"fmt"
+	"time"
 )
 
+// evolutionReport describes an automatic CLI evolution.
+type evolutionReport struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Phase string `json:"phase"`
+}
+
 // checkAndReportEvolution checks if the pet qualifies for evolution
 // and automatically evolves using the best candidate.
 // This is the CLI-mode evolution (auto-pick best match).
 func checkAndReportEvolution(pet *game.Pet) {
-	candidates := game.CheckEvolution(pet, registry)
-	if len(candidates) == 0 {
-		return
+	if evo := autoEvolve(pet); evo != nil {
+		fmt.Printf("evolve: %s -> %s (%s)\n", evo.From, evo.To, evo.Phase)
 	}
+}
+
+// autoEvolve evolves the pet to the best candidate, if any, saves and
+// records it. It returns nil when the pet does not qualify.
+func autoEvolve(pet *game.Pet) *evolutionReport {
+	return autoEvolveIn(petStore, pet)
+}
 
-	best := game.BestCandidate(candidates)
+// autoEvolveIn is autoEvolve for a pet saved in st.
+func autoEvolveIn(st store.Store, pet *game.Pet) *evolutionReport {
+	oldStageID, best := game.AutoEvolve(pet, registry)
 	if best == nil {
-		return
+		return nil
 	}
 
-	oldStageID := pet.StageID
-	game.DoEvolve(pet, *best)
-	_ = petStore.Save(pet)
+	_ = st.Save(pet)
+	_ = store.History(st, store.SourceCLI).RecordEvolution(time.Now(), pet, oldStageID, best.ToStage.ID)
 
-	fmt.Printf("evolve: %s -> %s (%s)\n", oldStageID, best.ToStage.ID, best.ToStage.Phase)
+	return &evolutionReport{From: oldStageID, To: best.ToStage.ID, Phase: best.ToStage.Phase}
 }
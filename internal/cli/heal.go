@@ -0,0 +1,17 @@
+package cli
+
+import (
+	"clipet/internal/game"
+
+	"github.com/spf13/cobra"
+)
+
+func newHealCmd() *cobra.Command {
+	return newActionCmd("heal", "Heal the pet", runHeal)
+}
+
+func runHeal(cmd *cobra.Command, args []string) error {
+	return runAction(cmd, "heal", func(pet *game.Pet) game.ActionResult {
+		return pet.Heal()
+	})
+}
@@ -2,8 +2,11 @@ package cli
 
 import (
 	"clipet/internal/game"
+	"clipet/internal/store"
+	"errors"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/spf13/cobra"
 )
@@ -16,14 +19,42 @@ func newInitCmd() *cobra.Command {
 	}
 }
 
+func newAdoptCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "adopt",
+		Short: "Add another pet to the household",
+		Args:  cobra.NoArgs,
+		RunE:  runAdopt,
+	}
+}
+
 func runInit(cmd *cobra.Command, args []string) error {
+	petStore = servedStore(petStore)
 	if petStore.Exists() {
-		return fmt.Errorf(i18nMgr.T("cli.init.pet_exists", "path", petStore.Path()))
+		return errors.New(i18nMgr.T("cli.init.pet_exists", "path", petStore.Path()))
+	}
+	return createPet(petStore)
+}
+
+// runAdopt creates a pet in a new slot of the active profile.
+func runAdopt(cmd *cobra.Command, args []string) error {
+	slot, err := profileMgr.NewPetSlot(activeProfile)
+	if err != nil {
+		return err
+	}
+	st, err := profileMgr.OpenPet(activeProfile, slot, store.Backend(cfg.StoreBackend))
+	if err != nil {
+		return fmt.Errorf("init store: %w", err)
 	}
+	return createPet(servedStore(st))
+}
 
+// createPet asks for a species and a name and saves the new egg to st.
+// Names must be unique within the household so --pet can find the pet.
+func createPet(st store.Store) error {
 	species := registry.ListSpecies()
 	if len(species) == 0 {
-		return fmt.Errorf(i18nMgr.T("cli.init.no_species"))
+		return errors.New(i18nMgr.T("cli.init.no_species"))
 	}
 
 	// Sort species by name
@@ -47,7 +78,7 @@ func runInit(cmd *cobra.Command, args []string) error {
 	// Get species choice
 	var choice int
 	for {
-		fmt.Printf(i18nMgr.T("cli.init.select_species", "count", len(species)))
+		fmt.Print(i18nMgr.T("cli.init.select_species", "count", len(species)))
 		_, err := fmt.Scanln(&choice)
 		if err != nil || choice < 1 || choice > len(species) {
 			fmt.Println(i18nMgr.T("cli.init.invalid_selection"))
@@ -66,6 +97,10 @@ func runInit(cmd *cobra.Command, args []string) error {
 			fmt.Println(i18nMgr.T("cli.init.name_empty"))
 			continue
 		}
+		if _, err := profileMgr.FindPet(activeProfile, name); err == nil {
+			fmt.Println(i18nMgr.T("cli.init.name_taken", "name", name))
+			continue
+		}
 		break
 	}
 
@@ -73,17 +108,22 @@ func runInit(cmd *cobra.Command, args []string) error {
 	baseStats := registry.GetBaseStats(selected.ID)
 	eggStage := registry.GetEggStage(selected.ID)
 	if baseStats == nil || eggStage == nil {
-		return fmt.Errorf(i18nMgr.T("cli.init.incomplete_species", "species", selected.ID))
+		return errors.New(i18nMgr.T("cli.init.incomplete_species", "species", selected.ID))
 	}
 
 	// Create pet
 	pet := game.NewPet(name, selected.ID, eggStage.ID,
 		baseStats.Hunger, baseStats.Happiness, baseStats.Health, baseStats.Energy, registry)
 	pet.SetCapabilitiesRegistry(capabilitiesReg)
+	pet.Traits = game.RollTraits(registry, selected.ID)
 
-	if err := petStore.Save(pet); err != nil {
-		return fmt.Errorf(i18nMgr.T("cli.init.save_failed", "error", err.Error()))
+	if err := st.Save(pet); err != nil {
+		return errors.New(i18nMgr.T("cli.init.save_failed", "error", err.Error()))
 	}
+	birth := store.NewEvent(time.Now(), store.EventBirth, store.SourceCLI, pet)
+	birth.Subject = selected.ID
+	birth.Detail = name
+	_ = store.JournalFor(st).Append(birth)
 
 	fmt.Println()
 	fmt.Println(i18nMgr.T("cli.init.pet_created", "name", name, "stage", eggStage.Name))
@@ -91,6 +131,9 @@ func runInit(cmd *cobra.Command, args []string) error {
 	fmt.Println(i18nMgr.T("cli.init.stage_label", "stage", eggStage.Name))
 	fmt.Println()
 	fmt.Println(i18nMgr.T("cli.init.run_hint"))
+	if pets, _ := profileMgr.Pets(activeProfile); len(pets) > 1 {
+		fmt.Println(i18nMgr.T("cli.init.pet_hint", "name", name))
+	}
 
 	return nil
 }
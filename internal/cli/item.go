@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"clipet/internal/game"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// itemInfo describes one inventory entry for `clipet item` listings.
+type itemInfo struct {
+	ID      string         `json:"id"`
+	Name    string         `json:"name"`
+	Kind    string         `json:"kind"`
+	Count   int            `json:"count"`
+	Worn    bool           `json:"worn,omitempty"`
+	Effects map[string]int `json:"effects,omitempty"`
+}
+
+func newItemCmd() *cobra.Command {
+	cmd := newActionCmd("item [id]", "Use an item from the pet's inventory, or list the inventory", runItem)
+	cmd.Args = cobra.MaximumNArgs(1)
+	return cmd
+}
+
+func runItem(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return listItems(cmd)
+	}
+
+	itemID := args[0]
+	return runAction(cmd, "item:"+itemID, func(pet *game.Pet) game.ActionResult {
+		return pet.UseItem(itemID)
+	})
+}
+
+// listItems prints the items the pet carries.
+func listItems(cmd *cobra.Command) error {
+	pet, err := loadPet()
+	if err != nil {
+		return err
+	}
+
+	items := []itemInfo{}
+	for _, id := range pet.InventoryIDs() {
+		info := itemInfo{ID: id, Name: id, Count: pet.ItemCount(id), Worn: pet.IsEquipped(id)}
+		if item := registry.GetItem(pet.Species, id); item != nil {
+			info.Name = item.Name
+			info.Kind = item.Kind
+			info.Effects = item.Effects
+		}
+		items = append(items, info)
+	}
+
+	jsonFlag, _ := cmd.Flags().GetBool("json")
+	if jsonFlag {
+		data, err := json.MarshalIndent(items, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if len(items) == 0 {
+		fmt.Println(i18nMgr.T("cli.action.no_items"))
+		return nil
+	}
+	for _, it := range items {
+		kind := i18nMgr.T("cli.action.item_kind." + it.Kind)
+		if it.Worn {
+			kind += " " + i18nMgr.T("cli.action.worn")
+		}
+		fmt.Printf("%-16s %s ×%d  %s  %s\n", it.ID, it.Name, it.Count, kind, formatEffects(it.Effects))
+	}
+	return nil
+}
+
+// itemName returns the localized name of an item of the pet's species.
+func itemName(pet *game.Pet, itemID string) string {
+	if item := registry.GetItem(pet.Species, itemID); item != nil {
+		return item.Name
+	}
+	return itemID
+}
+
+// formatEffects renders item effects as "attr +n" pairs, sorted by name.
+func formatEffects(effects map[string]int) string {
+	parts := make([]string, 0, len(effects))
+	for _, name := range slices.Sorted(maps.Keys(effects)) {
+		parts = append(parts, fmt.Sprintf("%s %+d", name, effects[name]))
+	}
+	return strings.Join(parts, "  ")
+}
@@ -0,0 +1,243 @@
+package cli
+
+import (
+	"clipet/internal/daemon"
+	"clipet/internal/store"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func newLogCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "log",
+		Short: "Show the pet's event journal",
+		Long: `Show the pet's event journal.
+
+Time bounds accept a relative age (30m, 12h, 7d), a date (2006-01-02),
+a date and time (2006-01-02 15:04) or RFC 3339.`,
+		Args: cobra.NoArgs,
+		RunE: runLog,
+	}
+	cmd.Flags().StringSliceP("type", "t", nil, "Only show these event types (birth, action, adventure, evolution, decay, crisis, achievement, death, edit)")
+	cmd.Flags().String("since", "", "Only show events at or after this time")
+	cmd.Flags().String("until", "", "Only show events at or before this time")
+	cmd.Flags().IntP("limit", "n", 50, "Show at most this many of the newest events (0 = all)")
+	cmd.Flags().BoolP("json", "j", false, "Output in JSON format")
+	cmd.Flags().BoolP("follow", "f", false, "Keep running and print new events from the daemon")
+	return cmd
+}
+
+func runLog(cmd *cobra.Command, args []string) error {
+	filter, err := logFilterFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	events, err := store.JournalFor(petStore).Events(filter)
+	if err != nil {
+		return err
+	}
+
+	jsonFlag, _ := cmd.Flags().GetBool("json")
+	if follow, _ := cmd.Flags().GetBool("follow"); follow {
+		return followLog(events, filter, jsonFlag)
+	}
+	if jsonFlag {
+		if events == nil {
+			events = []store.Event{}
+		}
+		data, err := json.MarshalIndent(events, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if len(events) == 0 {
+		fmt.Println(i18nMgr.T("cli.log.empty"))
+		return nil
+	}
+	for _, e := range events {
+		fmt.Println(formatEventLine(e))
+	}
+	return nil
+}
+
+// followLog prints the already recorded events, then streams new ones from
+// the daemon until it stops. In JSON mode events are written one per line.
+func followLog(events []store.Event, filter store.EventFilter, jsonMode bool) error {
+	client := dialDaemon()
+	if client == nil {
+		return errors.New(i18nMgr.T("cli.daemon.not_running"))
+	}
+	defer client.Close()
+	if err := client.Subscribe(); err != nil {
+		return daemonError(err)
+	}
+
+	emit := func(e store.Event) error {
+		if !jsonMode {
+			fmt.Println(formatEventLine(e))
+			return nil
+		}
+		data, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	for _, e := range events {
+		if err := emit(e); err != nil {
+			return err
+		}
+	}
+	for {
+		method, params, err := client.Next()
+		if err != nil {
+			// The daemon shut down.
+			return nil
+		}
+		if method != daemon.NotifyEvent {
+			continue
+		}
+		var e store.Event
+		if err := json.Unmarshal(params, &e); err != nil {
+			continue
+		}
+		if !filter.Match(e) {
+			continue
+		}
+		if err := emit(e); err != nil {
+			return err
+		}
+	}
+}
+
+// logFilterFromFlags builds a journal filter from the log command flags.
+func logFilterFromFlags(cmd *cobra.Command) (store.EventFilter, error) {
+	var filter store.EventFilter
+	now := time.Now()
+
+	types, _ := cmd.Flags().GetStringSlice("type")
+	for _, t := range types {
+		typ := store.EventType(strings.ToLower(strings.TrimSpace(t)))
+		if !isKnownEventType(typ) {
+			return filter, errors.New(i18nMgr.T("cli.log.unknown_type", "type", t))
+		}
+		filter.Types = append(filter.Types, typ)
+	}
+
+	var err error
+	if s, _ := cmd.Flags().GetString("since"); s != "" {
+		if filter.Since, err = parseTimeArg(s, now); err != nil {
+			return filter, errors.New(i18nMgr.T("cli.log.invalid_time", "value", s))
+		}
+	}
+	if s, _ := cmd.Flags().GetString("until"); s != "" {
+		if filter.Until, err = parseTimeArg(s, now); err != nil {
+			return filter, errors.New(i18nMgr.T("cli.log.invalid_time", "value", s))
+		}
+	}
+
+	filter.Limit, _ = cmd.Flags().GetInt("limit")
+	return filter, nil
+}
+
+func isKnownEventType(t store.EventType) bool {
+	for _, known := range store.EventTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTimeArg parses an absolute time or an age relative to now.
+func parseTimeArg(s string, now time.Time) (time.Time, error) {
+	if strings.HasSuffix(s, "d") {
+		if days, err := strconv.ParseFloat(strings.TrimSuffix(s, "d"), 64); err == nil {
+			return now.Add(-time.Duration(days * 24 * float64(time.Hour))), nil
+		}
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return now.Add(-d), nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q", s)
+}
+
+// formatEventLine renders one journal event as a single line of text.
+func formatEventLine(e store.Event) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s  %-5s %s", e.Time.Local().Format("2006-01-02 15:04"), "["+string(e.Source)+"]",
+		i18nMgr.T("cli.log.types."+string(e.Type)))
+
+	switch e.Type {
+	case store.EventBirth:
+		b.WriteString("  " + i18nMgr.T("cli.log.birth", "name", e.Detail, "species", e.Subject))
+	case store.EventAction:
+		b.WriteString("  " + e.Subject)
+		if !e.OK {
+			b.WriteString(" ✗")
+			if e.Detail != "" {
+				b.WriteString(" (" + e.Detail + ")")
+			}
+		}
+	case store.EventAdventure:
+		b.WriteString("  " + e.Subject)
+		if e.Detail != "" {
+			b.WriteString(" — " + e.Detail)
+		}
+	case store.EventEvolution:
+		b.WriteString("  " + e.Detail + " → " + e.Subject)
+	case store.EventDecay:
+		b.WriteString("  " + i18nMgr.T("cli.log.offline", "duration", e.Detail))
+	case store.EventCrisis:
+		b.WriteString("  " + e.Subject + " " + i18nMgr.T("cli.log.crisis."+e.Detail))
+	case store.EventAchievement:
+		name := e.Detail
+		if a := registry.GetAchievement(e.Subject); a != nil {
+			name, _ = achievementText(*a)
+		}
+		b.WriteString("  🏆 " + name)
+	case store.EventDeath:
+		b.WriteString("  " + e.Detail)
+	case store.EventEdit:
+		b.WriteString("  " + e.Subject + ": " + e.Detail)
+	}
+
+	if len(e.Changes) > 0 {
+		b.WriteString("  " + formatChanges(e.Changes))
+	}
+	return b.String()
+}
+
+// formatChanges renders an attribute change map as "attr old→new" pairs, sorted by name.
+func formatChanges(changes map[string][2]int) string {
+	names := make([]string, 0, len(changes))
+	for name := range changes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		ch := changes[name]
+		parts = append(parts, fmt.Sprintf("%s %d→%d", name, ch[0], ch[1]))
+	}
+	return strings.Join(parts, "  ")
+}
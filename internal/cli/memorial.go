@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"clipet/internal/store"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+func newMemorialCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "memorial",
+		Short: "Remember the pets of the active profile that have passed away",
+		Args:  cobra.NoArgs,
+		RunE:  runMemorial,
+	}
+	cmd.Flags().BoolP("json", "j", false, "Output in JSON format")
+	return cmd
+}
+
+func runMemorial(cmd *cobra.Command, args []string) error {
+	entries, err := profileMgr.Memorial(activeProfile).Entries()
+	if err != nil {
+		return err
+	}
+
+	jsonFlag, _ := cmd.Flags().GetBool("json")
+	if jsonFlag {
+		if entries == nil {
+			entries = []store.MemorialEntry{}
+		}
+		data, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if len(entries) == 0 {
+		fmt.Println(i18nMgr.T("cli.memorial.empty"))
+		return nil
+	}
+	fmt.Println(i18nMgr.T("cli.memorial.title", "profile", activeProfile))
+	for _, e := range entries {
+		species, stage := e.Species, e.StageID
+		if sp := registry.GetSpecies(e.Species); sp != nil {
+			species = sp.Species.Name
+		}
+		if s := registry.GetStage(e.Species, e.StageID); s != nil {
+			stage = s.Name
+		}
+		fmt.Println(i18nMgr.T("cli.memorial.entry",
+			"name", e.Name, "generation", e.Generation, "species", species,
+			"stage", stage, "lifespan", formatDuration(e.Lifespan())))
+		fmt.Println(i18nMgr.T("cli.memorial.dates",
+			"born", e.Born.Local().Format("2006-01-02"), "died", e.Died.Local().Format("2006-01-02"),
+			"ending", endingText(e.Species, e.EndingType, e.EndingMessage)))
+		fmt.Println(i18nMgr.T("cli.memorial.stats",
+			"interactions", e.Stats["interactions"], "adventures", e.Stats["adventures"],
+			"games_won", e.Stats["games_won"], "dialogues", e.Stats["dialogues"]))
+		if e.Successor != "" {
+			fmt.Println(i18nMgr.T("cli.memorial.successor", "name", e.Successor))
+		}
+	}
+	return nil
+}
+
+// endingText returns the localized text of an ending: the species' own
+// message, the built-in one or the message saved with the pet. Pets that
+// died without an ending simply passed away.
+func endingText(species, endingType, message string) string {
+	if endingType == "" {
+		return i18nMgr.T("cli.memorial.no_ending")
+	}
+	if msg := registry.GetEndingMessage(species, endingType); msg != "" {
+		return msg
+	}
+	if msg := i18nMgr.T("game.endings." + endingType); !strings.HasPrefix(msg, "game.endings.") {
+		return msg
+	}
+	if message != "" {
+		return message
+	}
+	return i18nMgr.T("game.endings.peaceful_rest")
+}
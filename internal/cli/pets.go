@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"clipet/internal/game"
+	"clipet/internal/store"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// petEntry describes one household pet for `clipet pets` listings.
+type petEntry struct {
+	Slot    string `json:"slot"`
+	Name    string `json:"name"`
+	Species string `json:"species"`
+	Stage   string `json:"stage"`
+	Alive   bool   `json:"alive"`
+	Active  bool   `json:"active"`
+	Error   string `json:"error,omitempty"` // the slot's save cannot be read
+}
+
+func newPetsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pets",
+		Short: "List the pets of the active profile's household",
+		Args:  cobra.NoArgs,
+		RunE:  runPets,
+	}
+	cmd.Flags().BoolP("json", "j", false, "Output in JSON format")
+	return cmd
+}
+
+func runPets(cmd *cobra.Command, args []string) error {
+	infos, err := profileMgr.Pets(activeProfile)
+	if err != nil {
+		return err
+	}
+
+	pets := []petEntry{}
+	for _, p := range infos {
+		e := petEntry{
+			Slot:    p.Slot,
+			Name:    p.Name,
+			Species: p.Species,
+			Stage:   p.StageID,
+			Alive:   p.Alive,
+			Active:  p.Slot == activePet,
+		}
+		if p.Err != nil {
+			e.Error = p.Err.Error()
+		}
+		pets = append(pets, e)
+	}
+
+	jsonFlag, _ := cmd.Flags().GetBool("json")
+	if jsonFlag {
+		data, err := json.MarshalIndent(pets, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if len(pets) == 0 {
+		fmt.Println(i18nMgr.T("cli.status.no_pet"))
+		return nil
+	}
+	fmt.Println(i18nMgr.T("cli.pets.list_title", "profile", activeProfile))
+	damaged := false
+	for _, p := range pets {
+		marker := " "
+		if p.Active {
+			marker = "*"
+		}
+		if p.Error != "" {
+			damaged = true
+			fmt.Println(i18nMgr.T("cli.pets.list_entry_damaged", "marker", marker,
+				"path", profileMgr.PetDir(activeProfile, p.Slot), "error", p.Error))
+			continue
+		}
+		key := "cli.pets.list_entry"
+		if !p.Alive {
+			key = "cli.pets.list_entry_dead"
+		}
+		fmt.Println(i18nMgr.T(key, "marker", marker, "name", p.Name, "species", p.Species, "stage", p.Stage))
+	}
+	if damaged {
+		fmt.Println(i18nMgr.T("cli.doctor.hint"))
+	}
+	return nil
+}
+
+// loadPair loads the active pet and the housemate named ref for an action
+// between the two. Pets served by a daemon are loaded and saved through it.
+// accumulated reports for each pet whether loading it accumulated offline
+// time, so it needs saving even if the action is rejected.
+func loadPair(ref string) (pet, partner *game.Pet, partnerStore store.Store, accumulated [2]bool, err error) {
+	info, err := profileMgr.FindPet(activeProfile, ref)
+	if err != nil {
+		return nil, nil, nil, accumulated, errors.New(i18nMgr.T("cli.pet.not_found", "name", ref, "profile", activeProfile))
+	}
+	if info.Slot == activePet {
+		return nil, nil, nil, accumulated, errors.New(i18nMgr.T("cli.pets.pair_self"))
+	}
+	petStore = servedStore(petStore)
+	pet, accumulated[0], err = loadActivePet()
+	if err != nil {
+		return nil, nil, nil, accumulated, err
+	}
+	partnerStore, err = profileMgr.OpenPet(activeProfile, info.Slot, store.Backend(cfg.StoreBackend))
+	if err != nil {
+		return nil, nil, nil, accumulated, fmt.Errorf("init store: %w", err)
+	}
+	partnerStore = servedStore(partnerStore)
+	partner, accumulated[1], err = readPet(partnerStore)
+	if err != nil {
+		return nil, nil, nil, accumulated, err
+	}
+	return pet, partner, partnerStore, accumulated, nil
+}
@@ -1,44 +1,73 @@
 package cli
 
 import (
+	"clipet/internal/game"
+	"clipet/internal/store"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
 func newPlayCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:   "play",
-		Short: "和宠物玩耍",
-		RunE:  runPlay,
-	}
+	cmd := newActionCmd("play", "Play with the pet", runPlay)
+	cmd.Flags().String("with", "", "Let the pet play with another household pet of this name")
+	return cmd
 }
 
 func runPlay(cmd *cobra.Command, args []string) error {
-	pet, err := loadPet()
-	if err != nil {
-		return err
+	if with, _ := cmd.Flags().GetString("with"); with != "" {
+		return runPlayWith(cmd, with)
 	}
+	return runAction(cmd, "play", func(pet *game.Pet) game.ActionResult {
+		return pet.Play()
+	})
+}
 
-	if !pet.Alive {
-		return fmt.Errorf("你的宠物已经不在了... 😢")
+// runPlayWith lets the active pet play with a housemate.
+func runPlayWith(cmd *cobra.Command, ref string) error {
+	pet, partner, partnerStore, accumulated, err := loadPair(ref)
+	if err != nil {
+		return err
 	}
 
-	// Apply offline decay first
-	pet.AccumulateOfflineTime()
+	now := time.Now()
+	res, partnerRes := game.PlayTogether(pet, partner)
+	_ = store.History(petStore, store.SourceCLI).RecordAction(now, pet, "play:"+partner.Name, res)
+	_ = store.History(partnerStore, store.SourceCLI).RecordAction(now, partner, "play:"+pet.Name, partnerRes)
 
-	res := pet.Play()
-	if !res.OK {
-		fmt.Printf("play: %s\n", res.Message)
-		return nil
+	if partnerRes.OK || accumulated[1] {
+		if err := partnerStore.Save(partner); err != nil {
+			return fmt.Errorf("save pet: %w", err)
+		}
 	}
-
-	if err := petStore.Save(pet); err != nil {
-		return fmt.Errorf("保存失败: %w", err)
+	report := playReport(pet, partner, res)
+	report.Partner = playReport(partner, pet, partnerRes)
+	report.Partner.Pet = partner.Name
+	if res.ErrorType == game.ErrPartnerBusy {
+		report.Message = i18nMgr.T("cli.pets.partner_busy", "partner", partner.Name, "reason", report.Partner.Message)
+	}
+	if partnerRes.OK {
+		report.Partner.Evolution = autoEvolveIn(partnerStore, partner)
 	}
+	report.Partner.Achievements = achievementReports(partner.TakeAchievements())
+	return finishAction(cmd, pet, accumulated[0], report)
+}
 
-	chH := res.Changes["happiness"]
-	fmt.Printf("play: happiness %d -> %d, energy %d\n", chH[0], chH[1], pet.Energy)
-	checkAndReportEvolution(pet)
-	return nil
+// playReport builds the report of pet playing with partner.
+func playReport(pet, partner *game.Pet, res game.ActionResult) *actionReport {
+	report := &actionReport{
+		Action:    "play:" + partner.Name,
+		OK:        res.OK,
+		ErrorType: res.ErrorType,
+		Changes:   res.Changes,
+		Animation: string(res.Animation),
+		Crises:    res.Crises,
+	}
+	if res.OK {
+		report.Message = i18nMgr.T("cli.action.success.play_with", "name", pet.Name, "partner", partner.Name)
+	} else {
+		report.Message = localizeActionError(res.ErrorType, res.Message)
+	}
+	return report
 }
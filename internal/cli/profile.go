@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"clipet/internal/store"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func newProfileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "profile",
+		Short: "Manage save profiles",
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "list",
+		Short: "List save profiles",
+		Args:  cobra.NoArgs,
+		RunE:  runProfileList,
+	})
+	cmd.AddCommand(&cobra.Command{
+		Use:   "new <name>",
+		Short: "Create a new profile and switch to it",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runProfileNew,
+	})
+	cmd.AddCommand(&cobra.Command{
+		Use:   "switch <name>",
+		Short: "Switch the active profile",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runProfileSwitch,
+	})
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a profile and its save",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runProfileDelete,
+	}
+	deleteCmd.Flags().BoolP("yes", "y", false, "Skip confirmation")
+	cmd.AddCommand(deleteCmd)
+
+	return cmd
+}
+
+func runProfileList(cmd *cobra.Command, args []string) error {
+	list, err := profileMgr.List()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(i18nMgr.T("cli.profile.list_title"))
+	for _, p := range list {
+		marker := " "
+		if p.Name == activeProfile {
+			marker = "*"
+		}
+
+		switch {
+		case !p.HasPet:
+			fmt.Println(i18nMgr.T("cli.profile.list_entry_empty", "marker", marker, "name", p.Name))
+		case !p.Alive:
+			fmt.Println(i18nMgr.T("cli.profile.list_entry_dead",
+				"marker", marker, "name", p.Name, "pet", p.PetName, "species", p.Species, "stage", p.StageID))
+		default:
+			fmt.Println(i18nMgr.T("cli.profile.list_entry_pet",
+				"marker", marker, "name", p.Name, "pet", p.PetName, "species", p.Species, "stage", p.StageID))
+		}
+	}
+	return nil
+}
+
+func runProfileNew(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	if err := profileMgr.Create(name); err != nil {
+		return err
+	}
+	if err := switchProfile(name); err != nil {
+		return err
+	}
+
+	fmt.Println(i18nMgr.T("cli.profile.created", "name", name))
+	fmt.Println(i18nMgr.T("cli.profile.init_hint"))
+	return nil
+}
+
+func runProfileSwitch(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	if err := store.ValidateProfileName(name); err != nil {
+		return err
+	}
+	if !profileMgr.Exists(name) {
+		return errors.New(i18nMgr.T("cli.profile.not_found", "name", name))
+	}
+	if name == cfg.ActiveProfile {
+		fmt.Println(i18nMgr.T("cli.profile.already_active", "name", name))
+		return nil
+	}
+
+	if err := switchProfile(name); err != nil {
+		return err
+	}
+	fmt.Println(i18nMgr.T("cli.profile.switched", "name", name))
+	return nil
+}
+
+func runProfileDelete(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	if err := store.ValidateProfileName(name); err != nil {
+		return err
+	}
+	if !profileMgr.Exists(name) {
+		return errors.New(i18nMgr.T("cli.profile.not_found", "name", name))
+	}
+	if name == activeProfile {
+		return errors.New(i18nMgr.T("cli.profile.delete_active", "name", name))
+	}
+
+	yes, _ := cmd.Flags().GetBool("yes")
+	if !yes {
+		fmt.Print(i18nMgr.T("cli.profile.delete_confirm", "name", name))
+		var answer string
+		fmt.Scanln(&answer)
+		if answer != "y" && answer != "Y" {
+			fmt.Println(i18nMgr.T("cli.profile.cancelled"))
+			return nil
+		}
+	}
+
+	if err := profileMgr.Delete(name); err != nil {
+		return err
+	}
+	fmt.Println(i18nMgr.T("cli.profile.deleted", "name", name))
+	return nil
+}
+
+// switchProfile makes name the active profile, reopens the store and
+// remembers the choice in the user config.
+func switchProfile(name string) error {
+	slot, err := firstPetSlot(name)
+	if err != nil {
+		return err
+	}
+	st, err := profileMgr.OpenPet(name, slot, store.Backend(cfg.StoreBackend))
+	if err != nil {
+		return err
+	}
+	petStore = st
+	activeProfile = name
+	activePet = slot
+
+	if err := cfg.SetActiveProfile(name); err != nil {
+		return fmt.Errorf("save config: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package cli
+
+import (
+	"clipet/internal/config"
+	"clipet/internal/store"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// defaultPromptFormat is the segment printed by `clipet prompt`.
+const defaultPromptFormat = "{emoji}{mood}{warn}"
+
+// promptLowThreshold is the attribute value below which the segment warns.
+const promptLowThreshold = 20
+
+// promptMoodGlyphs maps game.Pet.MoodName values to prompt glyphs.
+var promptMoodGlyphs = map[string]string{
+	"happy":     "😊",
+	"normal":    "😐",
+	"unhappy":   "😕",
+	"sad":       "😢",
+	"miserable": "😭",
+}
+
+// promptSnippets are the shell integrations printed by `clipet prompt init`.
+var promptSnippets = map[string]string{
+	"bash": `# clipet prompt segment. Add to ~/.bashrc:
+#   eval "$(clipet prompt init bash)"
+__clipet_prompt() { CLIPET_SEGMENT="$(clipet prompt 2>/dev/null)"; }
+[[ $PROMPT_COMMAND == *__clipet_prompt* ]] || PROMPT_COMMAND="__clipet_prompt${PROMPT_COMMAND:+;$PROMPT_COMMAND}"
+[[ $PS1 == *CLIPET_SEGMENT* ]] || PS1='${CLIPET_SEGMENT:+$CLIPET_SEGMENT }'"$PS1"
+`,
+	"zsh": `# clipet prompt segment. Add to ~/.zshrc:
+#   eval "$(clipet prompt init zsh)"
+setopt prompt_subst
+autoload -Uz add-zsh-hook
+__clipet_prompt() { CLIPET_SEGMENT="$(clipet prompt 2>/dev/null)" }
+add-zsh-hook precmd __clipet_prompt
+[[ $PROMPT == *CLIPET_SEGMENT* ]] || PROMPT='${CLIPET_SEGMENT:+$CLIPET_SEGMENT }'"$PROMPT"
+`,
+	"fish": `# clipet prompt segment. Add to ~/.config/fish/config.fish:
+#   clipet prompt init fish | source
+functions -q __clipet_fish_prompt; or functions -c fish_prompt __clipet_fish_prompt
+function fish_prompt
+    set -l segment (clipet prompt 2>/dev/null)
+    test -n "$segment"; and echo -n "$segment "
+    __clipet_fish_prompt
+end
+`,
+	"starship": `# clipet prompt segment. Add to ~/.config/starship.toml
+# and put ${custom.clipet} in your format (or keep $all):
+[custom.clipet]
+description = "Clipet pet status"
+command = "clipet prompt"
+when = true
+shell = ["sh"]
+format = "$output "
+`,
+}
+
+func newPromptCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prompt",
+		Short: "Print a compact pet status segment for shell prompts",
+		Long: `Print a compact pet status segment for shell prompts.
+
+The segment is read from the status cache written on every save, so it does
+not load species packs or verify the save. It prints nothing when there is
+no pet yet.
+
+Format placeholders: {emoji} {mood} {warn} {name} {stage} {hunger}
+{happiness} {health} {energy}.
+
+Use "clipet prompt init <bash|zsh|fish|starship>" for a ready-made snippet.`,
+		Args: cobra.NoArgs,
+		// Skip the full setup: prompts run on every command line.
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error { return nil },
+		RunE:              runPrompt,
+	}
+	cmd.Flags().StringP("format", "f", defaultPromptFormat, "Segment format")
+	cmd.AddCommand(newPromptInitCmd())
+	return cmd
+}
+
+func newPromptInitCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:       "init <bash|zsh|fish|starship>",
+		Short:     "Print the prompt integration snippet for a shell or starship",
+		ValidArgs: []string{"bash", "zsh", "fish", "starship"},
+		Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fmt.Print(promptSnippets[args[0]])
+			return nil
+		},
+	}
+}
+
+func runPrompt(cmd *cobra.Command, args []string) error {
+	status, err := readPromptStatus()
+	if err != nil {
+		// A prompt must never break the shell: no pet, no segment.
+		return nil
+	}
+	format, _ := cmd.Flags().GetString("format")
+	fmt.Println(formatPromptSegment(status, format))
+	return nil
+}
+
+// readPromptStatus resolves the profile and pet the way setup does, but only
+// reads the config file and the pet's status cache.
+func readPromptStatus() (*store.PetStatus, error) {
+	profile := profileFlag
+	if profile == "" {
+		if c, err := config.Load(); err == nil && c.ActiveProfile != "" {
+			profile = c.ActiveProfile
+		}
+	}
+	if profile == "" {
+		profile = store.DefaultProfile
+	}
+
+	dir, err := store.PetStatusDir("", profile, petFlag)
+	if err != nil {
+		return nil, err
+	}
+	return store.ReadStatus(dir)
+}
+
+// formatPromptSegment expands the format placeholders for status.
+func formatPromptSegment(s *store.PetStatus, format string) string {
+	emoji := s.Emoji
+	if s.Phase == "egg" {
+		emoji = "🥚"
+	}
+	if emoji == "" {
+		emoji = "🐾"
+	}
+
+	mood := promptMoodGlyphs[s.Mood]
+	var warn string
+	if !s.Alive {
+		emoji, mood = "🪦", ""
+	} else {
+		if s.Hunger < promptLowThreshold {
+			warn += "🍖"
+		}
+		if s.Health < promptLowThreshold {
+			warn += "🩹"
+		}
+	}
+
+	return strings.NewReplacer(
+		"{emoji}", emoji,
+		"{mood}", mood,
+		"{warn}", warn,
+		"{name}", s.Name,
+		"{stage}", s.StageID,
+		"{hunger}", strconv.Itoa(s.Hunger),
+		"{happiness}", strconv.Itoa(s.Happiness),
+		"{health}", strconv.Itoa(s.Health),
+		"{energy}", strconv.Itoa(s.Energy),
+	).Replace(format)
+}
@@ -1,8 +1,8 @@
 package cli
 
 import (
+	"clipet/internal/store"
 	"fmt"
-	"os"
 
 	"github.com/spf13/cobra"
 )
@@ -34,7 +34,17 @@ func runReset(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	if err := os.Remove(petStore.Path()); err != nil {
+	if snapper, ok := petStore.(store.Snapshotter); ok {
+		snap, err := snapper.Snapshot("reset")
+		if err != nil {
+			return fmt.Errorf("snapshot failed: %w", err)
+		}
+		if snap != nil {
+			fmt.Printf("snapshot saved: %s (undo with 'clipet restore')\n", snap.ID)
+		}
+	}
+
+	if err := petStore.Delete(); err != nil {
 		return fmt.Errorf("delete failed: %w", err)
 	}
 	fmt.Println("save deleted")
@@ -0,0 +1,17 @@
+package cli
+
+import (
+	"clipet/internal/game"
+
+	"github.com/spf13/cobra"
+)
+
+func newRestCmd() *cobra.Command {
+	return newActionCmd("rest", "Let the pet rest", runRest)
+}
+
+func runRest(cmd *cobra.Command, args []string) error {
+	return runAction(cmd, "rest", func(pet *game.Pet) game.ActionResult {
+		return pet.Rest()
+	})
+}
@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"clipet/internal/store"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+)
+
+func newRestoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore [number|id]",
+		Short: "List save snapshots or roll back to one",
+		Long: `List save snapshots or roll back to one.
+
+Without arguments, lists the snapshots of the active profile, newest first.
+With a list number or snapshot ID, replaces the current save with that
+snapshot. The current save is snapshotted first, so a restore can be undone.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: runRestore,
+	}
+	cmd.Flags().BoolP("yes", "y", false, "Skip confirmation")
+	return cmd
+}
+
+func runRestore(cmd *cobra.Command, args []string) error {
+	snapper, ok := petStore.(store.Snapshotter)
+	if !ok {
+		return errors.New(i18nMgr.T("cli.restore.unsupported"))
+	}
+
+	list, err := snapper.Snapshots()
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 {
+		return listSnapshots(snapper, list)
+	}
+
+	snap, err := findSnapshot(list, args[0])
+	if err != nil {
+		return err
+	}
+	pet, err := snapper.LoadSnapshot(snap.ID)
+	if err != nil {
+		return err
+	}
+
+	yes, _ := cmd.Flags().GetBool("yes")
+	if !yes {
+		fmt.Print(i18nMgr.T("cli.restore.confirm",
+			"id", snap.ID, "pet", pet.Name, "stage", pet.StageID))
+		var answer string
+		fmt.Scanln(&answer)
+		if answer != "y" && answer != "Y" {
+			fmt.Println(i18nMgr.T("cli.restore.cancelled"))
+			return nil
+		}
+	}
+
+	if err := snapper.Restore(snap.ID); err != nil {
+		return err
+	}
+	fmt.Println(i18nMgr.T("cli.restore.restored", "id", snap.ID, "pet", pet.Name, "stage", pet.StageID))
+	return nil
+}
+
+// listSnapshots prints the numbered snapshot list.
+func listSnapshots(snapper store.Snapshotter, list []store.Snapshot) error {
+	if len(list) == 0 {
+		fmt.Println(i18nMgr.T("cli.restore.empty"))
+		return nil
+	}
+
+	fmt.Println(i18nMgr.T("cli.restore.list_title", "profile", activeProfile))
+	for i, snap := range list {
+		summary := i18nMgr.T("cli.restore.unreadable")
+		if pet, err := snapper.LoadSnapshot(snap.ID); err == nil {
+			summary = fmt.Sprintf("%s (%s · %s)", pet.Name, pet.Species, pet.StageID)
+			if !pet.Alive {
+				summary += " " + i18nMgr.T("cli.restore.deceased")
+			}
+		}
+		fmt.Printf("%3d  %s  %-8s %s\n", i+1, snap.Time.Format("2006-01-02 15:04:05"), snap.Kind, summary)
+	}
+	fmt.Println(i18nMgr.T("cli.restore.list_hint"))
+	return nil
+}
+
+// findSnapshot resolves a list number (1 = newest) or snapshot ID.
+func findSnapshot(list []store.Snapshot, arg string) (*store.Snapshot, error) {
+	if n, err := strconv.Atoi(arg); err == nil {
+		if n < 1 || n > len(list) {
+			return nil, errors.New(i18nMgr.T("cli.restore.not_found", "id", arg))
+		}
+		return &list[n-1], nil
+	}
+	for i := range list {
+		if list[i].ID == arg {
+			return &list[i], nil
+		}
+	}
+	return nil, errors.New(i18nMgr.T("cli.restore.not_found", "id", arg))
+}
@@ -7,22 +7,33 @@ import (
 	"clipet/internal/game"
 	"clipet/internal/game/capabilities"
 	"clipet/internal/i18n"
+	"clipet/internal/notify"
 	"clipet/internal/plugin"
 	"clipet/internal/store"
+	"cmp"
+	"errors"
 	"fmt"
+	"log"
 	"os"
 	"path/filepath"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/spf13/cobra"
 )
 
 var (
-	registry         *plugin.Registry
-	capabilitiesReg  *capabilities.Registry
-	petStore         *store.JSONStore
+	registry        *plugin.Registry
+	capabilitiesReg *capabilities.Registry
+	petStore        store.Store
 	i18nMgr         *i18n.Manager
 	cfg             *config.Config
+	profileMgr      *store.ProfileManager
+	activeProfile   string
+	profileFlag     string
+	activePet       string // pet slot of the active profile, see store.ProfileManager.PetDir
+	petFlag         string
 )
 
 // NewRootCmd creates the root cobra command.
@@ -42,9 +53,37 @@ func NewRootCmd() *cobra.Command {
 		SilenceErrors: true,
 	}
 
+	root.PersistentFlags().StringVar(&profileFlag, "profile", "", "Use the given save profile for this run")
+	root.PersistentFlags().StringVar(&petFlag, "pet", "", "Act on the household pet with this name")
+
 	root.AddCommand(newInitCmd())
+	root.AddCommand(newAdoptCmd())
+	root.AddCommand(newPetsCmd())
+	root.AddCommand(newBreedCmd())
+	root.AddCommand(newSuccessorCmd())
+	root.AddCommand(newMemorialCmd())
+	root.AddCommand(newAchievementsCmd())
 	root.AddCommand(newStatusCmd())
+	root.AddCommand(newFeedCmd())
+	root.AddCommand(newPlayCmd())
+	root.AddCommand(newRestCmd())
+	root.AddCommand(newHealCmd())
+	root.AddCommand(newTalkCmd())
+	root.AddCommand(newSkillCmd())
+	root.AddCommand(newItemCmd())
+	root.AddCommand(newShopCmd())
+	root.AddCommand(newEquipCmd())
+	root.AddCommand(newUnequipCmd())
+	root.AddCommand(newAdventureCmd())
 	root.AddCommand(newResetCmd())
+	root.AddCommand(newProfileCmd())
+	root.AddCommand(newLogCmd())
+	root.AddCommand(newRestoreCmd())
+	root.AddCommand(newDoctorCmd())
+	root.AddCommand(newExportCmd())
+	root.AddCommand(newImportCmd())
+	root.AddCommand(newPromptCmd())
+	root.AddCommand(newDaemonCmd())
 
 	return root
 }
@@ -85,6 +124,13 @@ func setup() error {
 		return fmt.Errorf("load builtin packs: %w", err)
 	}
 
+	// Load builtin achievements
+	achievements, err := plugin.ParseAchievements(assets.AchievementsFS, ".")
+	if err != nil {
+		return fmt.Errorf("load builtin achievements: %w", err)
+	}
+	registry.SetBuiltinAchievements(achievements)
+
 	// Load external plugins
 	home, err := os.UserHomeDir()
 	if err == nil {
@@ -109,68 +155,319 @@ func setup() error {
 	// Initialize time system with registries
 	game.InitTimeSystem(registry, capabilitiesReg)
 
-	// Initialize store
-	petStore, err = store.NewJSONStore("")
+	// Initialize profile-aware store
+	profileMgr, err = store.NewProfileManager("")
+	if err != nil {
+		return fmt.Errorf("init profiles: %w", err)
+	}
+
+	activeProfile = resolveProfile()
+	activePet, err = resolvePet()
+	if err != nil {
+		return err
+	}
+	petStore, err = profileMgr.OpenPet(activeProfile, activePet, store.Backend(cfg.StoreBackend))
 	if err != nil {
 		return fmt.Errorf("init store: %w", err)
 	}
 
+	setupNotify()
+
 	return nil
 }
 
-// runTUI launches the Bubble Tea TUI application.
-func runTUI() error {
-	pet, err := loadPet()
+// notifyFlushTimeout bounds how long a command waits on exit for queued
+// notifications, enough for one notification command to finish.
+const notifyFlushTimeout = 10 * time.Second
+
+// setupNotify registers the notification time hook when the config enables
+// a notifier. A bad notify section is reported but does not stop the command.
+// Condition state is kept per pet slot, in notify.json in the slot's
+// directory. Queued notifications are flushed when the command finishes.
+func setupNotify() {
+	statePath := func(slot string) string {
+		return filepath.Join(profileMgr.PetDir(activeProfile, slot), notify.StateFileName)
+	}
+	d, err := notify.FromConfig(cfg.Notify, statePath, log.New(os.Stderr, "clipet: ", 0))
 	if err != nil {
-		return err
+		fmt.Fprintln(os.Stderr, i18nMgr.T("cli.notify.config_error", "error", err.Error()))
+		return
+	}
+	if d == nil {
+		return
 	}
+	game.RegisterTimeHook(notify.NewHook(d, registry, petSlot, func(c notify.Condition, pet *game.Pet) string {
+		return i18nMgr.T("cli.notify."+string(c), "name", pet.Name)
+	}), game.PriorityObserver)
+	cobra.OnFinalize(func() { d.Flush(notifyFlushTimeout) })
+}
 
-	// Apply accumulated offline duration and collect results
-	var offlineResults []game.DecayRoundResult
-	if pet.AccumulatedOfflineDuration > 0 {
-		dur := pet.AccumulatedOfflineDuration
+// petSlots caches the household slot of each pet name for petSlot. Time
+// hooks run on the daemon's and the TUI's goroutines, so it is locked.
+var (
+	petSlotsMu sync.Mutex
+	petSlots   = map[string]string{}
+)
 
-		// Adjust age
-		pet.Birthday = pet.Birthday.Add(-dur)
+// petSlot returns the household slot of pet, found by its name (names are
+// unique within a household). Unknown pets map to the active slot.
+func petSlot(pet *game.Pet) string {
+	petSlotsMu.Lock()
+	defer petSlotsMu.Unlock()
+	if slot, ok := petSlots[pet.Name]; ok {
+		return slot
+	}
+	slot := activePet
+	if info, err := profileMgr.FindPet(activeProfile, pet.Name); err == nil {
+		slot = info.Slot
+	}
+	petSlots[pet.Name] = slot
+	return slot
+}
+
+// resolveProfile picks the profile for this run.
+// Priority: --profile flag > config active profile > default profile.
+func resolveProfile() string {
+	if profileFlag != "" {
+		return profileFlag
+	}
+	if cfg.ActiveProfile != "" {
+		if profileMgr.Exists(cfg.ActiveProfile) {
+			return cfg.ActiveProfile
+		}
+		fmt.Fprintln(os.Stderr, i18nMgr.T("cli.profile.fallback_default", "name", cfg.ActiveProfile))
+	}
+	return store.DefaultProfile
+}
+
+// resolvePet picks the pet slot for this run: the pet named by --pet, else
+// the first pet of the household.
+func resolvePet() (string, error) {
+	if petFlag != "" {
+		info, err := profileMgr.FindPet(activeProfile, petFlag)
+		if err != nil {
+			return "", errors.New(i18nMgr.T("cli.pet.not_found", "name", petFlag, "profile", activeProfile))
+		}
+		return info.Slot, nil
+	}
+	return firstPetSlot(activeProfile)
+}
 
-		// Multi-stage settlement
-		offlineResults = pet.ApplyMultiStageDecay(dur)
+// firstPetSlot returns the slot of the first pet of a profile's household,
+// or the profile's own slot if it has no pet yet. A slot with a damaged save
+// counts as a pet, so loading it fails and points at clipet doctor instead of
+// silently moving on to a housemate.
+func firstPetSlot(profile string) (string, error) {
+	pets, err := profileMgr.Pets(profile)
+	if err != nil || len(pets) == 0 {
+		return "", err
+	}
+	return pets[0].Slot, nil
+}
+
+// petDir returns the directory of the active pet.
+func petDir() string {
+	return profileMgr.PetDir(activeProfile, activePet)
+}
 
-		// Trigger time hooks (lifecycle, death check, etc.)
-		pet.AdvanceTime(dur)
+// runTUI launches the Bubble Tea TUI application.
+func runTUI() error {
+	// Let the user pick a slot when several exist and none was forced; a
+	// --pet already names a pet of the active profile
+	if profileFlag == "" && petFlag == "" {
+		profiles, err := profileMgr.List()
+		if err != nil {
+			return err
+		}
+		if len(profiles) > 1 {
+			name, err := pickProfile(profiles, activeProfile)
+			if err != nil {
+				return err
+			}
+			if name == "" {
+				return nil
+			}
+			if name != activeProfile {
+				if err := switchProfile(name); err != nil {
+					return err
+				}
+			}
+		}
+	}
 
-		// Clear cache
-		pet.AccumulatedOfflineDuration = 0
-		pet.LastCheckedAt = time.Now()
+	household, focus, err := loadHousehold()
+	if err != nil {
+		return err
+	}
 
-		// Save state
-		if err := petStore.Save(pet); err != nil {
+	// Apply accumulated offline duration to every pet, then let the pets
+	// react to their neglected housemates
+	elapsed := make([]time.Duration, len(household))
+	pets := make([]*game.Pet, len(household))
+	for i, m := range household {
+		if !isServed(m.store) {
+			elapsed[i] = m.pet.AccumulatedOfflineDuration
+		}
+		pets[i] = m.pet
+		if err := m.settle(); err != nil {
+			return err
+		}
+	}
+	for i, neglect := range game.ApplyHouseholdNeglect(pets, elapsed) {
+		if len(neglect.Housemates) == 0 {
+			continue
+		}
+		household[i].neglect = neglect
+		if err := household[i].store.Save(household[i].pet); err != nil {
 			return fmt.Errorf("save after applying offline duration: %w", err)
 		}
 	}
 
 	// Import TUI package and start with offline results (if any)
-	return startTUI(pet, registry, petStore, offlineResults)
+	return startTUI(household, focus, registry)
+}
+
+// householdPet is a pet of the active profile loaded for the TUI, with the
+// results of its offline settlement.
+type householdPet struct {
+	pet     *game.Pet
+	store   store.Store
+	results []game.DecayRoundResult
+	crises  []game.CrisisEvent
+	neglect game.NeglectResult
+}
+
+// loadHousehold loads every pet of the active profile, through its daemon
+// if one is running. The active pet comes from petStore and must exist;
+// other pets that fail to load are reported and skipped. focus is the index
+// of the active pet.
+func loadHousehold() ([]*householdPet, int, error) {
+	petStore = servedStore(petStore)
+	pet, err := loadPet()
+	if err != nil {
+		return nil, 0, err
+	}
+	active := &householdPet{pet: pet, store: petStore}
+
+	infos, err := profileMgr.Pets(activeProfile)
+	if err != nil {
+		return nil, 0, err
+	}
+	var household []*householdPet
+	for _, info := range infos {
+		if info.Slot == activePet {
+			household = append(household, active)
+			continue
+		}
+		st, err := profileMgr.OpenPet(activeProfile, info.Slot, store.Backend(cfg.StoreBackend))
+		var other *game.Pet
+		if err == nil {
+			st = servedStore(st)
+			other, err = loadPetFrom(st)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, i18nMgr.T("cli.pet.load_failed", "name", cmp.Or(info.Name, info.Slot), "error", err.Error()))
+			continue
+		}
+		household = append(household, &householdPet{pet: other, store: st})
+	}
+	focus := slices.Index(household, active)
+	if focus < 0 {
+		focus = len(household)
+		household = append(household, active)
+	}
+	return household, focus, nil
 }
 
-// loadPet loads the pet from store and sets its registry reference.
+// settle applies the pet's accumulated offline duration, saves it and
+// records the results. A pet served by a daemon is already up to date.
+func (m *householdPet) settle() error {
+	pet := m.pet
+	if pet.AccumulatedOfflineDuration <= 0 || isServed(m.store) {
+		return nil
+	}
+	dur := pet.AccumulatedOfflineDuration
+
+	// Adjust age; a dead pet's lifespan is fixed
+	if pet.Alive {
+		pet.Birthday = pet.Birthday.Add(-dur)
+	}
+
+	// Multi-stage settlement
+	m.results = pet.ApplyMultiStageDecay(dur)
+
+	// Trigger time hooks (lifecycle, death check, etc.)
+	wasAlive := pet.Alive
+	pet.AdvanceTime(dur)
+	game.ReportHealthRegen(m.results, pet.TakeHealthRegen())
+	m.crises = pet.TakeCrisisEvents()
+
+	// Clear cache
+	pet.AccumulatedOfflineDuration = 0
+	pet.LastCheckedAt = time.Now()
+
+	// Save state
+	if err := m.store.Save(pet); err != nil {
+		return fmt.Errorf("save after applying offline duration: %w", err)
+	}
+	_ = store.History(m.store, store.SourceTUI).RecordDecay(time.Now(), pet, m.results)
+	_ = store.JournalFor(m.store).AppendCrises(store.SourceTUI, pet, m.crises)
+	if wasAlive && !pet.Alive {
+		death := store.NewEvent(time.Now(), store.EventDeath, store.SourceTUI, pet)
+		death.Detail = pet.EndingType
+		_ = store.JournalFor(m.store).Append(death)
+	}
+	return nil
+}
+
+// loadPet loads the pet from the active pet's store and sets its registry reference.
 func loadPet() (*game.Pet, error) {
+	pet, _, err := loadActivePet()
+	return pet, err
+}
+
+// loadActivePet is loadPet that also reports whether accumulating offline
+// time changed the pet, so commands that leave the pet alone know whether it
+// still needs saving.
+func loadActivePet() (*game.Pet, bool, error) {
 	if !petStore.Exists() {
 		fmt.Println(i18nMgr.T("cli.status.no_pet"))
-		return nil, fmt.Errorf("no pet")
+		return nil, false, fmt.Errorf("no pet")
 	}
+	return readPet(petStore)
+}
 
-	pet, err := petStore.Load()
+// loadPetFrom loads a pet from st, restores its registry references and
+// accumulates the time since it was last checked, unless a daemon serves it.
+func loadPetFrom(st store.Store) (*game.Pet, error) {
+	pet, _, err := readPet(st)
+	return pet, err
+}
+
+// readPet implements loadPetFrom and reports whether offline time was
+// accumulated.
+func readPet(st store.Store) (*game.Pet, bool, error) {
+	pet, err := st.Load()
+	if errors.Is(err, store.ErrCorruptSave) {
+		return nil, false, fmt.Errorf("load pet: %w\n%s", err, i18nMgr.T("cli.doctor.hint"))
+	}
 	if err != nil {
-		return nil, fmt.Errorf("load pet: %w", err)
+		return nil, false, fmt.Errorf("load pet: %w", err)
+	}
+	if rec, ok := st.(store.Recoverer); ok && rec.RecoveredFrom() != "" {
+		fmt.Fprintln(os.Stderr, i18nMgr.T("cli.doctor.recovered", "path", rec.RecoveredFrom()))
+	}
+	if issues := game.ValidatePet(pet, registry); len(issues) > 0 {
+		fmt.Fprintln(os.Stderr, i18nMgr.T("cli.doctor.load_warning", "count", len(issues)))
+		fmt.Fprintln(os.Stderr, i18nMgr.T("cli.doctor.hint"))
 	}
 
 	// Restore registry references (not serialized)
 	pet.SetRegistry(registry)
 	pet.SetCapabilitiesRegistry(capabilitiesReg)
 
-	// Accumulate natural offline time (time since last check)
-	pet.AccumulateOfflineTime()
-
-	return pet, nil
+	// Accumulate natural offline time (time since last check); a daemon
+	// advances the time of the pets it serves
+	accumulated := !isServed(st) && pet.AccumulateOfflineTime()
+	return pet, accumulated, nil
 }
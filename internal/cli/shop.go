@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"clipet/internal/game"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// shopListing is the JSON output of `clipet shop` without an item ID.
+type shopListing struct {
+	Coins int        `json:"coins"`
+	Items []shopItem `json:"items"`
+}
+
+// shopItem describes one item for sale.
+type shopItem struct {
+	ID      string         `json:"id"`
+	Name    string         `json:"name"`
+	Kind    string         `json:"kind"`
+	Price   int            `json:"price"`
+	Owned   int            `json:"owned"`
+	Effects map[string]int `json:"effects,omitempty"`
+}
+
+func newShopCmd() *cobra.Command {
+	cmd := newActionCmd("shop [id]", "Buy an item with coins, or list the shop", runShop)
+	cmd.Args = cobra.MaximumNArgs(1)
+	return cmd
+}
+
+func runShop(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return listShop(cmd)
+	}
+
+	itemID := args[0]
+	return runAction(cmd, "buy:"+itemID, func(pet *game.Pet) game.ActionResult {
+		return pet.BuyItem(itemID)
+	})
+}
+
+// listShop prints the coin balance and the items for sale.
+func listShop(cmd *cobra.Command) error {
+	pet, err := loadPet()
+	if err != nil {
+		return err
+	}
+
+	listing := shopListing{Coins: pet.Coins, Items: []shopItem{}}
+	for _, item := range pet.ShopItems() {
+		listing.Items = append(listing.Items, shopItem{
+			ID:      item.ID,
+			Name:    item.Name,
+			Kind:    item.Kind,
+			Price:   item.Price,
+			Owned:   pet.ItemCount(item.ID),
+			Effects: item.Effects,
+		})
+	}
+
+	jsonFlag, _ := cmd.Flags().GetBool("json")
+	if jsonFlag {
+		data, err := json.MarshalIndent(listing, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	fmt.Println(i18nMgr.T("cli.shop.balance", "coins", listing.Coins))
+	if len(listing.Items) == 0 {
+		fmt.Println(i18nMgr.T("cli.shop.empty"))
+		return nil
+	}
+	for _, it := range listing.Items {
+		fmt.Printf("%-16s %s  %s  🪙 %d  %s\n", it.ID, it.Name,
+			i18nMgr.T("cli.action.item_kind."+it.Kind), it.Price, formatEffects(it.Effects))
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"clipet/internal/game"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// skillInfo describes one active skill for `clipet skill` listings.
+type skillInfo struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	EnergyCost int    `json:"energy_cost"`
+	Cooldown   string `json:"cooldown"`
+}
+
+func newSkillCmd() *cobra.Command {
+	cmd := newActionCmd("skill [id]", "Use one of the pet's active skills, or list them", runSkill)
+	cmd.Args = cobra.MaximumNArgs(1)
+	return cmd
+}
+
+func runSkill(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return listSkills(cmd)
+	}
+
+	skillID := args[0]
+	return runAction(cmd, "skill:"+skillID, func(pet *game.Pet) game.ActionResult {
+		return pet.UseSkill(skillID)
+	})
+}
+
+// listSkills prints the active skills the pet has unlocked.
+func listSkills(cmd *cobra.Command) error {
+	pet, err := loadPet()
+	if err != nil {
+		return err
+	}
+
+	skills := []skillInfo{}
+	for _, trait := range capabilitiesReg.GetActiveTraits(pet.Species, pet.UnlockedTraitIDs()) {
+		info := skillInfo{ID: trait.ID, Name: registry.GetTraitName(pet.Species, trait.ID)}
+		if trait.ActiveEffect != nil {
+			info.EnergyCost = trait.ActiveEffect.EnergyCost
+			info.Cooldown = trait.ActiveEffect.Cooldown.String()
+		}
+		skills = append(skills, info)
+	}
+
+	jsonFlag, _ := cmd.Flags().GetBool("json")
+	if jsonFlag {
+		data, err := json.MarshalIndent(skills, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if len(skills) == 0 {
+		fmt.Println(i18nMgr.T("cli.action.no_skills"))
+		return nil
+	}
+	for _, s := range skills {
+		fmt.Printf("%-16s %s  %s\n", s.ID, s.Name,
+			i18nMgr.T("cli.action.skill_cost", "energy", s.EnergyCost, "cooldown", s.Cooldown))
+	}
+	return nil
+}
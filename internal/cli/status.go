@@ -1,8 +1,10 @@
 package cli
 
 import (
+	"clipet/internal/game"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -19,18 +21,11 @@ func newStatusCmd() *cobra.Command {
 }
 
 func runStatus(cmd *cobra.Command, args []string) error {
-	pet, err := loadPet()
+	pet, err := statusPet()
 	if err != nil {
 		return err
 	}
 
-	// Apply offline decay
-	pet.AccumulateOfflineTime()
-	_ = petStore.Save(pet)
-
-	// Check and trigger evolution
-	checkAndReportEvolution(pet)
-
 	jsonFlag, _ := cmd.Flags().GetBool("json")
 	if jsonFlag {
 		data, err := json.MarshalIndent(pet, "", "  ")
@@ -54,8 +49,7 @@ func runStatus(cmd *cobra.Command, args []string) error {
 	}
 
 	// Format age
-	age := time.Since(pet.Birthday)
-	ageStr := formatDuration(age)
+	ageStr := formatDuration(pet.Age())
 
 	fmt.Printf("name=%s species=%s stage=%s(%s) age=%s alive=%t\n",
 		pet.Name, speciesName, stageName, pet.StageID, ageStr, pet.Alive)
@@ -63,6 +57,18 @@ func runStatus(cmd *cobra.Command, args []string) error {
 		pet.Hunger, pet.Happiness, pet.Health, pet.Energy, pet.MoodName(), pet.MoodScore())
 	fmt.Printf("interactions=%d games_won=%d adventures=%d dialogues=%d\n",
 		pet.TotalInteractions, pet.GamesWon, pet.AdventuresCompleted, pet.DialogueCount)
+	fmt.Printf("coins=%d care_streak=%d\n", pet.Coins, pet.CareStreak)
+	fmt.Printf("traits=%s\n", strings.Join(pet.TraitIDs(), ","))
+	if pet.Generation > 1 {
+		fmt.Printf("generation=%d predecessor=%s\n", pet.Generation, pet.Predecessor)
+	}
+	for _, c := range pet.ActiveCrises {
+		resolve := ""
+		if def := registry.GetCrisis(pet.Species, c.ID); def != nil {
+			resolve = strings.Join(def.Resolve, ",")
+		}
+		fmt.Printf("crisis=%s left=%s resolve=%s\n", c.ID, formatDuration(c.Left()), resolve)
+	}
 
 	return nil
 }
@@ -78,3 +84,37 @@ func formatDuration(d time.Duration) string {
 	}
 	return i18nMgr.T("cli.status.format_minutes", "minutes", int(d.Minutes()))
 }
+
+// statusPet returns the pet to show. A running daemon keeps the pet up to
+// date itself, so its copy is used as is; otherwise offline decay and
+// evolution are applied and saved here.
+func statusPet() (*game.Pet, error) {
+	if client := dialDaemon(); client != nil {
+		defer client.Close()
+		raw, err := client.Status()
+		if err != nil {
+			return nil, daemonError(err)
+		}
+		var pet game.Pet
+		if err := json.Unmarshal(raw, &pet); err != nil {
+			return nil, fmt.Errorf("decode daemon status: %w", err)
+		}
+		pet.SetRegistry(registry)
+		pet.SetCapabilitiesRegistry(capabilitiesReg)
+		return &pet, nil
+	}
+
+	pet, accumulated, err := loadActivePet()
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the accumulated offline time; an unchanged pet is not saved
+	if accumulated {
+		_ = petStore.Save(pet)
+	}
+
+	// Check and trigger evolution
+	checkAndReportEvolution(pet)
+	return pet, nil
+}
@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"clipet/internal/game"
+	"clipet/internal/store"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// successorReport is the outcome of `clipet successor`, printed as text or
+// as JSON.
+type successorReport struct {
+	Name        string         `json:"name"`
+	Species     string         `json:"species"`
+	Generation  int            `json:"generation"`
+	Predecessor string         `json:"predecessor"`
+	Legacy      game.Legacy    `json:"legacy"`
+	Traits      []string       `json:"traits"`
+	Stats       map[string]int `json:"stats"`
+}
+
+func newSuccessorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "successor [name]",
+		Short: "Hatch the successor of a pet that has passed away",
+		Long: `Hatch the successor of a pet that has passed away.
+
+The old pet moves into the profile's memorial hall (see 'clipet memorial')
+and an egg of the same species takes its place, one generation later. The
+egg inherits a legacy: an attribute bonus for its predecessor's ending, more
+happiness and health for a well-cared-for and adventurous life, half of its
+coins and one of its traits. The name defaults to the predecessor's name
+with the next generation, e.g. "Mochi II".`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: runSuccessor,
+	}
+	cmd.Flags().BoolP("json", "j", false, "Output in JSON format")
+	return cmd
+}
+
+func runSuccessor(cmd *cobra.Command, args []string) error {
+	petStore = servedStore(petStore)
+	pet, err := loadPet()
+	if err != nil {
+		return err
+	}
+	if pet.Alive {
+		return errors.New(i18nMgr.T("cli.successor.alive", "name", pet.Name))
+	}
+
+	name := game.SuccessorName(pet)
+	if len(args) > 0 {
+		name = strings.TrimSpace(args[0])
+	}
+	if name == "" {
+		return errors.New(i18nMgr.T("cli.init.name_empty"))
+	}
+	if info, err := profileMgr.FindPet(activeProfile, name); err == nil && info.Slot != activePet {
+		return errors.New(i18nMgr.T("cli.init.name_taken", "name", name))
+	}
+
+	// The predecessor goes into the memorial hall only once the successor is
+	// saved, so a failed save can be retried without recording it twice.
+	successor, legacy := game.NewSuccessor(pet, name)
+	if err := petStore.Save(successor); err != nil {
+		return errors.New(i18nMgr.T("cli.init.save_failed", "error", err.Error()))
+	}
+	remembered := true
+	if err := profileMgr.Memorial(activeProfile).Add(store.NewMemorialEntry(pet, name)); err != nil {
+		fmt.Fprintln(os.Stderr, i18nMgr.T("cli.successor.memorial_failed", "name", pet.Name, "error", err.Error()))
+		remembered = false
+	}
+	birth := store.NewEvent(time.Now(), store.EventBirth, store.SourceCLI, successor)
+	birth.Subject = successor.Species
+	birth.Detail = successor.Name
+	_ = store.JournalFor(petStore).Append(birth)
+
+	report := successorReport{
+		Name:        successor.Name,
+		Species:     successor.Species,
+		Generation:  successor.Generation,
+		Predecessor: pet.Name,
+		Legacy:      legacy,
+		Traits:      successor.Traits,
+		Stats: map[string]int{
+			"hunger": successor.Hunger, "happiness": successor.Happiness,
+			"health": successor.Health, "energy": successor.Energy,
+		},
+	}
+
+	jsonFlag, _ := cmd.Flags().GetBool("json")
+	if jsonFlag {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	fmt.Println(i18nMgr.T("cli.successor.success", "name", report.Name, "predecessor", pet.Name, "generation", report.Generation))
+	if len(legacy.Attrs) > 0 {
+		fmt.Println(i18nMgr.T("cli.successor.attrs", "attrs", formatLegacyAttrs(legacy.Attrs)))
+	}
+	if legacy.Coins > 0 {
+		fmt.Println(i18nMgr.T("cli.successor.coins", "coins", legacy.Coins))
+	}
+	if legacy.Trait != "" {
+		fmt.Println(i18nMgr.T("cli.successor.trait", "trait", registry.GetTraitName(successor.Species, legacy.Trait)))
+	}
+	if remembered {
+		fmt.Println(i18nMgr.T("cli.successor.memorial", "name", pet.Name))
+	}
+	return nil
+}
+
+// formatLegacyAttrs formats a legacy's attribute bonus, e.g.
+// "happiness +15  health +4".
+func formatLegacyAttrs(attrs map[string]int) string {
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, fmt.Sprintf("%s +%d", name, attrs[name]))
+	}
+	return strings.Join(parts, "  ")
+}
@@ -0,0 +1,17 @@
+package cli
+
+import (
+	"clipet/internal/game"
+
+	"github.com/spf13/cobra"
+)
+
+func newTalkCmd() *cobra.Command {
+	return newActionCmd("talk", "Talk with the pet", runTalk)
+}
+
+func runTalk(cmd *cobra.Command, args []string) error {
+	return runAction(cmd, "talk", func(pet *game.Pet) game.ActionResult {
+		return pet.Talk()
+	})
+}
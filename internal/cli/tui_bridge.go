@@ -1,7 +1,6 @@
 package cli
 
 import (
-	"clipet/internal/game"
 	"clipet/internal/plugin"
 	"clipet/internal/store"
 	"clipet/internal/tui"
@@ -9,10 +8,27 @@ import (
 	tea "charm.land/bubbletea/v2"
 )
 
-// startTUI launches the Bubble Tea TUI application.
-func startTUI(pet *game.Pet, reg *plugin.Registry, st *store.JSONStore, offlineResults []game.DecayRoundResult) error {
-	app := tui.NewApp(pet, reg, st, i18nMgr, offlineResults)
+// startTUI launches the Bubble Tea TUI application for the household,
+// focused on household[focus].
+func startTUI(household []*householdPet, focus int, reg *plugin.Registry) error {
+	members := make([]tui.Member, len(household))
+	for i, m := range household {
+		members[i] = tui.Member{Pet: m.pet, Store: m.store, Served: isServed(m.store),
+			Results: m.results, Crises: m.crises, Neglect: m.neglect}
+	}
+	app := tui.NewApp(members, focus, reg, profileMgr.Memorial(activeProfile), i18nMgr)
 	p := tea.NewProgram(app)
 	_, err := p.Run()
 	return err
 }
+
+// pickProfile shows the profile picker and returns the chosen profile name.
+// An empty name means the user quit without choosing.
+func pickProfile(profiles []store.ProfileInfo, active string) (string, error) {
+	picker := tui.NewProfilePicker(profiles, active, i18nMgr)
+	m, err := tea.NewProgram(picker).Run()
+	if err != nil {
+		return "", err
+	}
+	return m.(tui.ProfilePicker).Chosen(), nil
+}
@@ -11,9 +11,22 @@ import (
 
 // Config represents the user configuration.
 type Config struct {
-	Language         string `json:"language"`
-	FallbackLanguage string `json:"fallback_language"`
-	Version          string `json:"version"`
+	Language         string       `json:"language"`
+	FallbackLanguage string       `json:"fallback_language"`
+	Version          string       `json:"version"`
+	ActiveProfile    string       `json:"active_profile,omitempty"`
+	StoreBackend     string       `json:"store_backend,omitempty"` // "json" (default) or "sqlite"
+	Notify           NotifyConfig `json:"notify,omitzero"`
+}
+
+// NotifyConfig configures attention notifications. Notifications are off
+// unless at least one backend (bell, exec, file) is set.
+type NotifyConfig struct {
+	Bell       bool   `json:"bell,omitempty"`        // ring the terminal bell
+	Exec       string `json:"exec,omitempty"`        // shell command run per notification
+	File       string `json:"file,omitempty"`        // file or FIFO receiving JSON lines
+	RateLimit  string `json:"rate_limit,omitempty"`  // minimum gap per condition, e.g. "2h" (default 1h)
+	QuietHours string `json:"quiet_hours,omitempty"` // local time range, e.g. "22:00-07:00"
 }
 
 // Default configuration values.
@@ -102,6 +115,12 @@ func (c *Config) SetLanguage(lang string) error {
 	return c.Save()
 }
 
+// SetActiveProfile updates the active save profile.
+func (c *Config) SetActiveProfile(name string) error {
+	c.ActiveProfile = name
+	return c.Save()
+}
+
 // getConfigPath returns the path to the configuration file.
 func getConfigPath() (string, error) {
 	// Check for XDG_CONFIG_HOME first
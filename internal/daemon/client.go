@@ -0,0 +1,163 @@
+package daemon
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"clipet/internal/game"
+)
+
+// dialTimeout keeps CLI commands snappy when no daemon is listening.
+const dialTimeout = 200 * time.Millisecond
+
+// Client is a JSON-RPC client for a running daemon. It is not safe for
+// concurrent use.
+type Client struct {
+	conn    net.Conn
+	scanner *bufio.Scanner
+	nextID  int
+}
+
+// Dial connects to the daemon listening on socket.
+func Dial(socket string) (*Client, error) {
+	conn, err := net.DialTimeout("unix", socket, dialTimeout)
+	if err != nil {
+		return nil, err
+	}
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 0, 4096), maxMessageSize)
+	return &Client{conn: conn, scanner: scanner}, nil
+}
+
+// Close closes the connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Call invokes method and decodes its result into result (which may be nil).
+// Notifications received while waiting are dropped.
+func (c *Client) Call(method string, params, result any) error {
+	c.nextID++
+	id := json.RawMessage(strconv.Itoa(c.nextID))
+	req := Request{JSONRPC: "2.0", ID: id, Method: method}
+	if params != nil {
+		data, err := json.Marshal(params)
+		if err != nil {
+			return fmt.Errorf("marshal params: %w", err)
+		}
+		req.Params = data
+	}
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	if _, err := c.conn.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+
+	for {
+		resp, err := c.read()
+		if err != nil {
+			return err
+		}
+		if string(resp.ID) != string(id) {
+			continue
+		}
+		if resp.Error != nil {
+			return resp.Error
+		}
+		if result == nil {
+			return nil
+		}
+		if err := json.Unmarshal(resp.Result, result); err != nil {
+			return fmt.Errorf("decode result: %w", err)
+		}
+		return nil
+	}
+}
+
+// Status returns the daemon's current pet as raw JSON (the save layout
+// without header fields).
+func (c *Client) Status() (json.RawMessage, error) {
+	var raw json.RawMessage
+	err := c.Call(MethodStatus, nil, &raw)
+	return raw, err
+}
+
+// Act performs a care action through the daemon.
+func (c *Client) Act(p ActParams) (*ActResult, error) {
+	var res ActResult
+	if err := c.Call(MethodAct, p, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// Load returns the daemon's pet and its revision, advancing time first.
+// The pet is nil while the profile has no pet.
+func (c *Client) Load() (*PetState, error) {
+	var res PetState
+	if err := c.Call(MethodLoad, nil, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// Revision returns the daemon's current revision and whether it has a pet,
+// without advancing time.
+func (c *Client) Revision() (*RevisionState, error) {
+	var res RevisionState
+	if err := c.Call(MethodRevision, nil, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// Save replaces the daemon's pet if revision is still current and returns
+// the new revision. A stale revision fails with CodeConflict.
+func (c *Client) Save(revision int64, pet *game.Pet) (int64, error) {
+	var res PetState
+	if err := c.Call(MethodSave, SaveParams{Revision: revision, Pet: pet}, &res); err != nil {
+		return 0, err
+	}
+	return res.Revision, nil
+}
+
+// Subscribe asks the daemon to send notifications on this connection.
+// Read them with Next.
+func (c *Client) Subscribe() error {
+	return c.Call(MethodSubscribe, nil, nil)
+}
+
+// Next blocks until the next notification arrives and returns its method
+// and params.
+func (c *Client) Next() (string, json.RawMessage, error) {
+	for {
+		resp, err := c.read()
+		if err != nil {
+			return "", nil, err
+		}
+		if resp.Method != "" {
+			return resp.Method, resp.Params, nil
+		}
+	}
+}
+
+// read returns the next message from the daemon.
+func (c *Client) read() (*Response, error) {
+	if !c.scanner.Scan() {
+		if err := c.scanner.Err(); err != nil {
+			return nil, fmt.Errorf("read response: %w", err)
+		}
+		return nil, fmt.Errorf("read response: connection closed")
+	}
+	var resp Response
+	if err := json.Unmarshal(c.scanner.Bytes(), &resp); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &resp, nil
+}
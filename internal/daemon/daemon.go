@@ -0,0 +1,618 @@
+// Package daemon keeps a pet running in the background. The daemon owns the
+// pet state, advances time through the game's time hooks on a schedule and
+// serves a JSON-RPC 2.0 API on a Unix socket in the profile directory.
+package daemon
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"clipet/internal/game"
+	"clipet/internal/game/capabilities"
+	"clipet/internal/plugin"
+	"clipet/internal/store"
+)
+
+// socketFileName is the control socket inside a profile directory.
+const socketFileName = "daemon.sock"
+
+// Default schedule.
+const (
+	DefaultInterval = time.Minute // how often the daemon syncs and checks the clock
+	DefaultStep     = time.Hour   // time is advanced in whole steps of this size
+)
+
+// maxMessageSize bounds a single JSON-RPC line.
+const maxMessageSize = 1 << 20
+
+// Outbound limits. A client that lets outboxSize messages pile up, or does
+// not take a write within writeTimeout, is disconnected.
+const (
+	outboxSize   = 64
+	writeTimeout = 5 * time.Second
+)
+
+// ErrRunning is returned by Run when another daemon serves the socket.
+var ErrRunning = errors.New("daemon already running")
+
+// SocketPath returns the control socket path for a profile directory.
+func SocketPath(dir string) string {
+	return filepath.Join(dir, socketFileName)
+}
+
+// Options configures a Server.
+type Options struct {
+	Store        store.Store
+	Registry     *plugin.Registry // required
+	Capabilities *capabilities.Registry
+	Socket       string        // defaults to SocketPath of the store's directory
+	Interval     time.Duration // defaults to DefaultInterval
+	// Step is the unit of time advancement. Attribute decay truncates to whole
+	// points per call, so steps much shorter than an hour lose decay.
+	Step   time.Duration // defaults to DefaultStep
+	Logger *log.Logger   // defaults to discarding output
+}
+
+// Server owns one profile's pet while it runs.
+type Server struct {
+	opts    Options
+	journal *store.Journal
+
+	mu        sync.Mutex // guards pet, revision and published
+	pet       *game.Pet  // nil while the profile has no pet
+	revision  int64      // bumped on every reload and save of pet
+	published int        // journal events already sent to subscribers
+
+	connsMu sync.Mutex
+	conns   map[*conn]bool // open connections; true once subscribed
+}
+
+// New creates a server. Call Run to start it.
+func New(opts Options) *Server {
+	if opts.Socket == "" {
+		opts.Socket = SocketPath(filepath.Dir(opts.Store.Path()))
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultInterval
+	}
+	if opts.Step <= 0 {
+		opts.Step = DefaultStep
+	}
+	if opts.Logger == nil {
+		opts.Logger = log.New(io.Discard, "", 0)
+	}
+	return &Server{
+		opts:    opts,
+		journal: store.JournalFor(opts.Store),
+		conns:   make(map[*conn]bool),
+	}
+}
+
+// Socket returns the control socket path.
+func (s *Server) Socket() string {
+	return s.opts.Socket
+}
+
+// Run loads the pet, listens on the socket and advances time until ctx is
+// cancelled. The socket is removed on return.
+func (s *Server) Run(ctx context.Context) error {
+	ln, err := listen(s.opts.Socket)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(s.opts.Socket)
+	s.opts.Logger.Printf("listening on %s", s.opts.Socket)
+
+	s.mu.Lock()
+	if err := s.reload(); err != nil {
+		s.mu.Unlock()
+		ln.Close()
+		return err
+	}
+	if events, err := s.journal.Events(store.EventFilter{}); err == nil {
+		s.published = len(events)
+	}
+	s.tick(time.Now())
+	s.mu.Unlock()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.serve(ln)
+	}()
+
+	ticker := time.NewTicker(s.opts.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			ln.Close()
+			s.closeConns()
+			wg.Wait()
+			return nil
+		case now := <-ticker.C:
+			s.mu.Lock()
+			s.tick(now)
+			s.mu.Unlock()
+		}
+	}
+}
+
+// listen opens the Unix socket, replacing a stale socket file left by a
+// daemon that did not shut down cleanly.
+func listen(path string) (net.Listener, error) {
+	if _, err := os.Stat(path); err == nil {
+		if c, err := net.DialTimeout("unix", path, 200*time.Millisecond); err == nil {
+			c.Close()
+			return nil, ErrRunning
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("remove stale socket: %w", err)
+		}
+	}
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("listen on %s: %w", path, err)
+	}
+	return ln, nil
+}
+
+// ----- Pet state (callers hold s.mu) -----
+
+// reload reads the pet from the store, or clears it if there is none.
+func (s *Server) reload() error {
+	if !s.opts.Store.Exists() {
+		s.pet = nil
+		return nil
+	}
+	pet, err := s.opts.Store.Load()
+	if err != nil {
+		return fmt.Errorf("load pet: %w", err)
+	}
+	pet.SetRegistry(s.opts.Registry)
+	pet.SetCapabilitiesRegistry(s.opts.Capabilities)
+	s.pet = pet
+	s.revision++
+	return nil
+}
+
+// sync reloads the pet when another process changed the save.
+func (s *Server) sync() {
+	if s.pet == nil {
+		if err := s.reload(); err != nil {
+			s.opts.Logger.Printf("reload: %v", err)
+		}
+		return
+	}
+	syncer, ok := s.opts.Store.(store.Syncer)
+	if !ok {
+		return
+	}
+	if changed, err := syncer.Changed(); err != nil || !changed {
+		return
+	}
+	if err := s.reload(); err != nil {
+		s.opts.Logger.Printf("reload: %v", err)
+		return
+	}
+	s.opts.Logger.Printf("reloaded pet after external change")
+}
+
+// tick syncs with the save, advances time and publishes new journal events.
+func (s *Server) tick(now time.Time) {
+	s.sync()
+	if err := s.advance(now); err != nil {
+		s.opts.Logger.Printf("advance time: %v", err)
+	}
+	s.publish()
+}
+
+// advance runs the time hooks for every whole step that passed since the pet
+// was last checked. Time not yet worth a step stays pending in
+// AccumulatedOfflineDuration, so front ends settle it like offline time
+// once the daemon stops.
+func (s *Server) advance(now time.Time) error {
+	pet := s.pet
+	if pet == nil || !pet.Alive {
+		return nil
+	}
+	pending := pet.AccumulatedOfflineDuration + now.Sub(pet.LastCheckedAt)
+	steps := pending / s.opts.Step
+	if steps < 1 {
+		return nil
+	}
+
+	start := attrs(pet)
+	for i := time.Duration(0); i < steps && pet.Alive; i++ {
+		pet.AdvanceTime(s.opts.Step)
+	}
+	pet.AccumulatedOfflineDuration = pending - steps*s.opts.Step
+	pet.LastCheckedAt = now
+
+	if err := s.save(); err != nil {
+		return err
+	}
+	_ = store.History(s.opts.Store, store.SourceDaemon).RecordDecay(now, pet, []game.DecayRoundResult{{
+		Round:      1,
+		Duration:   steps * s.opts.Step,
+		StartAttrs: start,
+		EndAttrs:   attrs(pet),
+	}})
+	_ = s.journal.AppendCrises(store.SourceDaemon, pet, pet.TakeCrisisEvents())
+	pet.TakeAchievements() // unlocks reach subscribers through the journal
+	if !pet.Alive {
+		death := store.NewEvent(now, store.EventDeath, store.SourceDaemon, pet)
+		death.Detail = pet.EndingType
+		_ = s.journal.Append(death)
+	}
+	return nil
+}
+
+// save writes the pet, reloading it if another process saved first.
+func (s *Server) save() error {
+	s.revision++
+	err := s.opts.Store.Save(s.pet)
+	if errors.Is(err, store.ErrConflict) {
+		if rerr := s.reload(); rerr != nil {
+			return rerr
+		}
+	}
+	return err
+}
+
+// act performs one care action and auto-evolves the pet if it qualifies.
+func (s *Server) act(p ActParams) (*ActResult, error) {
+	s.sync()
+	pet := s.pet
+	if pet == nil {
+		return nil, &RPCError{Code: CodeNoPet, Message: "no pet"}
+	}
+
+	var res game.ActionResult
+	switch {
+	case p.Action == "feed":
+		res = pet.Feed()
+	case p.Action == "play":
+		res = pet.Play()
+	case p.Action == "rest":
+		res = pet.Rest()
+	case p.Action == "heal":
+		res = pet.Heal()
+	case p.Action == "talk":
+		res = pet.Talk()
+	case strings.HasPrefix(p.Action, "skill:"):
+		res = pet.UseSkill(strings.TrimPrefix(p.Action, "skill:"))
+	case strings.HasPrefix(p.Action, "item:"):
+		res = pet.UseItem(strings.TrimPrefix(p.Action, "item:"))
+	case strings.HasPrefix(p.Action, "buy:"):
+		res = pet.BuyItem(strings.TrimPrefix(p.Action, "buy:"))
+	case strings.HasPrefix(p.Action, "equip:"):
+		res = pet.EquipAccessory(strings.TrimPrefix(p.Action, "equip:"))
+	case strings.HasPrefix(p.Action, "unequip:"):
+		res = pet.UnequipAccessory(strings.TrimPrefix(p.Action, "unequip:"))
+	default:
+		return nil, &RPCError{Code: CodeInvalidParams, Message: fmt.Sprintf("unknown action %q", p.Action)}
+	}
+
+	src := store.Source(p.Source)
+	if src == "" {
+		src = store.SourceDaemon
+	}
+
+	result := &ActResult{
+		Action:    p.Action,
+		Pet:       pet.Name,
+		Species:   pet.Species,
+		OK:        res.OK,
+		ErrorType: res.ErrorType,
+		Message:   res.Message,
+		Changes:   res.Changes,
+		Animation: string(res.Animation),
+		Crises:    res.Crises,
+	}
+	if result.Changes == nil {
+		result.Changes = map[string][2]int{}
+	}
+	if res.OK && p.Action == "talk" && s.opts.Registry != nil {
+		result.Dialogue = s.opts.Registry.GetDialogue(pet.Species, pet.StageID, pet.MoodName())
+	}
+	if res.OK {
+		if from, best := game.AutoEvolve(pet, s.opts.Registry); best != nil {
+			result.Evolution = &Evolution{From: from, To: best.ToStage.ID, Phase: best.ToStage.Phase}
+		}
+	}
+
+	if err := s.save(); err != nil {
+		return nil, fmt.Errorf("save pet: %w", err)
+	}
+	now := time.Now()
+	history := store.History(s.opts.Store, src)
+	_ = history.RecordAction(now, pet, p.Action, res)
+	if evo := result.Evolution; evo != nil {
+		_ = history.RecordEvolution(now, pet, evo.From, evo.To)
+	}
+	for _, a := range pet.TakeAchievements() {
+		result.Achievements = append(result.Achievements, a.Key())
+	}
+	s.publish()
+	return result, nil
+}
+
+// replace saves a client's pet in place of the daemon's, unless the
+// daemon's pet changed since the client loaded it. A daemon without a pet
+// has nothing to lose, so any revision may fill it. The result carries only
+// the new revision.
+func (s *Server) replace(p SaveParams) (*PetState, error) {
+	s.sync()
+	if s.pet != nil && p.Revision != s.revision {
+		return nil, &RPCError{Code: CodeConflict, Message: "pet changed since it was loaded"}
+	}
+	if p.Pet == nil {
+		return nil, &RPCError{Code: CodeInvalidParams, Message: "missing pet"}
+	}
+	pet := p.Pet
+	pet.SetRegistry(s.opts.Registry)
+	pet.SetCapabilitiesRegistry(s.opts.Capabilities)
+	if old := s.pet; old != nil && old.Alive {
+		pet.LastCheckedAt = old.LastCheckedAt
+		pet.AccumulatedOfflineDuration = old.AccumulatedOfflineDuration
+	}
+	s.pet = pet
+	if err := s.save(); err != nil {
+		return nil, fmt.Errorf("save pet: %w", err)
+	}
+	s.publish()
+	return &PetState{Revision: s.revision}, nil
+}
+
+// publish sends journal events appended since the last call, by this
+// daemon or any other clipet process, to subscribers.
+func (s *Server) publish() {
+	events, err := s.journal.Events(store.EventFilter{})
+	if err != nil {
+		return
+	}
+	if len(events) < s.published {
+		// The journal was reset with the pet.
+		s.published = 0
+	}
+	fresh := events[s.published:]
+	s.published = len(events)
+	for _, e := range fresh {
+		s.notify(NotifyEvent, e)
+	}
+}
+
+func attrs(p *game.Pet) [4]int {
+	return [4]int{p.Hunger, p.Happiness, p.Health, p.Energy}
+}
+
+// ----- Connections -----
+
+// conn is one client connection. Responses and notifications are queued on
+// out and written by a single writer goroutine, so a slow client never
+// blocks the daemon while it holds its locks.
+type conn struct {
+	c   net.Conn
+	out chan []byte
+}
+
+func newConn(nc net.Conn) *conn {
+	return &conn{c: nc, out: make(chan []byte, outboxSize)}
+}
+
+// send queues a response, waiting while the queue is full. Only the
+// connection's own handler sends responses.
+func (c *conn) send(r Response) error {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	c.out <- append(data, '\n')
+	return nil
+}
+
+// post queues a notification without waiting. A client whose queue is full
+// has stopped reading; it is disconnected and post reports false.
+func (c *conn) post(data []byte) bool {
+	select {
+	case c.out <- data:
+		return true
+	default:
+		c.c.Close()
+		return false
+	}
+}
+
+// writeLoop writes queued messages until out is closed. After a failed or
+// timed out write the connection is closed and the rest of the queue is
+// discarded.
+func (c *conn) writeLoop() {
+	defer c.c.Close()
+	failed := false
+	for data := range c.out {
+		if failed {
+			continue
+		}
+		_ = c.c.SetWriteDeadline(time.Now().Add(writeTimeout))
+		if _, err := c.c.Write(data); err != nil {
+			failed = true
+			c.c.Close()
+		}
+	}
+}
+
+func (s *Server) serve(ln net.Listener) {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		nc, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		c := newConn(nc)
+		s.connsMu.Lock()
+		s.conns[c] = false
+		s.connsMu.Unlock()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.handle(c)
+		}()
+	}
+}
+
+func (s *Server) handle(c *conn) {
+	written := make(chan struct{})
+	go func() {
+		defer close(written)
+		c.writeLoop()
+	}()
+	defer func() {
+		// Once the connection is out of conns nothing posts to it, so
+		// out can be closed; the writer flushes what is queued.
+		s.connsMu.Lock()
+		delete(s.conns, c)
+		s.connsMu.Unlock()
+		close(c.out)
+		<-written
+	}()
+
+	scanner := bufio.NewScanner(c.c)
+	scanner.Buffer(make([]byte, 0, 4096), maxMessageSize)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+
+		var req Request
+		if err := json.Unmarshal(line, &req); err != nil {
+			_ = c.send(errorResponse(nil, &RPCError{Code: CodeParseError, Message: err.Error()}))
+			continue
+		}
+		result, err := s.dispatch(c, req)
+		if req.ID == nil {
+			continue // notification: no response
+		}
+		if err != nil {
+			var rpcErr *RPCError
+			if !errors.As(err, &rpcErr) {
+				rpcErr = &RPCError{Code: CodeInternalError, Message: err.Error()}
+			}
+			_ = c.send(errorResponse(req.ID, rpcErr))
+			continue
+		}
+		data, err := json.Marshal(result)
+		if err != nil {
+			_ = c.send(errorResponse(req.ID, &RPCError{Code: CodeInternalError, Message: err.Error()}))
+			continue
+		}
+		_ = c.send(Response{JSONRPC: "2.0", ID: req.ID, Result: data})
+	}
+}
+
+func (s *Server) dispatch(c *conn, req Request) (any, error) {
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return nil, &RPCError{Code: CodeInvalidRequest, Message: "invalid request"}
+	}
+
+	switch req.Method {
+	case MethodStatus:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.sync()
+		if s.pet == nil {
+			return nil, &RPCError{Code: CodeNoPet, Message: "no pet"}
+		}
+		// Marshal under the lock: the ticker mutates the pet.
+		data, err := json.Marshal(s.pet)
+		return json.RawMessage(data), err
+
+	case MethodAct:
+		var p ActParams
+		if err := json.Unmarshal(req.Params, &p); err != nil {
+			return nil, &RPCError{Code: CodeInvalidParams, Message: err.Error()}
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.act(p)
+
+	case MethodLoad:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.tick(time.Now())
+		// Marshal under the lock: the ticker mutates the pet.
+		data, err := json.Marshal(PetState{Revision: s.revision, Pet: s.pet})
+		return json.RawMessage(data), err
+
+	case MethodRevision:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.sync()
+		return RevisionState{Revision: s.revision, HasPet: s.pet != nil}, nil
+
+	case MethodSave:
+		var p SaveParams
+		if err := json.Unmarshal(req.Params, &p); err != nil {
+			return nil, &RPCError{Code: CodeInvalidParams, Message: err.Error()}
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.replace(p)
+
+	case MethodSubscribe:
+		s.connsMu.Lock()
+		s.conns[c] = true
+		s.connsMu.Unlock()
+		return map[string]bool{"subscribed": true}, nil
+	}
+	return nil, &RPCError{Code: CodeMethodNotFound, Message: fmt.Sprintf("method %q not found", req.Method)}
+}
+
+// notify queues a notification for every subscriber. It does no socket I/O,
+// so it is safe to call with s.mu held.
+func (s *Server) notify(method string, params any) {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return
+	}
+	msg, err := json.Marshal(Response{JSONRPC: "2.0", Method: method, Params: data})
+	if err != nil {
+		return
+	}
+	msg = append(msg, '\n')
+	s.connsMu.Lock()
+	defer s.connsMu.Unlock()
+	for c, subscribed := range s.conns {
+		if subscribed && !c.post(msg) {
+			s.conns[c] = false
+		}
+	}
+}
+
+// closeConns closes every client connection so their handlers return.
+func (s *Server) closeConns() {
+	s.connsMu.Lock()
+	defer s.connsMu.Unlock()
+	for c := range s.conns {
+		c.c.Close()
+	}
+}
+
+func errorResponse(id json.RawMessage, err *RPCError) Response {
+	return Response{JSONRPC: "2.0", ID: id, Error: err}
+}
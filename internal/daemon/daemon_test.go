@@ -0,0 +1,296 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"clipet/internal/game"
+	"clipet/internal/plugin"
+	"clipet/internal/store"
+)
+
+func newTestStore(t *testing.T, pet *game.Pet) *store.JSONStore {
+	t.Helper()
+	st, err := store.NewJSONStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewJSONStore: %v", err)
+	}
+	if err := st.Save(pet); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	return st
+}
+
+func testRegistry() *plugin.Registry {
+	reg := plugin.NewRegistry()
+	reg.Register(&plugin.SpeciesPack{
+		Species: plugin.SpeciesConfig{ID: "cat"},
+		Stages:  []plugin.Stage{{ID: "baby", Phase: "baby"}},
+	})
+	return reg
+}
+
+// startServer runs a server until the test ends and returns a connected client.
+func startServer(t *testing.T, st store.Store) (*Server, *Client) {
+	t.Helper()
+	srv := New(Options{Store: st, Registry: testRegistry(), Interval: time.Hour})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- srv.Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("Run: %v", err)
+		}
+		if _, err := os.Stat(srv.Socket()); !os.IsNotExist(err) {
+			t.Errorf("socket not removed on shutdown: %v", err)
+		}
+	})
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		c, err := Dial(srv.Socket())
+		if err == nil {
+			t.Cleanup(func() { c.Close() })
+			return srv, c
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("daemon did not start: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestServer_StatusActSubscribe(t *testing.T) {
+	now := time.Now()
+	st := newTestStore(t, &game.Pet{Name: "Mochi", Species: "cat", StageID: "baby", Alive: true,
+		Hunger: 30, Happiness: 50, Health: 80, Energy: 80, Birthday: now, LastCheckedAt: now})
+	srv, client := startServer(t, st)
+
+	sub, err := Dial(srv.Socket())
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	defer sub.Close()
+	if err := sub.Subscribe(); err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	raw, err := client.Status()
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	var pet game.Pet
+	if err := json.Unmarshal(raw, &pet); err != nil || pet.Name != "Mochi" {
+		t.Fatalf("status pet = %+v, %v", pet, err)
+	}
+
+	res, err := client.Act(ActParams{Action: "feed", Source: string(store.SourceCLI)})
+	if err != nil {
+		t.Fatalf("Act: %v", err)
+	}
+	if !res.OK || res.Changes["hunger"][1] <= 30 {
+		t.Fatalf("feed result = %+v", res)
+	}
+
+	events := make(chan store.Event, 1)
+	go func() {
+		for {
+			method, params, err := sub.Next()
+			if err != nil {
+				return
+			}
+			var e store.Event
+			if method == NotifyEvent && json.Unmarshal(params, &e) == nil {
+				events <- e
+				return
+			}
+		}
+	}()
+	select {
+	case e := <-events:
+		if e.Type != store.EventAction || e.Subject != "feed" || e.Source != store.SourceCLI {
+			t.Errorf("notified event = %+v", e)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("no event notification after act")
+	}
+
+	other, _ := store.NewJSONStore(filepath.Dir(st.Path()))
+	saved, err := other.Load()
+	if err != nil || saved.Hunger != res.Changes["hunger"][1] {
+		t.Errorf("act not saved: hunger %d, %v", saved.Hunger, err)
+	}
+
+	var rpcErr *RPCError
+	if _, err := client.Act(ActParams{Action: "dance"}); !errors.As(err, &rpcErr) || rpcErr.Code != CodeInvalidParams {
+		t.Errorf("unknown action: got %v", err)
+	}
+	if err := client.Call("nope", nil, nil); !errors.As(err, &rpcErr) || rpcErr.Code != CodeMethodNotFound {
+		t.Errorf("unknown method: got %v", err)
+	}
+
+	second := New(Options{Store: st, Registry: testRegistry()})
+	if err := second.Run(context.Background()); !errors.Is(err, ErrRunning) {
+		t.Errorf("second daemon: got %v, want ErrRunning", err)
+	}
+}
+
+func TestRemoteStore_LoadSave(t *testing.T) {
+	now := time.Now()
+	st := newTestStore(t, &game.Pet{Name: "Mochi", Species: "cat", StageID: "baby", Alive: true,
+		Hunger: 30, Happiness: 50, Health: 80, Energy: 80, Birthday: now, LastCheckedAt: now})
+	srv, client := startServer(t, st)
+	remote := NewRemoteStore(client, st)
+
+	pet, err := remote.Load()
+	if err != nil || pet.Name != "Mochi" {
+		t.Fatalf("Load = %+v, %v", pet, err)
+	}
+	pet.Coins = 42
+	pet.LastCheckedAt = time.Time{}
+	if err := remote.Save(pet); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	other, _ := store.NewJSONStore(filepath.Dir(st.Path()))
+	saved, err := other.Load()
+	if err != nil || saved.Coins != 42 {
+		t.Fatalf("save not written: coins %d, %v", saved.Coins, err)
+	}
+	if saved.LastCheckedAt.IsZero() {
+		t.Error("daemon should keep its own clock for a living pet")
+	}
+
+	// A second front end saves first: the stale one gets ErrConflict.
+	c2, err := Dial(srv.Socket())
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	defer c2.Close()
+	stale := NewRemoteStore(c2, st)
+	if _, err := stale.Load(); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	pet.Coins = 7
+	if err := remote.Save(pet); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if changed, err := stale.Changed(); err != nil || !changed {
+		t.Errorf("Changed = %v, %v; want true", changed, err)
+	}
+	// Exists only peeks, so it must not hide the conflict
+	if !stale.Exists() {
+		t.Error("Exists = false with a served pet")
+	}
+	if err := stale.Save(pet); !errors.Is(err, store.ErrConflict) {
+		t.Errorf("stale Save: got %v, want ErrConflict", err)
+	}
+	if err := remote.Delete(); err == nil {
+		t.Error("Delete through the daemon should fail")
+	}
+}
+
+func TestRemoteStore_FillsEmptySlot(t *testing.T) {
+	st, err := store.NewJSONStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewJSONStore: %v", err)
+	}
+	_, client := startServer(t, st)
+	remote := NewRemoteStore(client, st)
+	if remote.Exists() {
+		t.Fatal("Exists = true without a pet")
+	}
+	now := time.Now()
+	egg := &game.Pet{Name: "Mochi", Species: "cat", StageID: "baby", Alive: true, Birthday: now, LastCheckedAt: now}
+	if err := remote.Save(egg); err != nil {
+		t.Fatalf("Save into an empty daemon: %v", err)
+	}
+	if !remote.Exists() {
+		t.Error("Exists = false after Save")
+	}
+	if changed, err := remote.Changed(); err != nil || changed {
+		t.Errorf("Changed after own Save = %v, %v; want false", changed, err)
+	}
+}
+
+func TestServer_AdvanceInWholeSteps(t *testing.T) {
+	now := time.Now()
+	pet := &game.Pet{Name: "Mochi", Alive: true, Hunger: 50, LastCheckedAt: now.Add(-150 * time.Minute)}
+	st := newTestStore(t, pet)
+	srv := New(Options{Store: st, Registry: testRegistry(), Step: time.Hour})
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if err := srv.reload(); err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+	if err := srv.advance(now); err != nil {
+		t.Fatalf("advance: %v", err)
+	}
+	if srv.pet.AccumulatedOfflineDuration != 30*time.Minute || !srv.pet.LastCheckedAt.Equal(now) {
+		t.Errorf("pending = %v, last checked = %v", srv.pet.AccumulatedOfflineDuration, srv.pet.LastCheckedAt)
+	}
+
+	events, _ := store.JournalFor(st).Events(store.EventFilter{Types: []store.EventType{store.EventDecay}})
+	if len(events) != 1 || events[0].Detail != "2h0m0s" || events[0].Source != store.SourceDaemon {
+		t.Errorf("decay events = %+v", events)
+	}
+
+	// Less than a step pending: nothing happens.
+	if err := srv.advance(now.Add(10 * time.Minute)); err != nil {
+		t.Fatalf("advance: %v", err)
+	}
+	if srv.pet.AccumulatedOfflineDuration != 30*time.Minute {
+		t.Errorf("partial step should stay pending, got %v", srv.pet.AccumulatedOfflineDuration)
+	}
+}
+
+func TestListen_ReplacesStaleSocket(t *testing.T) {
+	path := SocketPath(t.TempDir())
+	if err := os.WriteFile(path, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	ln, err := listen(path)
+	if err != nil {
+		t.Fatalf("listen over stale socket: %v", err)
+	}
+	ln.Close()
+}
+
+func TestServer_NotifyDropsStalledSubscriber(t *testing.T) {
+	srv := New(Options{Store: newTestStore(t, &game.Pet{Name: "Mochi", Species: "cat", StageID: "baby", Alive: true}),
+		Registry: testRegistry()})
+	server, client := net.Pipe() // unbuffered: nobody reads, so every write stalls
+	defer client.Close()
+	c := newConn(server)
+	srv.conns[c] = true
+	handled := make(chan struct{})
+	go func() {
+		defer close(handled)
+		srv.handle(c)
+	}()
+
+	start := time.Now()
+	for i := 0; i < outboxSize+2; i++ {
+		srv.notify(NotifyEvent, i)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("notify blocked for %v on a stalled subscriber", elapsed)
+	}
+	select {
+	case <-handled:
+	case <-time.After(2 * time.Second):
+		t.Fatal("stalled subscriber was not disconnected")
+	}
+	srv.connsMu.Lock()
+	defer srv.connsMu.Unlock()
+	if _, ok := srv.conns[c]; ok {
+		t.Error("stalled subscriber still registered")
+	}
+}
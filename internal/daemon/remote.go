@@ -0,0 +1,127 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"clipet/internal/game"
+	"clipet/internal/store"
+)
+
+// RemoteStore is a store.Store that loads and saves the pet through a
+// running daemon instead of the save file, so front ends don't race the
+// daemon's own writes. The daemon advances the pet's time; callers must not
+// settle offline time for pets loaded from a RemoteStore.
+//
+// History is written to the local store's journal and, if the local store
+// keeps history tables, to those as well.
+type RemoteStore struct {
+	client   *Client
+	local    store.Store
+	revision int64
+}
+
+// NewRemoteStore returns a store served by the daemon behind client. local
+// is the store the daemon itself uses; it supplies Path and history.
+func NewRemoteStore(client *Client, local store.Store) *RemoteStore {
+	return &RemoteStore{client: client, local: local}
+}
+
+// Save replaces the daemon's pet. It fails with store.ErrConflict if the
+// daemon's pet changed since this store last loaded or saved.
+func (r *RemoteStore) Save(pet *game.Pet) error {
+	revision, err := r.client.Save(r.revision, pet)
+	if err != nil {
+		return remoteErr(err)
+	}
+	r.revision = revision
+	return nil
+}
+
+// Load returns the daemon's pet with time advanced to its last step.
+func (r *RemoteStore) Load() (*game.Pet, error) {
+	state, err := r.client.Load()
+	if err != nil {
+		return nil, remoteErr(err)
+	}
+	r.revision = state.Revision
+	if state.Pet == nil {
+		return nil, fmt.Errorf("no pet in %s", r.local.Path())
+	}
+	return state.Pet, nil
+}
+
+// Exists reports whether the daemon has a pet. It leaves the revision that
+// Save checks alone.
+func (r *RemoteStore) Exists() bool {
+	state, err := r.client.Revision()
+	return err == nil && state.HasPet
+}
+
+// Delete refuses: the daemon must be stopped before its pet is removed.
+func (r *RemoteStore) Delete() error {
+	return errors.New("pet is served by a running daemon; stop it first")
+}
+
+// Path returns the path of the daemon's save.
+func (r *RemoteStore) Path() string {
+	return r.local.Path()
+}
+
+// Close closes the connection to the daemon.
+func (r *RemoteStore) Close() error {
+	return r.client.Close()
+}
+
+// Revision returns the daemon revision last loaded or written by this store.
+func (r *RemoteStore) Revision() int64 {
+	return r.revision
+}
+
+// Changed reports whether the daemon's pet changed since this store last
+// loaded or saved it.
+func (r *RemoteStore) Changed() (bool, error) {
+	state, err := r.client.Revision()
+	if err != nil {
+		return false, remoteErr(err)
+	}
+	return state.Revision != r.revision, nil
+}
+
+func (r *RemoteStore) RecordAction(at time.Time, pet *game.Pet, action string, res game.ActionResult) error {
+	if rec, ok := r.local.(store.HistoryRecorder); ok {
+		return rec.RecordAction(at, pet, action, res)
+	}
+	return nil
+}
+
+func (r *RemoteStore) RecordAdventure(at time.Time, pet *game.Pet, res game.AdventureResult) error {
+	if rec, ok := r.local.(store.HistoryRecorder); ok {
+		return rec.RecordAdventure(at, pet, res)
+	}
+	return nil
+}
+
+func (r *RemoteStore) RecordEvolution(at time.Time, pet *game.Pet, fromStage, toStage string) error {
+	if rec, ok := r.local.(store.HistoryRecorder); ok {
+		return rec.RecordEvolution(at, pet, fromStage, toStage)
+	}
+	return nil
+}
+
+func (r *RemoteStore) RecordDecay(at time.Time, pet *game.Pet, rounds []game.DecayRoundResult) error {
+	if rec, ok := r.local.(store.HistoryRecorder); ok {
+		return rec.RecordDecay(at, pet, rounds)
+	}
+	return nil
+}
+
+// remoteErr maps the daemon's conflict error to store.ErrConflict.
+func remoteErr(err error) error {
+	var rpcErr *RPCError
+	if errors.As(err, &rpcErr) && rpcErr.Code == CodeConflict {
+		return fmt.Errorf("%w: %s", store.ErrConflict, rpcErr.Message)
+	}
+	return err
+}
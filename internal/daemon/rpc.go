@@ -0,0 +1,122 @@
+package daemon
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"clipet/internal/game"
+)
+
+// JSON-RPC 2.0 error codes used by the daemon.
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeInternalError  = -32603
+	CodeNoPet          = -32001 // the profile has no pet
+	CodeConflict       = -32002 // the pet changed since the client loaded it
+)
+
+// RPC method names.
+const (
+	MethodStatus    = "status"
+	MethodAct       = "act"
+	MethodSubscribe = "subscribe"
+	MethodLoad      = "load"
+	MethodSave      = "save"
+	MethodRevision  = "revision"
+)
+
+// Notification method names sent to subscribers.
+const (
+	NotifyEvent = "event" // params: store.Event
+)
+
+// Request is a JSON-RPC 2.0 request or notification (no ID).
+// Messages are exchanged as one JSON document per line.
+type Request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// Response is a JSON-RPC 2.0 response. A response without ID and with a
+// method is a server notification.
+type Response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *RPCError       `json:"error,omitempty"`
+}
+
+// RPCError is a JSON-RPC error object. It implements error so clients can
+// return it directly.
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("%s (code %d)", e.Message, e.Code)
+}
+
+// ActParams are the parameters of the act method.
+type ActParams struct {
+	// Action is feed, play, rest, heal, talk, skill:<id>, item:<id>, buy:<id>,
+	// equip:<id> or unequip:<id>.
+	Action string `json:"action"`
+	// Source is recorded in the journal; defaults to "daemon".
+	Source string `json:"source,omitempty"`
+}
+
+// ActResult is the result of the act method. Message is the game's own,
+// untranslated text; clients localize ErrorType instead.
+type ActResult struct {
+	Action    string             `json:"action"`
+	Pet       string             `json:"pet"`
+	Species   string             `json:"species"`
+	OK        bool               `json:"ok"`
+	ErrorType string             `json:"error_type,omitempty"`
+	Message   string             `json:"message,omitempty"`
+	Changes   map[string][2]int  `json:"changes"`
+	Animation string             `json:"animation,omitempty"`
+	Dialogue  string             `json:"dialogue,omitempty"`
+	Evolution *Evolution         `json:"evolution,omitempty"`
+	Crises    []game.CrisisEvent `json:"crises,omitempty"` // crises resolved by the action
+
+	Achievements []string `json:"achievements,omitempty"` // keys of the achievements the action unlocked
+}
+
+// Evolution describes an automatic evolution triggered by an action.
+type Evolution struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Phase string `json:"phase"`
+}
+
+// PetState is the result of the load method: the daemon's pet, nil while
+// the profile has no pet, with time advanced to the last whole step.
+type PetState struct {
+	Revision int64     `json:"revision"` // changes whenever the daemon's pet does
+	Pet      *game.Pet `json:"pet"`
+}
+
+// RevisionState is the result of the revision method. It lets clients
+// notice changes without loading the pet or advancing its time.
+type RevisionState struct {
+	Revision int64 `json:"revision"`
+	HasPet   bool  `json:"has_pet"`
+}
+
+// SaveParams are the parameters of the save method. The pet replaces the
+// daemon's only if Revision is still current, or if the daemon has no pet
+// to lose; otherwise the call fails with CodeConflict. A living pet keeps the daemon's clock (LastCheckedAt and
+// pending offline time), since the daemon advances its time.
+type SaveParams struct {
+	Revision int64     `json:"revision"`
+	Pet      *game.Pet `json:"pet"`
+}
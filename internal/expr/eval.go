@@ -0,0 +1,232 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+)
+
+// value is the result of evaluating a node.
+type value struct {
+	n float64
+	b bool
+}
+
+type node interface {
+	typ() Type
+	pos() int
+	eval(vars Vars) (value, error)
+}
+
+type numLit struct {
+	col int
+	v   float64
+}
+
+func (n *numLit) typ() Type                { return Number }
+func (n *numLit) pos() int                 { return n.col }
+func (n *numLit) eval(Vars) (value, error) { return value{n: n.v}, nil }
+
+type boolLit struct {
+	col int
+	v   bool
+}
+
+func (n *boolLit) typ() Type                { return Bool }
+func (n *boolLit) pos() int                 { return n.col }
+func (n *boolLit) eval(Vars) (value, error) { return value{b: n.v}, nil }
+
+type varRef struct {
+	col  int
+	name string
+}
+
+func (n *varRef) typ() Type { return Number }
+func (n *varRef) pos() int  { return n.col }
+func (n *varRef) eval(vars Vars) (value, error) {
+	if vars == nil {
+		return value{}, nil
+	}
+	return value{n: vars(n.name)}, nil
+}
+
+type unary struct {
+	col int
+	op  string
+	x   node
+}
+
+func (n *unary) typ() Type {
+	if n.op == "not" {
+		return Bool
+	}
+	return Number
+}
+func (n *unary) pos() int { return n.col }
+func (n *unary) eval(vars Vars) (value, error) {
+	x, err := n.x.eval(vars)
+	if err != nil {
+		return value{}, err
+	}
+	if n.op == "not" {
+		return value{b: !x.b}, nil
+	}
+	return value{n: -x.n}, nil
+}
+
+type binary struct {
+	col  int
+	op   string
+	l, r node
+}
+
+func (n *binary) typ() Type {
+	switch n.op {
+	case "+", "-", "*", "/", "%":
+		return Number
+	}
+	return Bool
+}
+func (n *binary) pos() int { return n.l.pos() }
+func (n *binary) eval(vars Vars) (value, error) {
+	l, err := n.l.eval(vars)
+	if err != nil {
+		return value{}, err
+	}
+	// Short-circuit logic operators.
+	switch n.op {
+	case "and":
+		if !l.b {
+			return value{}, nil
+		}
+		return n.r.eval(vars)
+	case "or":
+		if l.b {
+			return value{b: true}, nil
+		}
+		return n.r.eval(vars)
+	}
+
+	r, err := n.r.eval(vars)
+	if err != nil {
+		return value{}, err
+	}
+	boolOperands := n.l.typ() == Bool
+	switch n.op {
+	case "+":
+		return value{n: l.n + r.n}, nil
+	case "-":
+		return value{n: l.n - r.n}, nil
+	case "*":
+		return value{n: l.n * r.n}, nil
+	case "/":
+		if r.n == 0 {
+			return value{}, &Error{Col: n.col, Msg: "division by zero"}
+		}
+		return value{n: l.n / r.n}, nil
+	case "%":
+		if r.n == 0 {
+			return value{}, &Error{Col: n.col, Msg: "modulo by zero"}
+		}
+		return value{n: math.Mod(l.n, r.n)}, nil
+	case "==":
+		if boolOperands {
+			return value{b: l.b == r.b}, nil
+		}
+		return value{b: l.n == r.n}, nil
+	case "!=":
+		if boolOperands {
+			return value{b: l.b != r.b}, nil
+		}
+		return value{b: l.n != r.n}, nil
+	case "<":
+		return value{b: l.n < r.n}, nil
+	case "<=":
+		return value{b: l.n <= r.n}, nil
+	case ">":
+		return value{b: l.n > r.n}, nil
+	case ">=":
+		return value{b: l.n >= r.n}, nil
+	}
+	return value{}, &Error{Col: n.col, Msg: fmt.Sprintf("unknown operator %q", n.op)}
+}
+
+type call struct {
+	col  int
+	name string
+	fn   function
+	args []node
+}
+
+func (n *call) typ() Type { return n.fn.ret }
+func (n *call) pos() int  { return n.col }
+func (n *call) eval(vars Vars) (value, error) {
+	args := make([]value, len(n.args))
+	for i, a := range n.args {
+		v, err := a.eval(vars)
+		if err != nil {
+			return value{}, err
+		}
+		args[i] = v
+	}
+	return n.fn.impl(args), nil
+}
+
+// function is a builtin function. maxArgs < 0 means variadic.
+type function struct {
+	arg, ret         Type
+	minArgs, maxArgs int
+	impl             func(args []value) value
+}
+
+func (f function) arity() string {
+	switch {
+	case f.minArgs == f.maxArgs && f.minArgs == 1:
+		return "1 argument"
+	case f.minArgs == f.maxArgs:
+		return fmt.Sprintf("%d arguments", f.minArgs)
+	default:
+		return fmt.Sprintf("at least %d argument(s)", f.minArgs)
+	}
+}
+
+var funcs = map[string]function{
+	"min": {arg: Number, ret: Number, minArgs: 1, maxArgs: -1, impl: func(a []value) value {
+		m := a[0].n
+		for _, v := range a[1:] {
+			m = math.Min(m, v.n)
+		}
+		return value{n: m}
+	}},
+	"max": {arg: Number, ret: Number, minArgs: 1, maxArgs: -1, impl: func(a []value) value {
+		m := a[0].n
+		for _, v := range a[1:] {
+			m = math.Max(m, v.n)
+		}
+		return value{n: m}
+	}},
+	"abs": {arg: Number, ret: Number, minArgs: 1, maxArgs: 1, impl: func(a []value) value {
+		return value{n: math.Abs(a[0].n)}
+	}},
+	"floor": {arg: Number, ret: Number, minArgs: 1, maxArgs: 1, impl: func(a []value) value {
+		return value{n: math.Floor(a[0].n)}
+	}},
+	"clamp": {arg: Number, ret: Number, minArgs: 3, maxArgs: 3, impl: func(a []value) value {
+		return value{n: math.Max(a[1].n, math.Min(a[2].n, a[0].n))}
+	}},
+	"any": {arg: Bool, ret: Bool, minArgs: 1, maxArgs: -1, impl: func(a []value) value {
+		for _, v := range a {
+			if v.b {
+				return value{b: true}
+			}
+		}
+		return value{}
+	}},
+	"all": {arg: Bool, ret: Bool, minArgs: 1, maxArgs: -1, impl: func(a []value) value {
+		for _, v := range a {
+			if !v.b {
+				return value{}
+			}
+		}
+		return value{b: true}
+	}},
+}
@@ -0,0 +1,155 @@
+// Package expr implements the small, side-effect free expression language
+// used by species packs, e.g.
+//
+//	happiness > health and fire_points >= 2 * ice_points
+//	any(hour >= 22, hour < 6) or min(hunger, energy) < 20
+//
+// Values are numbers or booleans. Identifiers are numeric variables looked
+// up at evaluation time. Expressions are type-checked when parsed, so a
+// pack with a broken expression is rejected at load time with the column of
+// the problem.
+package expr
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Type is the static type of an expression.
+type Type int
+
+const (
+	Number Type = iota
+	Bool
+)
+
+func (t Type) String() string {
+	if t == Bool {
+		return "boolean"
+	}
+	return "number"
+}
+
+// Vars resolves a variable name to its value. Unknown names should
+// resolve to 0.
+type Vars func(name string) float64
+
+// Error is a parse, type or evaluation error at a 1-based column of the
+// source.
+type Error struct {
+	Col int
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("col %d: %s", e.Col, e.Msg)
+}
+
+// Ident is a variable read by an expression, at a 1-based column.
+type Ident struct {
+	Name string
+	Col  int
+}
+
+// Expr is a parsed, type-checked expression. It is immutable and safe for
+// concurrent use.
+type Expr struct {
+	src  string
+	root node
+}
+
+// parsed memoizes Parse results; pack expressions are re-evaluated often.
+var parsed sync.Map // string -> parseResult
+
+type parseResult struct {
+	e   *Expr
+	err error
+}
+
+// Parse parses and type-checks src. Results are cached by source text.
+func Parse(src string) (*Expr, error) {
+	if r, ok := parsed.Load(src); ok {
+		r := r.(parseResult)
+		return r.e, r.err
+	}
+	p := &parser{lex: newLexer(src)}
+	root, err := p.parse()
+	var e *Expr
+	if err == nil {
+		e = &Expr{src: src, root: root}
+	}
+	parsed.Store(src, parseResult{e, err})
+	return e, err
+}
+
+// ParseBool parses src and requires a boolean (condition) expression.
+func ParseBool(src string) (*Expr, error) {
+	return parseTyped(src, Bool)
+}
+
+// ParseNumber parses src and requires a numeric expression.
+func ParseNumber(src string) (*Expr, error) {
+	return parseTyped(src, Number)
+}
+
+func parseTyped(src string, want Type) (*Expr, error) {
+	e, err := Parse(src)
+	if err != nil {
+		return nil, err
+	}
+	if e.Type() != want {
+		return nil, &Error{Col: e.root.pos(), Msg: fmt.Sprintf("expression must be a %s, got %s", want, e.Type())}
+	}
+	return e, nil
+}
+
+// String returns the source text.
+func (e *Expr) String() string {
+	return e.src
+}
+
+// Type returns the static type of the expression.
+func (e *Expr) Type() Type {
+	return e.root.typ()
+}
+
+// Idents returns the variables the expression reads, lowercased, one entry
+// per occurrence in source order. Validators use it to catch misspelled
+// names, which would otherwise evaluate as unset variables.
+func (e *Expr) Idents() []Ident {
+	return idents(e.root, nil)
+}
+
+func idents(n node, out []Ident) []Ident {
+	switch n := n.(type) {
+	case *varRef:
+		out = append(out, Ident{Name: n.name, Col: n.col})
+	case *unary:
+		out = idents(n.x, out)
+	case *binary:
+		out = idents(n.r, idents(n.l, out))
+	case *call:
+		for _, a := range n.args {
+			out = idents(a, out)
+		}
+	}
+	return out
+}
+
+// Bool evaluates a boolean expression.
+func (e *Expr) Bool(vars Vars) (bool, error) {
+	if e.Type() != Bool {
+		return false, &Error{Col: 1, Msg: "not a boolean expression"}
+	}
+	v, err := e.root.eval(vars)
+	return v.b, err
+}
+
+// Number evaluates a numeric expression.
+func (e *Expr) Number(vars Vars) (float64, error) {
+	if e.Type() != Number {
+		return 0, &Error{Col: 1, Msg: "not a numeric expression"}
+	}
+	v, err := e.root.eval(vars)
+	return v.n, err
+}
@@ -0,0 +1,110 @@
+package expr
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func vars(m map[string]float64) Vars {
+	return func(name string) float64 { return m[name] }
+}
+
+func TestBool(t *testing.T) {
+	env := vars(map[string]float64{
+		"happiness": 80, "health": 60, "fire_points": 9, "ice_points": 4, "hour": 23,
+	})
+	cases := map[string]bool{
+		"happiness > health and fire_points >= 2 * ice_points": true,
+		"happiness > health and fire_points >= 3 * ice_points": false,
+		"any(hour >= 22, hour < 6)":                            true,
+		"all(hour >= 22, hour < 6)":                            false,
+		"not (health > 50) || unknown_acc == 0":                true,
+		"min(happiness, health) == 60 && max(1, 2, 3) == 3":    true,
+		"(fire_points - ice_points) % 2 == 1":                  true,
+		"clamp(fire_points, 0, 5) == 5 and abs(-2) == 2":       true,
+		"Happiness > 79.5":                                     true,
+		"true != (1 > 2)":                                      true,
+	}
+	for src, want := range cases {
+		e, err := ParseBool(src)
+		if err != nil {
+			t.Errorf("ParseBool(%q): %v", src, err)
+			continue
+		}
+		got, err := e.Bool(env)
+		if err != nil || got != want {
+			t.Errorf("%q = %v, %v; want %v", src, got, err, want)
+		}
+	}
+}
+
+func TestNumber(t *testing.T) {
+	e, err := ParseNumber("magic * 0.01 + -1")
+	if err != nil {
+		t.Fatalf("ParseNumber: %v", err)
+	}
+	got, err := e.Number(vars(map[string]float64{"magic": 250}))
+	if err != nil || got != 1.5 {
+		t.Errorf("Number = %v, %v; want 1.5", got, err)
+	}
+}
+
+func TestErrorPositions(t *testing.T) {
+	cases := []struct {
+		src string
+		col int
+		msg string
+	}{
+		{"happiness >", 12, "unexpected end of expression"},
+		{"happiness > health and", 23, "unexpected end of expression"},
+		{"maxx(hunger, 1) > 2", 1, `unknown function "maxx"`},
+		{"hunger > 2 and energy", 16, "and expects a boolean operand, got number"},
+		{"1 < hunger < 3", 12, "cannot be chained"},
+		{"(hunger > 1", 12, `expected ")" to close "(" at col 1`},
+		{"hunger = 1", 8, `use "=="`},
+		{"hunger > 1 $", 12, "unexpected character"},
+		{"abs(1, 2) > 0", 1, "abs() takes 1 argument, got 2"},
+		{"any(hunger) ", 5, "any() expects a boolean operand"},
+		{"hunger + 1", 1, "expression must be a boolean, got number"},
+		{"", 1, "empty expression"},
+	}
+	for _, tc := range cases {
+		_, err := ParseBool(tc.src)
+		var perr *Error
+		if !errors.As(err, &perr) {
+			t.Errorf("ParseBool(%q) error = %v, want *Error", tc.src, err)
+			continue
+		}
+		if perr.Col != tc.col || !strings.Contains(perr.Msg, tc.msg) {
+			t.Errorf("ParseBool(%q) = col %d %q; want col %d containing %q", tc.src, perr.Col, perr.Msg, tc.col, tc.msg)
+		}
+	}
+}
+
+func TestIdents(t *testing.T) {
+	e, err := Parse("Fire_Points > 2 and not (min(hunger, -ice) < 1)")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	got := e.Idents()
+	want := []Ident{{"fire_points", 1}, {"hunger", 30}, {"ice", 39}}
+	if len(got) != len(want) {
+		t.Fatalf("Idents = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Idents[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestDivisionByZero(t *testing.T) {
+	e, err := ParseBool("fire_points / ice_points > 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Bool(vars(map[string]float64{"fire_points": 1})); err == nil {
+		t.Error("division by zero should be an evaluation error")
+	}
+}
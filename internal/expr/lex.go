@@ -0,0 +1,125 @@
+package expr
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokIdent
+	tokOp // + - * / % < <= > >= == != ! && ||
+	tokLParen
+	tokRParen
+	tokComma
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	num  float64
+	col  int // 1-based, in runes
+}
+
+func (t token) String() string {
+	if t.kind == tokEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+type lexer struct {
+	src string
+	off int // byte offset
+	col int // 1-based rune column of off
+}
+
+func newLexer(src string) *lexer {
+	return &lexer{src: src, col: 1}
+}
+
+func (l *lexer) peekRune() rune {
+	if l.off >= len(l.src) {
+		return 0
+	}
+	r, _ := utf8.DecodeRuneInString(l.src[l.off:])
+	return r
+}
+
+func (l *lexer) advance() rune {
+	r, size := utf8.DecodeRuneInString(l.src[l.off:])
+	l.off += size
+	l.col++
+	return r
+}
+
+// next returns the next token.
+func (l *lexer) next() (token, error) {
+	for l.off < len(l.src) && unicode.IsSpace(l.peekRune()) {
+		l.advance()
+	}
+	start, col := l.off, l.col
+	if l.off >= len(l.src) {
+		return token{kind: tokEOF, col: col}, nil
+	}
+
+	r := l.peekRune()
+	switch {
+	case r >= '0' && r <= '9' || r == '.':
+		for l.off < len(l.src) {
+			c := l.peekRune()
+			if !(c >= '0' && c <= '9' || c == '.') {
+				break
+			}
+			l.advance()
+		}
+		text := l.src[start:l.off]
+		n, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return token{}, &Error{Col: col, Msg: fmt.Sprintf("invalid number %q", text)}
+		}
+		return token{kind: tokNumber, text: text, num: n, col: col}, nil
+
+	case r == '_' || unicode.IsLetter(r):
+		for l.off < len(l.src) {
+			c := l.peekRune()
+			if !(c == '_' || unicode.IsLetter(c) || unicode.IsDigit(c)) {
+				break
+			}
+			l.advance()
+		}
+		return token{kind: tokIdent, text: l.src[start:l.off], col: col}, nil
+	}
+
+	l.advance()
+	switch r {
+	case '(':
+		return token{kind: tokLParen, text: "(", col: col}, nil
+	case ')':
+		return token{kind: tokRParen, text: ")", col: col}, nil
+	case ',':
+		return token{kind: tokComma, text: ",", col: col}, nil
+	case '+', '-', '*', '/', '%':
+		return token{kind: tokOp, text: string(r), col: col}, nil
+	case '<', '>', '!', '=':
+		if l.peekRune() == '=' {
+			l.advance()
+			return token{kind: tokOp, text: string(r) + "=", col: col}, nil
+		}
+		if r == '=' {
+			return token{}, &Error{Col: col, Msg: `unexpected "=" (use "==" to compare)`}
+		}
+		return token{kind: tokOp, text: string(r), col: col}, nil
+	case '&', '|':
+		if l.peekRune() == r {
+			l.advance()
+			return token{kind: tokOp, text: string(r) + string(r), col: col}, nil
+		}
+	}
+	return token{}, &Error{Col: col, Msg: fmt.Sprintf("unexpected character %q", r)}
+}
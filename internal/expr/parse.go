@@ -0,0 +1,280 @@
+package expr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// parser is a recursive-descent parser. Precedence, lowest first:
+//
+//	or ||   and &&   not !   == != < <= > >=   + -   * / %   unary -
+type parser struct {
+	lex *lexer
+	tok token
+}
+
+func (p *parser) parse() (node, error) {
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	if p.tok.kind == tokEOF {
+		return nil, &Error{Col: p.tok.col, Msg: "empty expression"}
+	}
+	n, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokEOF {
+		return nil, p.unexpected()
+	}
+	return n, nil
+}
+
+func (p *parser) next() error {
+	tok, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+	return nil
+}
+
+func (p *parser) unexpected() error {
+	return &Error{Col: p.tok.col, Msg: "unexpected " + p.tok.String()}
+}
+
+// isOp reports whether the current token is one of the given operators or
+// keywords.
+func (p *parser) isOp(ops ...string) bool {
+	if p.tok.kind != tokOp && p.tok.kind != tokIdent {
+		return false
+	}
+	for _, op := range ops {
+		if p.tok.text == op {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) parseOr() (node, error) {
+	return p.parseLogical(p.parseAnd, "or", "||")
+}
+
+func (p *parser) parseAnd() (node, error) {
+	return p.parseLogical(p.parseNot, "and", "&&")
+}
+
+func (p *parser) parseLogical(operand func() (node, error), ops ...string) (node, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp(ops...) {
+		op := p.tok
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		if err := wantType(left, Bool, op.text); err != nil {
+			return nil, err
+		}
+		if err := wantType(right, Bool, op.text); err != nil {
+			return nil, err
+		}
+		left = &binary{col: op.col, op: ops[0], l: left, r: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (node, error) {
+	if p.isOp("not", "!") {
+		op := p.tok
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		x, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		if err := wantType(x, Bool, op.text); err != nil {
+			return nil, err
+		}
+		return &unary{col: op.col, op: "not", x: x}, nil
+	}
+	return p.parseCompare()
+}
+
+var compareOps = []string{"==", "!=", "<", "<=", ">", ">="}
+
+func (p *parser) parseCompare() (node, error) {
+	left, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	if !p.isOp(compareOps...) {
+		return left, nil
+	}
+	op := p.tok
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	right, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	if p.isOp(compareOps...) {
+		return nil, &Error{Col: p.tok.col, Msg: "comparisons cannot be chained, combine them with and"}
+	}
+
+	if op.text == "==" || op.text == "!=" {
+		if left.typ() != right.typ() {
+			return nil, &Error{Col: op.col, Msg: fmt.Sprintf("cannot compare %s with %s", left.typ(), right.typ())}
+		}
+	} else {
+		if err := wantType(left, Number, op.text); err != nil {
+			return nil, err
+		}
+		if err := wantType(right, Number, op.text); err != nil {
+			return nil, err
+		}
+	}
+	return &binary{col: op.col, op: op.text, l: left, r: right}, nil
+}
+
+func (p *parser) parseSum() (node, error) {
+	return p.parseArith(p.parseProduct, "+", "-")
+}
+
+func (p *parser) parseProduct() (node, error) {
+	return p.parseArith(p.parseUnary, "*", "/", "%")
+}
+
+func (p *parser) parseArith(operand func() (node, error), ops ...string) (node, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokOp && p.isOp(ops...) {
+		op := p.tok
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		if err := wantType(left, Number, op.text); err != nil {
+			return nil, err
+		}
+		if err := wantType(right, Number, op.text); err != nil {
+			return nil, err
+		}
+		left = &binary{col: op.col, op: op.text, l: left, r: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.tok.kind == tokOp && p.tok.text == "-" {
+		op := p.tok
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if err := wantType(x, Number, "-"); err != nil {
+			return nil, err
+		}
+		return &unary{col: op.col, op: "-", x: x}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.tok
+	switch tok.kind {
+	case tokNumber:
+		return &numLit{col: tok.col, v: tok.num}, p.next()
+
+	case tokLParen:
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokRParen {
+			return nil, &Error{Col: p.tok.col, Msg: fmt.Sprintf("expected \")\" to close \"(\" at col %d, got %s", tok.col, p.tok)}
+		}
+		return x, p.next()
+
+	case tokIdent:
+		switch tok.text {
+		case "true", "false":
+			return &boolLit{col: tok.col, v: tok.text == "true"}, p.next()
+		case "and", "or", "not":
+			return nil, p.unexpected()
+		}
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		if p.tok.kind == tokLParen {
+			return p.parseCall(tok)
+		}
+		return &varRef{col: tok.col, name: strings.ToLower(tok.text)}, nil
+	}
+	return nil, p.unexpected()
+}
+
+func (p *parser) parseCall(name token) (node, error) {
+	fn, ok := funcs[name.text]
+	if !ok {
+		return nil, &Error{Col: name.col, Msg: fmt.Sprintf("unknown function %q", name.text)}
+	}
+	if err := p.next(); err != nil { // consume "("
+		return nil, err
+	}
+
+	var args []node
+	for p.tok.kind != tokRParen {
+		if len(args) > 0 {
+			if p.tok.kind != tokComma {
+				return nil, &Error{Col: p.tok.col, Msg: fmt.Sprintf("expected \",\" or \")\" in call to %s, got %s", name.text, p.tok)}
+			}
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+		}
+		arg, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := wantType(arg, fn.arg, name.text+"()"); err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	if err := p.next(); err != nil { // consume ")"
+		return nil, err
+	}
+
+	if len(args) < fn.minArgs || fn.maxArgs >= 0 && len(args) > fn.maxArgs {
+		return nil, &Error{Col: name.col, Msg: fmt.Sprintf("%s() takes %s, got %d", name.text, fn.arity(), len(args))}
+	}
+	return &call{col: name.col, name: name.text, fn: fn, args: args}, nil
+}
+
+// wantType reports a type error for an operand of op.
+func wantType(n node, want Type, op string) error {
+	if n.typ() == want {
+		return nil
+	}
+	return &Error{Col: n.pos(), Msg: fmt.Sprintf("%s expects a %s operand, got %s", op, want, n.typ())}
+}
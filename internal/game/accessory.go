@@ -0,0 +1,73 @@
+package game
+
+import (
+	"clipet/internal/plugin"
+	"slices"
+)
+
+// IsEquipped reports whether the pet wears the accessory.
+func (p *Pet) IsEquipped(itemID string) bool {
+	return slices.Contains(p.Equipped, itemID)
+}
+
+// EquipAccessory puts on an accessory from the inventory. An accessory
+// already worn in the same slot is taken off.
+func (p *Pet) EquipAccessory(itemID string) ActionResult {
+	item, res := p.ownedAccessory(itemID)
+	if item == nil {
+		return res
+	}
+	if !p.IsEquipped(itemID) {
+		if item.Slot != "" {
+			p.Equipped = slices.DeleteFunc(p.Equipped, func(id string) bool {
+				other := p.registry.GetItem(p.Species, id)
+				return other != nil && other.Slot == item.Slot
+			})
+		}
+		p.Equipped = append(p.Equipped, itemID)
+	}
+	return ActionResult{OK: true, Message: "戴上了" + item.Name, Changes: map[string][2]int{}}
+}
+
+// UnequipAccessory takes off a worn accessory.
+func (p *Pet) UnequipAccessory(itemID string) ActionResult {
+	item, res := p.ownedAccessory(itemID)
+	if item == nil {
+		return res
+	}
+	p.Equipped = slices.DeleteFunc(p.Equipped, func(id string) bool { return id == itemID })
+	return ActionResult{OK: true, Message: "摘下了" + item.Name, Changes: map[string][2]int{}}
+}
+
+// EquippedOverlays returns the overlays of the worn accessories for the
+// pet's current stage, in the order they were put on.
+func (p *Pet) EquippedOverlays() []plugin.AccessoryOverlay {
+	if p.registry == nil {
+		return nil
+	}
+	var overlays []plugin.AccessoryOverlay
+	for _, id := range p.Equipped {
+		if item := p.registry.GetItem(p.Species, id); item != nil {
+			if ov := item.OverlayFor(p.StageID); ov != nil {
+				overlays = append(overlays, *ov)
+			}
+		}
+	}
+	return overlays
+}
+
+// ownedAccessory looks up an accessory the pet owns. If there is none, it
+// returns nil and the failed result to report.
+func (p *Pet) ownedAccessory(itemID string) (*plugin.Item, ActionResult) {
+	if p.ItemCount(itemID) <= 0 {
+		return nil, failResultWithType(ErrNoItem, "背包里没有这个物品！")
+	}
+	var item *plugin.Item
+	if p.registry != nil {
+		item = p.registry.GetItem(p.Species, itemID)
+	}
+	if item == nil || item.Kind != plugin.ItemAccessory {
+		return nil, failResultWithType(ErrNotAccessory, "这个物品不是饰品")
+	}
+	return item, ActionResult{}
+}
@@ -0,0 +1,127 @@
+package game
+
+import (
+	"testing"
+
+	"clipet/internal/plugin"
+)
+
+// accessoryRegistry loads a pack with two hats sharing a slot and a scarf
+// that is only drawn on babies.
+func accessoryRegistry(t *testing.T) *plugin.Registry {
+	t.Helper()
+	reg, _ := testRegistry(t, "", map[string]string{"items.toml": `
+[[items]]
+id = "cap"
+name = "Cap"
+kind = "accessory"
+slot = "head"
+  [[items.overlays]]
+  x = 1
+  y = -1
+  art = "_^_"
+
+[[items]]
+id = "crown"
+name = "Crown"
+kind = "accessory"
+slot = "head"
+
+[[items]]
+id = "scarf"
+name = "Scarf"
+kind = "accessory"
+slot = "neck"
+  [[items.overlays]]
+  stage = ["baby"]
+  y = 1
+  art = "~~"
+
+[[items]]
+id = "fish"
+name = "Fish"
+kind = "food"
+`})
+	return reg
+}
+
+func TestEquipAccessory(t *testing.T) {
+	reg := accessoryRegistry(t)
+	pet := testPet(reg)
+	for _, id := range []string{"cap", "crown", "scarf", "fish"} {
+		pet.AddItem(id, 1)
+	}
+
+	if res := pet.EquipAccessory("cap"); !res.OK {
+		t.Fatalf("EquipAccessory(cap) failed: %s", res.Message)
+	}
+	pet.EquipAccessory("scarf")
+	if !pet.IsEquipped("cap") || !pet.IsEquipped("scarf") {
+		t.Fatalf("equipped = %v, want cap and scarf", pet.Equipped)
+	}
+
+	// The crown takes the cap's slot
+	pet.EquipAccessory("crown")
+	if pet.IsEquipped("cap") || !pet.IsEquipped("crown") {
+		t.Errorf("equipped = %v, want the crown instead of the cap", pet.Equipped)
+	}
+
+	if res := pet.UnequipAccessory("crown"); !res.OK || pet.IsEquipped("crown") {
+		t.Errorf("crown still worn after UnequipAccessory: %v", pet.Equipped)
+	}
+
+	if res := pet.EquipAccessory("fish"); res.ErrorType != ErrNotAccessory {
+		t.Errorf("equipping food = %q, want %q", res.ErrorType, ErrNotAccessory)
+	}
+	if res := pet.UseItem("scarf"); res.OK {
+		t.Error("UseItem consumed an accessory")
+	}
+
+	// Losing the item takes it off
+	pet.RemoveItem("scarf", 1)
+	if pet.IsEquipped("scarf") {
+		t.Error("scarf still worn after leaving the inventory")
+	}
+	if res := pet.EquipAccessory("scarf"); res.ErrorType != ErrNoItem {
+		t.Errorf("equipping a missing item = %q, want %q", res.ErrorType, ErrNoItem)
+	}
+}
+
+func TestEquippedOverlays(t *testing.T) {
+	reg := accessoryRegistry(t)
+	pet := testPet(reg)
+	pet.AddItem("cap", 1)
+	pet.AddItem("scarf", 1)
+	pet.EquipAccessory("scarf")
+	pet.EquipAccessory("cap")
+
+	overlays := pet.EquippedOverlays()
+	if len(overlays) != 2 || overlays[0].Art != "~~" || overlays[1].Art != "_^_" {
+		t.Fatalf("baby overlays = %+v, want the scarf then the cap", overlays)
+	}
+
+	// The scarf has no overlay outside the baby stage
+	pet.StageID = "egg"
+	if overlays := pet.EquippedOverlays(); len(overlays) != 1 || overlays[0].Art != "_^_" {
+		t.Errorf("egg overlays = %+v, want only the cap", overlays)
+	}
+}
+
+func TestValidateOverlays(t *testing.T) {
+	bad := *accessoryRegistry(t).GetSpecies(testSpecies)
+	bad.Items = append([]plugin.Item{
+		{ID: "bone", Kind: plugin.ItemToy, Overlays: []plugin.AccessoryOverlay{{Art: "x"}}},
+		{ID: "bell", Kind: plugin.ItemAccessory, Overlays: []plugin.AccessoryOverlay{{Stage: []string{"teen"}}}},
+	}, bad.Items...)
+
+	fields := map[string]bool{}
+	errs, _ := plugin.Validate(&bad)
+	for _, e := range errs {
+		fields[e.Field] = true
+	}
+	for _, want := range []string{"items[0].overlays", "items[1].overlays[0].art", "items[1].overlays[0].stage"} {
+		if !fields[want] {
+			t.Errorf("Validate did not report %s (got %v)", want, fields)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package game
+
+import (
+	"clipet/internal/plugin"
+	"time"
+)
+
+// AchievementUnlock records when an achievement was unlocked and by which
+// pet of the household.
+type AchievementUnlock struct {
+	At  time.Time `json:"at"`
+	Pet string    `json:"pet"`
+}
+
+// AchievementEvent is something a pet did that achievements can count.
+type AchievementEvent struct {
+	Type    string // one of the plugin.AchievementEvent* constants
+	Subject string // action, adventure, stage or crisis ID
+}
+
+// AchievementProgress is the achievement state of a profile, shared by all
+// pets of its household: the unlocked achievements and the events counted
+// toward those still locked, both keyed by plugin.Achievement.Key.
+type AchievementProgress struct {
+	Unlocked map[string]AchievementUnlock `json:"unlocked"`
+	Counts   map[string]int               `json:"counts,omitempty"`
+}
+
+// NewAchievementProgress returns the progress of a profile that has not
+// unlocked anything yet.
+func NewAchievementProgress() *AchievementProgress {
+	return &AchievementProgress{
+		Unlocked: make(map[string]AchievementUnlock),
+		Counts:   make(map[string]int),
+	}
+}
+
+// Unlock returns when the achievement was unlocked, if it was.
+func (ap *AchievementProgress) Unlock(a plugin.Achievement) (AchievementUnlock, bool) {
+	u, ok := ap.Unlocked[a.Key()]
+	return u, ok
+}
+
+// Count returns the matching events counted toward a locked achievement.
+func (ap *AchievementProgress) Count(a plugin.Achievement) int {
+	return ap.Counts[a.Key()]
+}
+
+// Observe counts the events toward the achievements the pet can unlock,
+// then unlocks every achievement whose event count is reached and whose
+// condition holds for the pet. Called without events it only checks the
+// conditions, e.g. after time advanced. Newly unlocked achievements are
+// returned and queued on the pet for front ends (see TakeAchievements).
+func (ap *AchievementProgress) Observe(pet *Pet, at time.Time, events ...AchievementEvent) []plugin.Achievement {
+	if pet.registry == nil {
+		return nil
+	}
+	if ap.Unlocked == nil {
+		ap.Unlocked = make(map[string]AchievementUnlock)
+	}
+	if ap.Counts == nil {
+		ap.Counts = make(map[string]int)
+	}
+
+	var unlocked []plugin.Achievement
+	for _, a := range pet.registry.GetAchievements(pet.Species) {
+		key := a.Key()
+		if _, ok := ap.Unlocked[key]; ok {
+			continue
+		}
+		for _, ev := range events {
+			if a.Matches(ev.Type, ev.Subject) {
+				ap.Counts[key]++
+			}
+		}
+		if ap.Counts[key] < a.Needed() || !pet.EvalCondition(a.Condition) {
+			continue
+		}
+		ap.Unlocked[key] = AchievementUnlock{At: at, Pet: pet.Name}
+		delete(ap.Counts, key)
+		unlocked = append(unlocked, a)
+	}
+	pet.achievements = append(pet.achievements, unlocked...)
+	return unlocked
+}
+
+// TakeAchievements returns the achievements unlocked by the pet since the
+// last call and clears them.
+func (p *Pet) TakeAchievements() []plugin.Achievement {
+	unlocked := p.achievements
+	p.achievements = nil
+	return unlocked
+}
@@ -0,0 +1,135 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"clipet/internal/assets"
+	"clipet/internal/plugin"
+)
+
+// achievementRegistry returns a registry with two built-in achievements
+// and two of testSpecies.
+func achievementRegistry(t *testing.T) *plugin.Registry {
+	t.Helper()
+	reg, _ := testRegistry(t, "", map[string]string{"achievements.toml": `
+[[achievements]]
+id = "skillful"
+name = "Skillful"
+event = "action"
+subject = "skill:*"
+count = 2
+condition = "happiness >= 50"
+
+[[achievements]]
+id = "legend"
+name = "Legend"
+event = "evolution"
+subject = "legend_*"
+`})
+	reg.SetBuiltinAchievements([]plugin.Achievement{
+		{ID: "first_steps", Name: "First Steps", Condition: "interactions >= 10"},
+		{ID: "gourmet", Name: "Gourmet", Event: plugin.AchievementEventAction, Subject: "feed", Count: 3},
+	})
+	return reg
+}
+
+func TestAchievementProgress_Observe(t *testing.T) {
+	reg := achievementRegistry(t)
+	pet := testPet(reg)
+	progress := NewAchievementProgress()
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	feed := AchievementEvent{Type: plugin.AchievementEventAction, Subject: "feed"}
+	if got := progress.Observe(pet, now, feed, feed); len(got) != 0 {
+		t.Fatalf("unlocked %v after two feeds", got)
+	}
+	if n := progress.Count(plugin.Achievement{ID: "gourmet"}); n != 2 {
+		t.Errorf("gourmet count = %d, want 2", n)
+	}
+
+	// The third feed unlocks gourmet; conditions are checked on every call
+	pet.TotalInteractions = 10
+	got := progress.Observe(pet, now, feed)
+	if len(got) != 2 || got[0].ID != "first_steps" || got[1].ID != "gourmet" {
+		t.Fatalf("unlocked %v, want first_steps and gourmet", got)
+	}
+	if u, ok := progress.Unlock(got[1]); !ok || !u.At.Equal(now) || u.Pet != "Tabby" {
+		t.Errorf("gourmet unlock = %+v, %v", u, ok)
+	}
+	if _, ok := progress.Counts["gourmet"]; ok {
+		t.Error("count of an unlocked achievement was kept")
+	}
+
+	// Achievements unlock once
+	if got := progress.Observe(pet, now, feed); len(got) != 0 {
+		t.Errorf("unlocked %v again", got)
+	}
+	if queued := pet.TakeAchievements(); len(queued) != 2 || len(pet.TakeAchievements()) != 0 {
+		t.Errorf("queued = %v, want the two unlocks once", queued)
+	}
+
+	// Species achievements are keyed by species; subjects match like stage patterns
+	skill := AchievementEvent{Type: plugin.AchievementEventAction, Subject: "skill:purr_heal"}
+	pet.Happiness = 40
+	if got := progress.Observe(pet, now, skill, skill); len(got) != 0 {
+		t.Fatalf("unlocked %v while the condition fails", got)
+	}
+	pet.Happiness = 60
+	got = progress.Observe(pet, now)
+	if len(got) != 1 || got[0].Key() != testSpecies+"/skillful" {
+		t.Fatalf("unlocked %v, want %s/skillful", got, testSpecies)
+	}
+	evolve := AchievementEvent{Type: plugin.AchievementEventEvolution, Subject: "adult_fire"}
+	if got := progress.Observe(pet, now, evolve); len(got) != 0 {
+		t.Errorf("unlocked %v on a non-matching subject", got)
+	}
+}
+
+func TestBuiltinAchievements(t *testing.T) {
+	builtin, err := plugin.ParseAchievements(assets.AchievementsFS, ".")
+	if err != nil {
+		t.Fatalf("ParseAchievements: %v", err)
+	}
+	if len(builtin) == 0 {
+		t.Fatal("no built-in achievements")
+	}
+	for _, e := range plugin.ValidateAchievements(builtin) {
+		t.Errorf("built-in achievement: %v", e)
+	}
+
+	// The cat pack's achievements come after the built-in ones
+	reg := builtinRegistry(t)
+	reg.SetBuiltinAchievements(builtin)
+	all := reg.GetAchievements("cat")
+	if len(all) <= len(builtin) || all[len(builtin)].Species != "cat" {
+		t.Errorf("cat achievements = %v", all[len(builtin):])
+	}
+	if reg.GetAchievement("cat/purrfect_bond") == nil || reg.GetAchievement("gourmet") == nil {
+		t.Error("GetAchievement did not find achievements by key")
+	}
+}
+
+func TestValidateAchievements(t *testing.T) {
+	bad := []plugin.Achievement{
+		{ID: "ok", Name: "OK", Condition: "coins >= 10"},
+		{ID: "ok", Name: "Dup", Condition: "coins >="},
+		{ID: "no_rule", Name: "No rule"},
+		{ID: "odd", Name: "Odd", Event: "sleep", Count: -1},
+	}
+	fields := map[string]bool{}
+	for _, e := range plugin.ValidateAchievements(bad) {
+		fields[e.Field] = true
+	}
+	for _, want := range []string{
+		"achievements[1].id", "achievements[1].condition", "achievements[2]",
+		"achievements[3].event", "achievements[3].count",
+	} {
+		if !fields[want] {
+			t.Errorf("ValidateAchievements did not report %s (got %v)", want, fields)
+		}
+	}
+	if fields["achievements[0].id"] || fields["achievements[0].condition"] {
+		t.Error("ValidateAchievements reported a valid achievement")
+	}
+}
@@ -1,8 +1,14 @@
 package game
 
 import (
+	"clipet/internal/game/capabilities"
 	"clipet/internal/plugin"
+	"clipet/internal/script"
+	"hash/fnv"
+	"maps"
 	"math/rand"
+	"slices"
+	"strconv"
 	"time"
 )
 
@@ -12,8 +18,9 @@ const CooldownAdventure = 10 * time.Minute
 // AdventureCheckResult holds the result of CanAdventure check.
 type AdventureCheckResult struct {
 	OK        bool
-	ErrorType string // standardized error type for i18n
-	Message   string // human-readable feedback (for internal logs)
+	ErrorType string        // standardized error type for i18n
+	Message   string        // human-readable feedback (for internal logs)
+	Wait      time.Duration // time until the next adventure is allowed (ErrAdventureLimit)
 }
 
 // AdventureResult holds the outcome of a completed adventure.
@@ -40,9 +47,52 @@ func CanAdventure(pet *Pet) AdventureCheckResult {
 			Message:   "精力不足，需要至少15点精力才能冒险！",
 		}
 	}
+	if wait := pet.adventureWait(time.Now()); wait > 0 {
+		return AdventureCheckResult{
+			OK:        false,
+			ErrorType: ErrAdventureLimit,
+			Message:   "冒险太频繁了，休息一下再出发吧！",
+			Wait:      wait,
+		}
+	}
 	return AdventureCheckResult{OK: true}
 }
 
+// constraints returns the plugin constraints the pet's species is held to.
+func (p *Pet) constraints() capabilities.PluginConstraints {
+	if p.registry == nil {
+		return capabilities.DefaultConstraints()
+	}
+	return p.registry.Constraints()
+}
+
+// adventureWait returns how long the pet must wait before another adventure
+// keeps it within MaxAdventureFrequency, or 0 if it may go now.
+func (p *Pet) adventureWait(now time.Time) time.Duration {
+	n, window := p.constraints().AdventureWindow()
+	if n == 0 || len(p.AdventureTimes) < n {
+		return 0
+	}
+	oldest := p.AdventureTimes[len(p.AdventureTimes)-n]
+	return max(oldest.Add(window).Sub(now), 0)
+}
+
+// recordAdventure records an adventure start for the frequency limit,
+// keeping only as many timestamps as the limit looks at.
+func (p *Pet) recordAdventure(at time.Time) {
+	n, _ := p.constraints().AdventureWindow()
+	p.AdventureTimes = append(p.AdventureTimes, at)
+	p.AdventureTimes = p.AdventureTimes[max(len(p.AdventureTimes)-n, 0):]
+}
+
+// rewindAdventureTimes moves the recorded adventure times back by elapsed,
+// like the other cooldown timestamps.
+func (p *Pet) rewindAdventureTimes(elapsed time.Duration) {
+	for i := range p.AdventureTimes {
+		p.AdventureTimes[i] = p.AdventureTimes[i].Add(-elapsed)
+	}
+}
+
 // PickAdventure selects a random adventure available for the pet's current stage.
 // Returns nil if no adventures are available.
 func PickAdventure(pet *Pet, reg *plugin.Registry) *plugin.Adventure {
@@ -54,6 +104,24 @@ func PickAdventure(pet *Pet, reg *plugin.Registry) *plugin.Adventure {
 	return &picked
 }
 
+// NextAdventure selects the adventure available for the pet's current stage
+// like PickAdventure, but deterministically: the same adventure is returned
+// until the pet completes one. This lets non-interactive callers show an
+// adventure and resolve a choice in separate invocations.
+// Returns nil if no adventures are available.
+func NextAdventure(pet *Pet, reg *plugin.Registry) *plugin.Adventure {
+	adventures := reg.GetAdventures(pet.Species, pet.StageID)
+	if len(adventures) == 0 {
+		return nil
+	}
+	h := fnv.New32a()
+	h.Write([]byte(pet.Name))
+	h.Write([]byte(strconv.FormatInt(pet.Birthday.UnixNano(), 10)))
+	h.Write([]byte(strconv.Itoa(pet.AdventuresCompleted)))
+	picked := adventures[h.Sum32()%uint32(len(adventures))]
+	return &picked
+}
+
 // ResolveOutcome picks a weighted random outcome from a choice.
 func ResolveOutcome(choice plugin.AdventureChoice) plugin.AdventureOutcome {
 	if len(choice.Outcomes) == 0 {
@@ -80,7 +148,10 @@ func ResolveOutcome(choice plugin.AdventureChoice) plugin.AdventureOutcome {
 }
 
 // ApplyAdventureOutcome applies the outcome effects to the pet and returns
-// the changes map. Energy cost (10) is always deducted.
+// the changes map. Energy cost (10) is always deducted and the adventure
+// counts towards the frequency limit checked by CanAdventure. Item rewards
+// of the outcome are added to the inventory; a "coins" effect changes the
+// pet's coin balance.
 func ApplyAdventureOutcome(pet *Pet, outcome plugin.AdventureOutcome) map[string][2]int {
 	changes := make(map[string][2]int)
 	const energyCost = 10
@@ -94,8 +165,20 @@ func ApplyAdventureOutcome(pet *Pet, outcome plugin.AdventureOutcome) map[string
 	// Deduct base energy cost
 	pet.Energy = Clamp(pet.Energy-energyCost, 0, 100)
 
-	// Apply effects from outcome
-	for attr, delta := range outcome.Effects {
+	// Outcome effects plus those added by the on_adventure script
+	effects := maps.Clone(outcome.Effects)
+	if effects == nil {
+		effects = make(map[string]int)
+	}
+	for attr, delta := range pet.scriptEffects(script.HookOnAdventure, map[string]any{
+		"text":    outcome.Text,
+		"effects": outcome.Effects,
+	}) {
+		effects[attr] += delta
+	}
+
+	// Apply effects
+	for attr, delta := range effects {
 		switch attr {
 		case "hunger":
 			pet.Hunger = Clamp(pet.Hunger+delta, 0, 100)
@@ -105,6 +188,12 @@ func ApplyAdventureOutcome(pet *Pet, outcome plugin.AdventureOutcome) map[string
 			pet.Health = Clamp(pet.Health+delta, 0, 100)
 		case "energy":
 			pet.Energy = Clamp(pet.Energy+delta, 0, 100)
+		case "coins":
+			oldCoins := pet.Coins
+			pet.Coins = max(pet.Coins+delta, 0)
+			if pet.Coins != oldCoins {
+				changes["coins"] = [2]int{oldCoins, pet.Coins}
+			}
 		default:
 			// Apply to custom attributes (supports custom accumulators)
 			// Record old value before modification
@@ -131,9 +220,14 @@ func ApplyAdventureOutcome(pet *Pet, outcome plugin.AdventureOutcome) map[string
 		changes["energy"] = [2]int{oldE, pet.Energy}
 	}
 
+	for _, itemID := range slices.Sorted(maps.Keys(outcome.Items)) {
+		pet.AddItem(itemID, outcome.Items[itemID])
+	}
+
 	// Update stats
 	pet.AdventuresCompleted++
 	pet.TotalInteractions++
+	pet.recordAdventure(time.Now())
 
 	return changes
 }
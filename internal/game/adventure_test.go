@@ -0,0 +1,45 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"clipet/internal/plugin"
+)
+
+func TestNextAdventureStableUntilCompleted(t *testing.T) {
+	reg := plugin.NewRegistry()
+	pack := &plugin.SpeciesPack{
+		Species: plugin.SpeciesConfig{ID: "test"},
+		Stages:  []plugin.Stage{{ID: "baby_a", Phase: "baby"}},
+	}
+	for _, id := range []string{"cave", "river", "forest", "tower", "meadow"} {
+		pack.Adventures = append(pack.Adventures, plugin.Adventure{ID: id, Stage: []string{"*"}})
+	}
+	reg.Register(pack)
+
+	pet := &Pet{Name: "Mochi", Species: "test", StageID: "baby_a", Birthday: time.Unix(1700000000, 0)}
+	first := NextAdventure(pet, reg)
+	if first == nil {
+		t.Fatal("NextAdventure returned nil")
+	}
+	for i := 0; i < 10; i++ {
+		if got := NextAdventure(pet, reg); got.ID != first.ID {
+			t.Fatalf("NextAdventure changed without a completed adventure: %s != %s", got.ID, first.ID)
+		}
+	}
+
+	seen := map[string]bool{first.ID: true}
+	for i := 0; i < 50; i++ {
+		pet.AdventuresCompleted++
+		seen[NextAdventure(pet, reg).ID] = true
+	}
+	if len(seen) < 2 {
+		t.Errorf("NextAdventure never moved on after completions: %v", seen)
+	}
+
+	empty := &Pet{Species: "missing"}
+	if NextAdventure(empty, reg) != nil {
+		t.Error("NextAdventure for unknown species should be nil")
+	}
+}
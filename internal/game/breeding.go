@@ -0,0 +1,140 @@
+package game
+
+import (
+	"clipet/internal/game/capabilities"
+	"clipet/internal/plugin"
+	"math"
+	"math/rand"
+	"slices"
+	"time"
+)
+
+// Breed lets two grown pets of the same species have an egg named name.
+// Both parents spend the species' breeding energy cost and start its
+// cooldown. Nothing changes unless both can breed: the parent that cannot
+// gets its own failure and the other one ErrPartnerBusy. The egg is nil on
+// failure.
+func Breed(a, b *Pet, name string) (*Pet, ActionResult, ActionResult) {
+	if a.Species != b.Species {
+		res := failResultWithType(ErrBreedMismatch, "不同物种的宠物不能一起生蛋")
+		return nil, res, res
+	}
+	rules := a.breedingRules()
+	if rules == nil {
+		res := failResultWithType(ErrCannotBreed, "这个物种不能生蛋")
+		return nil, res, res
+	}
+	busy := failResultWithType(ErrPartnerBusy, "另一只宠物现在不能生蛋")
+	if res, blocked := a.breedBlocked(rules); blocked {
+		return nil, res, busy
+	}
+	if res, blocked := b.breedBlocked(rules); blocked {
+		return nil, busy, res
+	}
+
+	egg := newEgg(a, b, name, rules)
+	now := time.Now()
+	results := [2]ActionResult{}
+	for i, p := range []*Pet{a, b} {
+		oldEnergy := p.Energy
+		p.Energy = clamp(p.Energy-rules.EnergyCost, 0, 100)
+		p.LastBredAt = now
+		results[i] = ActionResult{
+			OK:      true,
+			Message: "生下了一颗蛋！",
+			Changes: map[string][2]int{"energy": {oldEnergy, p.Energy}},
+		}
+	}
+	return egg, results[0], results[1]
+}
+
+// breedingRules returns the breeding rules of the pet's species, or nil.
+func (p *Pet) breedingRules() *plugin.Breeding {
+	if p.registry == nil {
+		return nil
+	}
+	return p.registry.GetBreeding(p.Species)
+}
+
+// breedBlocked checks whether the pet can breed now under rules.
+func (p *Pet) breedBlocked(rules *plugin.Breeding) (ActionResult, bool) {
+	if !p.Alive {
+		return failResultWithType(ErrDead, "宠物已经不在了..."), true
+	}
+	if !slices.Contains(rules.Phases, string(p.Stage)) {
+		return failResultWithType(ErrNotGrown, "宠物还没有长大"), true
+	}
+	if left := cooldownLeft(p.LastBredAt, rules.Cooldown); left != "" {
+		return failResultWithType(ErrCooldown, "宠物还需要休养，"+left+"后才能再生蛋"), true
+	}
+	if p.Energy < rules.EnergyCost {
+		return failResultWithType(ErrEnergyLow, "宠物太累了，先休息一下吧！"), true
+	}
+	return ActionResult{}, false
+}
+
+// newEgg creates the egg of two parents. Its base stats move StatBias of
+// the way from the species' base stats toward the parents' average before
+// breeding, and it inherits traits as described by inheritTraits.
+func newEgg(a, b *Pet, name string, rules *plugin.Breeding) *Pet {
+	reg := a.registry
+	base := plugin.BaseStats{Hunger: 50, Happiness: 50, Health: 50, Energy: 50}
+	if bs := reg.GetBaseStats(a.Species); bs != nil {
+		base = *bs
+	}
+	lean := func(base, x, y int) int {
+		avg := float64(x+y) / 2
+		return clamp(int(math.Round(float64(base)+rules.StatBias*(avg-float64(base)))), 0, 100)
+	}
+
+	eggStageID := ""
+	if egg := reg.GetEggStage(a.Species); egg != nil {
+		eggStageID = egg.ID
+	}
+	egg := NewPet(name, a.Species, eggStageID,
+		lean(base.Hunger, a.Hunger, b.Hunger),
+		lean(base.Happiness, a.Happiness, b.Happiness),
+		lean(base.Health, a.Health, b.Health),
+		lean(base.Energy, a.Energy, b.Energy),
+		reg)
+	egg.SetCapabilitiesRegistry(a.capabilitiesReg)
+	egg.Traits = inheritTraits(a.TraitIDs(), b.TraitIDs(), speciesTraits(reg, a.Species), rules)
+	egg.Parents = []string{a.Name, b.Name}
+	return egg
+}
+
+// inheritTraits draws up to MaxTraits of the parents' traits without
+// replacement. A trait both parents have weighs SharedWeight, one only
+// one parent has SingleWeight; traits no longer in the species pool are
+// skipped. With MutationChance the egg also gains a pool trait neither
+// parent has, drawn by roll weight. Groups stay mutually exclusive. The
+// result is never nil, so the egg keeps its own trait set.
+func inheritTraits(traitsA, traitsB []string, pool []capabilities.PersonalityTrait, rules *plugin.Breeding) []string {
+	weights := make([]int, len(pool))
+	for i, t := range pool {
+		inA, inB := slices.Contains(traitsA, t.ID), slices.Contains(traitsB, t.ID)
+		switch {
+		case inA && inB:
+			weights[i] = rules.SharedWeight
+		case inA || inB:
+			weights[i] = rules.SingleWeight
+		}
+	}
+	traits := drawTraits(pool, weights, rules.MaxTraits)
+
+	if rand.Float64() < rules.MutationChance {
+		fresh := make([]int, len(pool))
+		for i, t := range pool {
+			if !slices.Contains(traitsA, t.ID) && !slices.Contains(traitsB, t.ID) {
+				fresh[i] = t.RollWeight()
+			}
+		}
+		for i, t := range pool {
+			if slices.Contains(traits, t.ID) {
+				excludeGroup(pool, fresh, i)
+			}
+		}
+		traits = inPackOrder(pool, append(traits, drawTraits(pool, fresh, 1)...))
+	}
+	return traits
+}
@@ -0,0 +1,196 @@
+package game
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"clipet/internal/game/capabilities"
+	"clipet/internal/plugin"
+)
+
+// breedingTraitsTOML gives the breeding test species base stats and four
+// traits.
+const breedingTraitsTOML = `
+[species.base_stats]
+hunger = 50
+happiness = 50
+health = 50
+energy = 50
+
+[[traits]]
+id = "phoenix"
+type = "passive"
+[traits.passive_effect]
+resurrect_chance = 1.0
+health_restore_percent = 40.0
+
+[[traits]]
+id = "swift"
+type = "passive"
+
+[[traits]]
+id = "calm"
+type = "passive"
+
+[[traits]]
+id = "wild"
+type = "passive"
+`
+
+// breedingRegistry loads a species with breeding rules and four traits, and
+// one without breeding rules.
+func breedingRegistry(t *testing.T, breeding string) (*plugin.Registry, *capabilities.Registry) {
+	t.Helper()
+	reg := plugin.NewRegistry()
+	capReg := capabilities.NewRegistry()
+	addTestSpecies(t, reg, capReg, "lynx", breedingTraitsTOML+breeding, nil)
+	addTestSpecies(t, reg, capReg, "newt", breedingTraitsTOML, nil)
+	return reg, capReg
+}
+
+func breedingPet(name, species string, reg *plugin.Registry, capReg *capabilities.Registry, traits ...string) *Pet {
+	pet := NewPet(name, species, "egg", 90, 70, 90, 80, reg)
+	pet.Stage, pet.StageID = StageAdult, "adult"
+	pet.SetCapabilitiesRegistry(capReg)
+	pet.Traits = traits
+	return pet
+}
+
+func TestBreed(t *testing.T) {
+	reg, capReg := breedingRegistry(t, `
+[breeding]
+energy_cost = 20
+max_traits = 2
+stat_bias = 1.0
+`)
+	a := breedingPet("Ash", "lynx", reg, capReg, "calm", "phoenix")
+	b := breedingPet("Birch", "lynx", reg, capReg, "phoenix", "calm")
+	b.Hunger = 70
+
+	egg, resA, resB := Breed(a, b, "Cinder")
+	if !resA.OK || !resB.OK || egg == nil {
+		t.Fatalf("Breed failed: %s / %s", resA.Message, resB.Message)
+	}
+	if a.Energy != 60 || resB.Changes["energy"] != [2]int{80, 60} {
+		t.Errorf("energy after breeding = %d, %v; want 60 for both", a.Energy, resB.Changes["energy"])
+	}
+
+	// Both shared traits fit in max_traits, kept in pack order
+	if want := []string{"phoenix", "calm"}; !slices.Equal(egg.Traits, want) {
+		t.Errorf("egg traits = %v, want %v", egg.Traits, want)
+	}
+	if egg.Stage != StageEgg || egg.StageID != "egg" || egg.Species != "lynx" {
+		t.Errorf("egg = %s/%s/%s, want a lynx egg", egg.Species, egg.Stage, egg.StageID)
+	}
+	if !slices.Equal(egg.Parents, []string{"Ash", "Birch"}) {
+		t.Errorf("egg parents = %v", egg.Parents)
+	}
+	// stat_bias 1: base stats are the parents' average
+	if egg.Hunger != 80 || egg.Happiness != 70 || egg.Energy != 80 {
+		t.Errorf("egg stats = %d/%d/%d, want 80/70/80", egg.Hunger, egg.Happiness, egg.Energy)
+	}
+
+	// Both parents are on cooldown now
+	c := breedingPet("Cedar", "lynx", reg, capReg)
+	if _, resC, resA := Breed(c, a, "Dune"); resC.ErrorType != ErrPartnerBusy || resA.ErrorType != ErrCooldown {
+		t.Errorf("errors = %q/%q, want %q/%q", resC.ErrorType, resA.ErrorType, ErrPartnerBusy, ErrCooldown)
+	}
+	if c.Energy != 80 || !c.LastBredAt.IsZero() {
+		t.Error("partner of a failed breeding was changed")
+	}
+}
+
+func TestBreedRules(t *testing.T) {
+	reg, capReg := breedingRegistry(t, "\n[breeding]\n")
+	adult := breedingPet("Ash", "lynx", reg, capReg)
+
+	young := breedingPet("Kit", "lynx", reg, capReg)
+	young.Stage, young.StageID = StageEgg, "egg"
+	if _, resA, resY := Breed(adult, young, "X"); resA.ErrorType != ErrPartnerBusy || resY.ErrorType != ErrNotGrown {
+		t.Errorf("egg partner: errors = %q/%q, want %q/%q", resA.ErrorType, resY.ErrorType, ErrPartnerBusy, ErrNotGrown)
+	}
+
+	newt := breedingPet("Newt", "newt", reg, capReg)
+	if _, res, _ := Breed(adult, newt, "X"); res.ErrorType != ErrBreedMismatch {
+		t.Errorf("mixed species: error = %q, want %q", res.ErrorType, ErrBreedMismatch)
+	}
+	if _, res, _ := Breed(newt, breedingPet("Eft", "newt", reg, capReg), "X"); res.ErrorType != ErrCannotBreed {
+		t.Errorf("species without [breeding]: error = %q, want %q", res.ErrorType, ErrCannotBreed)
+	}
+
+	// Defaults: 30 energy, 72h cooldown
+	adult.Energy = 29
+	if _, res, _ := Breed(adult, breedingPet("Birch", "lynx", reg, capReg), "X"); res.ErrorType != ErrEnergyLow {
+		t.Errorf("tired parent: error = %q, want %q", res.ErrorType, ErrEnergyLow)
+	}
+	adult.Energy = 100
+	adult.LastBredAt = time.Now().Add(-71 * time.Hour)
+	if _, res, _ := Breed(adult, breedingPet("Birch", "lynx", reg, capReg), "X"); res.ErrorType != ErrCooldown {
+		t.Errorf("recent parent: error = %q, want %q", res.ErrorType, ErrCooldown)
+	}
+}
+
+func TestInheritTraitsMutation(t *testing.T) {
+	rules := plugin.Breeding{MutationChance: 1}.Defaults()
+	pool := []capabilities.PersonalityTrait{{ID: "phoenix"}, {ID: "swift"}, {ID: "calm"}, {ID: "wild"}}
+
+	traits := inheritTraits([]string{"phoenix", "swift", "calm"}, []string{"swift"}, pool, &rules)
+	if len(traits) != rules.MaxTraits+1 || !slices.Contains(traits, "wild") {
+		t.Errorf("traits = %v, want %d inherited plus the mutation wild", traits, rules.MaxTraits)
+	}
+
+	// Traits no longer in the pool are not inherited; the set is never nil
+	traits = inheritTraits([]string{"gone"}, nil, pool, &plugin.Breeding{MaxTraits: 2, SingleWeight: 1})
+	if traits == nil || len(traits) != 0 {
+		t.Errorf("traits = %#v, want an empty set", traits)
+	}
+}
+
+func TestPetTraits(t *testing.T) {
+	reg, capReg := breedingRegistry(t, "")
+
+	legacy := breedingPet("Old", "lynx", reg, capReg)
+	legacy.Traits = nil
+	if got := legacy.TraitIDs(); !slices.Equal(got, []string{"phoenix", "swift", "calm", "wild"}) {
+		t.Errorf("TraitIDs without own traits = %v, want every species trait", got)
+	}
+	if got := RollTraits(reg, "lynx"); len(got) != 4 {
+		t.Errorf("RollTraits without a trait pool = %v, want every species trait", got)
+	}
+
+	// Resurrection only comes from the pet's own traits
+	hook := NewDeathCheckHook(capReg)
+	for _, tt := range []struct {
+		traits []string
+		alive  bool
+	}{
+		{[]string{"phoenix"}, true},
+		{[]string{}, false},
+	} {
+		pet := breedingPet("Ash", "lynx", reg, capReg, tt.traits...)
+		pet.Traits = tt.traits
+		pet.Health = 0
+		hook.OnTimeAdvance(time.Hour, pet)
+		if pet.Alive != tt.alive {
+			t.Errorf("traits %v: alive = %v, want %v", tt.traits, pet.Alive, tt.alive)
+		}
+	}
+}
+
+func TestValidateBreeding(t *testing.T) {
+	reg, _ := breedingRegistry(t, "")
+	bad := *reg.GetSpecies("lynx")
+	bad.Breeding = &plugin.Breeding{Phases: []string{"egg"}, EnergyCost: 120, MutationChance: 2, StatBias: -1}
+
+	fields := map[string]bool{}
+	errs, _ := plugin.Validate(&bad)
+	for _, e := range errs {
+		fields[e.Field] = true
+	}
+	for _, want := range []string{"breeding.phases", "breeding.energy_cost", "breeding.mutation_chance", "breeding.stat_bias"} {
+		if !fields[want] {
+			t.Errorf("Validate did not report %s (got %v)", want, fields)
+		}
+	}
+}
@@ -2,6 +2,9 @@ package capabilities
 
 import (
 	"fmt"
+	"maps"
+	"slices"
+	"time"
 )
 
 // PluginConstraints defines safety boundaries for species packs
@@ -32,15 +35,15 @@ type PluginConstraints struct {
 // DefaultConstraints returns sensible default values
 func DefaultConstraints() PluginConstraints {
 	return PluginConstraints{
-		MinLifespanHours:        24.0,     // 1 day minimum
-		MaxLifespanHours:        87600.0,  // 10 years maximum
-		MaxAttributeMultiplier:  3.0,      // 3x maximum bonus
-		MinAttributeMultiplier:  0.1,      // 10% minimum
-		MaxCrisisEventsPerHour:  2,
-		MinCrisisEventInterval:  0.5,      // 30 minutes
-		MaxAdventureFrequency:   6.0,      // Max 6 adventures per hour
-		MinCooldownMultiplier:   0.5,
-		MaxCooldownMultiplier:   2.0,
+		MinLifespanHours:       24.0,    // 1 day minimum
+		MaxLifespanHours:       87600.0, // 10 years maximum
+		MaxAttributeMultiplier: 3.0,     // 3x maximum bonus
+		MinAttributeMultiplier: 0.1,     // 10% minimum
+		MaxCrisisEventsPerHour: 2,
+		MinCrisisEventInterval: 0.5, // 30 minutes
+		MaxAdventureFrequency:  6.0, // Max 6 adventures per hour
+		MinCooldownMultiplier:  0.5,
+		MaxCooldownMultiplier:  2.0,
 	}
 }
 
@@ -79,6 +82,13 @@ func (c PluginConstraints) ValidatePassiveEffect(effect PassiveEffect) []string
 		{"sleep_energy_bonus", effect.SleepEnergyBonus},
 	}
 
+	for _, tag := range slices.Sorted(maps.Keys(effect.ItemTagBonus)) {
+		multipliers = append(multipliers, struct {
+			name string
+			val  float64
+		}{"item_tag_bonus." + tag, effect.ItemTagBonus[tag]})
+	}
+
 	for _, m := range multipliers {
 		if m.val != 0 {
 			mult := 1.0 + m.val
@@ -122,3 +132,14 @@ func (c PluginConstraints) ValidateOverride() []string {
 
 	return errs
 }
+
+// AdventureWindow returns how many adventures may start within the returned
+// window so that MaxAdventureFrequency (per hour) is not exceeded. Rates
+// below one per hour stretch the window, e.g. 0.5 allows 1 adventure per 2h.
+func (c PluginConstraints) AdventureWindow() (int, time.Duration) {
+	if c.MaxAdventureFrequency <= 0 {
+		return 0, 0 // unlimited
+	}
+	n := max(int(c.MaxAdventureFrequency), 1)
+	return n, time.Duration(float64(n) / c.MaxAdventureFrequency * float64(time.Hour))
+}
@@ -66,18 +66,27 @@ func (r *Registry) GetAllTraits(speciesID string) []PersonalityTrait {
 	return traits
 }
 
-// ApplyPassiveEffects applies all passive trait effects to a game action
+// traitsOf returns the traits of a species with the given IDs, in that
+// order. Unknown IDs are skipped. The caller must hold r.mu.
+func (r *Registry) traitsOf(speciesID string, traitIDs []string) []PersonalityTrait {
+	speciesTraits := r.traits[speciesID]
+	traits := make([]PersonalityTrait, 0, len(traitIDs))
+	for _, id := range traitIDs {
+		if trait, ok := speciesTraits[id]; ok {
+			traits = append(traits, trait)
+		}
+	}
+	return traits
+}
+
+// ApplyPassiveEffects applies the passive effects of the given traits of a
+// species to a game action.
 // Returns modified hunger, happiness, health, energy values
-func (r *Registry) ApplyPassiveEffects(speciesID string, action string, hunger, happiness, health, energy int) (int, int, int, int) {
+func (r *Registry) ApplyPassiveEffects(speciesID string, traitIDs []string, action string, hunger, happiness, health, energy int) (int, int, int, int) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	speciesTraits, exists := r.traits[speciesID]
-	if !exists {
-		return hunger, happiness, health, energy
-	}
-
-	for _, trait := range speciesTraits {
+	for _, trait := range r.traitsOf(speciesID, traitIDs) {
 		if trait.Type != "passive" || trait.PassiveEffect == nil {
 			continue
 		}
@@ -113,21 +122,40 @@ func (r *Registry) ApplyPassiveEffects(speciesID string, action string, hunger,
 	return hunger, happiness, health, energy
 }
 
-// GetEvolutionModifier returns the combined evolution modifier for a species
-func (r *Registry) GetEvolutionModifier(speciesID string) *EvolutionModifier {
+// ItemTagMultiplier returns the factor the given passive traits apply to the
+// gains of an item with the given tags. Bonuses of all matching tags multiply
+// and the result is clamped to the attribute multiplier bounds of c, the
+// constraints the species is held to.
+func (r *Registry) ItemTagMultiplier(speciesID string, traitIDs []string, tags []string, c PluginConstraints) float64 {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	speciesTraits, exists := r.traits[speciesID]
-	if !exists {
-		return nil
+	mult := 1.0
+	for _, trait := range r.traitsOf(speciesID, traitIDs) {
+		if trait.Type != "passive" || trait.PassiveEffect == nil {
+			continue
+		}
+		for _, tag := range tags {
+			if bonus, ok := trait.PassiveEffect.ItemTagBonus[tag]; ok {
+				mult *= 1.0 + bonus
+			}
+		}
 	}
 
+	return min(max(mult, c.MinAttributeMultiplier), c.MaxAttributeMultiplier)
+}
+
+// GetEvolutionModifier returns the combined evolution modifier of the given
+// traits of a species
+func (r *Registry) GetEvolutionModifier(speciesID string, traitIDs []string) *EvolutionModifier {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	// Combine all modifier traits
 	var combined EvolutionModifier
 	hasModifier := false
 
-	for _, trait := range speciesTraits {
+	for _, trait := range r.traitsOf(speciesID, traitIDs) {
 		if trait.Type != "modifier" || trait.EvolutionModifier == nil {
 			continue
 		}
@@ -159,18 +187,14 @@ func (r *Registry) GetEvolutionModifier(speciesID string) *EvolutionModifier {
 	return &combined
 }
 
-// GetActiveTraits returns all active abilities for a species
-func (r *Registry) GetActiveTraits(speciesID string) []PersonalityTrait {
+// GetActiveTraits returns the active abilities among the given traits of a
+// species
+func (r *Registry) GetActiveTraits(speciesID string, traitIDs []string) []PersonalityTrait {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	speciesTraits, exists := r.traits[speciesID]
-	if !exists {
-		return nil
-	}
-
 	var activeTraits []PersonalityTrait
-	for _, trait := range speciesTraits {
+	for _, trait := range r.traitsOf(speciesID, traitIDs) {
 		if trait.Type == "active" && trait.ActiveEffect != nil {
 			activeTraits = append(activeTraits, trait)
 		}
@@ -4,9 +4,9 @@ import "time"
 
 // LifecycleConfig defines the lifecycle parameters for a species
 type LifecycleConfig struct {
-	MaxAgeHours      float64 `toml:"max_age_hours"`      // Maximum lifespan in hours
-	EndingType       string  `toml:"ending_type"`        // death | ascend | eternal
-	WarningThreshold float64 `toml:"warning_threshold"`  // Warning threshold (0.0-1.0)
+	MaxAgeHours      float64 `toml:"max_age_hours"`     // Maximum lifespan in hours
+	EndingType       string  `toml:"ending_type"`       // death | ascend | eternal
+	WarningThreshold float64 `toml:"warning_threshold"` // Warning threshold (0.0-1.0)
 }
 
 // Defaults returns a LifecycleConfig with sensible defaults
@@ -26,15 +26,18 @@ func (lc LifecycleConfig) Defaults() LifecycleConfig {
 // PassiveEffect defines a passive personality trait effect
 type PassiveEffect struct {
 	// Attribute modifiers (multipliers, e.g., 0.8 = 80%, 1.2 = 120%)
-	FeedHungerBonus     float64 `toml:"feed_hunger_bonus"`      // Feed hunger gain multiplier
-	FeedHappinessBonus  float64 `toml:"feed_happiness_bonus"`   // Feed happiness gain multiplier
-	PlayHappinessBonus  float64 `toml:"play_happiness_bonus"`   // Play happiness gain multiplier
-	SleepEnergyBonus    float64 `toml:"sleep_energy_bonus"`     // Sleep energy gain multiplier
+	FeedHungerBonus    float64 `toml:"feed_hunger_bonus"`    // Feed hunger gain multiplier
+	FeedHappinessBonus float64 `toml:"feed_happiness_bonus"` // Feed happiness gain multiplier
+	PlayHappinessBonus float64 `toml:"play_happiness_bonus"` // Play happiness gain multiplier
+	SleepEnergyBonus   float64 `toml:"sleep_energy_bonus"`   // Sleep energy gain multiplier
+
+	// Item preferences: bonus on the gains of items with a tag (e.g. fish = 0.5, vegetable = -0.3)
+	ItemTagBonus map[string]float64 `toml:"item_tag_bonus"`
 
 	// Special effects
-	ResurrectChance         float64 `toml:"resurrect_chance"`          // Chance to resurrect on death (0.0-1.0)
-	HealthRestorePercent    float64 `toml:"health_restore_percent"`    // Health restored on resurrection (%)
-	HealthRegenMultiplier   string  `toml:"health_regen_multiplier"`   // Expression for health regen (e.g., "magic * 0.01")
+	ResurrectChance       float64 `toml:"resurrect_chance"`        // Chance to resurrect on death (0.0-1.0)
+	HealthRestorePercent  float64 `toml:"health_restore_percent"`  // Health restored on resurrection (%)
+	HealthRegenMultiplier string  `toml:"health_regen_multiplier"` // Expression for health regen (e.g., "magic * 0.01")
 }
 
 // ActiveEffect defines an active ability that the player can trigger
@@ -70,21 +73,36 @@ type PersonalityTrait struct {
 
 	// Evolution modifiers (e.g., "night owl": +50% night interaction evolution points)
 	EvolutionModifier *EvolutionModifier `toml:"evolution_modifier"`
+
+	// Trait pool: how a new pet rolls the trait (see the species' [trait_pool])
+	Weight      int    `toml:"weight"`       // roll weight (default: 1)
+	Group       string `toml:"group"`        // a pet has at most one trait of a group
+	UnlockPhase string `toml:"unlock_phase"` // phase from which the trait takes effect (default: always)
+}
+
+// RollWeight returns the weight of the trait when a new pet rolls its traits.
+func (t PersonalityTrait) RollWeight() int {
+	if t.Weight <= 0 {
+		return 1
+	}
+	return t.Weight
 }
 
 // EndingCondition defines when a specific ending should trigger
 type EndingCondition struct {
-	MinHappiness  int     `toml:"min_happiness"`   // Minimum happiness score
-	MinAgeHours   float64 `toml:"min_age_hours"`   // Minimum age in hours
-	MinAdventures int     `toml:"min_adventures"`  // Minimum completed adventures
+	MinHappiness  int     `toml:"min_happiness"`  // Minimum happiness score
+	MinAgeHours   float64 `toml:"min_age_hours"`  // Minimum age in hours
+	MinAdventures int     `toml:"min_adventures"` // Minimum completed adventures
+	Expr          string  `toml:"expr"`           // Optional boolean expression (see package expr)
 }
 
 // Ending represents a possible ending for the pet's life
 type Ending struct {
-	Type       string          `toml:"type"`       // blissful_passing | adventurous_life | peaceful_rest
-	Name       string          `toml:"name"`       // Display name
-	Condition  EndingCondition `toml:"condition"`  // Trigger condition
-	Message    string          `toml:"message"`    // Ending message
+	Type      string          `toml:"type"`      // blissful_passing | adventurous_life | peaceful_rest
+	Name      string          `toml:"name"`      // Display name
+	Condition EndingCondition `toml:"condition"` // Trigger condition
+	Message   string          `toml:"message"`   // Ending message
+	Legacy    map[string]int  `toml:"legacy"`    // Attribute bonus a successor inherits (e.g. happiness = 15)
 }
 
 // LifecycleState represents the current lifecycle state of a pet
@@ -103,10 +121,10 @@ type EndingResult struct {
 
 // DecayConfig defines attribute decay rates per hour
 type DecayConfig struct {
-	Hunger    float64 `toml:"hunger"`     // Hunger decay per hour (default: 1.0)
-	Happiness float64 `toml:"happiness"`  // Happiness decay per hour (default: 0.5)
-	Energy    float64 `toml:"energy"`     // Energy decay per hour (default: 0.3)
-	Health    float64 `toml:"health"`     // Health decay per hour when hungry (default: 0.2)
+	Hunger    float64 `toml:"hunger"`    // Hunger decay per hour (default: 1.0)
+	Happiness float64 `toml:"happiness"` // Happiness decay per hour (default: 0.5)
+	Energy    float64 `toml:"energy"`    // Energy decay per hour (default: 0.3)
+	Health    float64 `toml:"health"`    // Health decay per hour when hungry (default: 0.2)
 }
 
 // Defaults returns decay config with sensible defaults (slow unified decay)
@@ -134,10 +152,10 @@ type DynamicCooldownConfig struct {
 	// When attribute is high (70-100): long cooldown
 
 	// Low urgency multiplier (attribute < 30)
-	LowUrgencyMultiplier float64 `toml:"low_urgency_multiplier"` // e.g., 0.1 (10% of base cooldown)
+	LowUrgencyMultiplier float64 `toml:"low_urgency_multiplier"` // e.g., 0.5 (50% of base cooldown)
 
 	// Medium urgency multiplier (30 <= attribute < 70)
-	MediumUrgencyMultiplier float64 `toml:"medium_urgency_multiplier"` // e.g., 0.5 (50% of base cooldown)
+	MediumUrgencyMultiplier float64 `toml:"medium_urgency_multiplier"` // e.g., 0.75 (75% of base cooldown)
 
 	// High urgency multiplier (attribute >= 70)
 	HighUrgencyMultiplier float64 `toml:"high_urgency_multiplier"` // e.g., 1.0 (100% of base cooldown)
@@ -150,24 +168,24 @@ type DynamicCooldownConfig struct {
 // AttributeInteractionConfig defines attribute interaction rules
 type AttributeInteractionConfig struct {
 	// Hunger → Health
-	HungerHealthThreshold         int     `toml:"hunger_health_threshold"`          // Trigger threshold (default: 20)
-	HungerHealthRate              float64 `toml:"hunger_health_rate"`               // Decay rate (default: 0.2)
-	HungerZeroHealthMultiplier    float64 `toml:"hunger_zero_health_multiplier"`    // Multiplier when hunger=0 (default: 3.0)
+	HungerHealthThreshold      int     `toml:"hunger_health_threshold"`       // Trigger threshold (default: 20)
+	HungerHealthRate           float64 `toml:"hunger_health_rate"`            // Decay rate (default: 0.2)
+	HungerZeroHealthMultiplier float64 `toml:"hunger_zero_health_multiplier"` // Multiplier when hunger=0 (default: 3.0)
 
 	// Energy → Health/Happiness
-	EnergyLowThreshold              int     `toml:"energy_low_threshold"`               // Low energy threshold (default: 20)
-	EnergyCritThreshold             int     `toml:"energy_crit_threshold"`               // Critical energy threshold (default: 10)
-	EnergyLowHealthRate             float64 `toml:"energy_low_health_rate"`               // Low energy health decay (default: 0.1)
-	EnergyCritHealthMultiplier      float64 `toml:"energy_crit_health_multiplier"`        // Critical multiplier (default: 2.0)
-	EnergyCritHappinessMultiplier   float64 `toml:"energy_crit_happiness_multiplier"`     // Critical multiplier (default: 1.5)
+	EnergyLowThreshold            int     `toml:"energy_low_threshold"`             // Low energy threshold (default: 20)
+	EnergyCritThreshold           int     `toml:"energy_crit_threshold"`            // Critical energy threshold (default: 10)
+	EnergyLowHealthRate           float64 `toml:"energy_low_health_rate"`           // Low energy health decay (default: 0.1)
+	EnergyCritHealthMultiplier    float64 `toml:"energy_crit_health_multiplier"`    // Critical multiplier (default: 2.0)
+	EnergyCritHappinessMultiplier float64 `toml:"energy_crit_happiness_multiplier"` // Critical multiplier (default: 1.5)
 
 	// Happiness → Health
-	HappinessLowThreshold           int     `toml:"happiness_low_threshold"`           // Depression threshold (default: 20)
-	HappinessZeroHealthMultiplier   float64 `toml:"happiness_zero_health_multiplier"`  // Multiplier when happiness=0 (default: 4.0)
+	HappinessLowThreshold         int     `toml:"happiness_low_threshold"`          // Depression threshold (default: 20)
+	HappinessZeroHealthMultiplier float64 `toml:"happiness_zero_health_multiplier"` // Multiplier when happiness=0 (default: 4.0)
 
 	// Health → Global recovery
-	HealthCritThreshold    int     `toml:"health_crit_threshold"`    // Critical health threshold (default: 20)
-	HealthRecoveryPenalty  float64 `toml:"health_recovery_penalty"`  // Recovery penalty coefficient (default: 0.5)
+	HealthCritThreshold   int     `toml:"health_crit_threshold"`   // Critical health threshold (default: 20)
+	HealthRecoveryPenalty float64 `toml:"health_recovery_penalty"` // Recovery penalty coefficient (default: 0.5)
 }
 
 // Defaults returns attribute interaction config with sensible defaults
@@ -214,10 +232,10 @@ func (aic AttributeInteractionConfig) Defaults() AttributeInteractionConfig {
 // Defaults returns dynamic cooldown config with sensible defaults
 func (dcc DynamicCooldownConfig) Defaults() DynamicCooldownConfig {
 	if dcc.LowUrgencyMultiplier == 0 {
-		dcc.LowUrgencyMultiplier = 0.1 // 10% cooldown when urgent
+		dcc.LowUrgencyMultiplier = 0.5 // 50% cooldown when urgent (MinCooldownMultiplier)
 	}
 	if dcc.MediumUrgencyMultiplier == 0 {
-		dcc.MediumUrgencyMultiplier = 0.5 // 50% cooldown when medium
+		dcc.MediumUrgencyMultiplier = 0.75 // 75% cooldown when medium
 	}
 	if dcc.HighUrgencyMultiplier == 0 {
 		dcc.HighUrgencyMultiplier = 1.0 // 100% cooldown when full
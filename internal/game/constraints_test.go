@@ -0,0 +1,96 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"clipet/internal/game/capabilities"
+	"clipet/internal/plugin"
+)
+
+func TestAdventureFrequencyLimit(t *testing.T) {
+	reg := builtinRegistry(t)
+	pet := NewPet("Mochi", "cat", "egg", 100, 100, 100, 100, reg)
+	limit := int(reg.Constraints().MaxAdventureFrequency)
+
+	for i := range limit {
+		if check := CanAdventure(pet); !check.OK {
+			t.Fatalf("adventure %d refused: %s", i+1, check.ErrorType)
+		}
+		pet.Energy = 100
+		ApplyAdventureOutcome(pet, plugin.AdventureOutcome{Text: "ok"})
+	}
+
+	check := CanAdventure(pet)
+	if check.OK || check.ErrorType != ErrAdventureLimit {
+		t.Fatalf("adventure %d: check = %+v, want %s", limit+1, check, ErrAdventureLimit)
+	}
+	if check.Wait <= 0 || check.Wait > time.Hour {
+		t.Errorf("wait = %v, want within the hour", check.Wait)
+	}
+	if len(pet.AdventureTimes) != limit {
+		t.Errorf("%d adventure times kept, want %d", len(pet.AdventureTimes), limit)
+	}
+
+	// Advancing time rewinds the recorded adventures like other cooldowns
+	NewCooldownHook().OnTimeAdvance(time.Hour, pet)
+	if check := CanAdventure(pet); !check.OK {
+		t.Errorf("adventure still refused an hour later: %+v", check)
+	}
+}
+
+func TestAdventureWindow(t *testing.T) {
+	tests := []struct {
+		freq   float64
+		n      int
+		window time.Duration
+	}{
+		{6, 6, time.Hour},
+		{0.5, 1, 2 * time.Hour},
+		{2.5, 2, 48 * time.Minute},
+		{0, 0, 0},
+	}
+	for _, tt := range tests {
+		c := capabilities.DefaultConstraints()
+		c.MaxAdventureFrequency = tt.freq
+		n, window := c.AdventureWindow()
+		if n != tt.n || window != tt.window {
+			t.Errorf("freq %v: window = %d per %v, want %d per %v", tt.freq, n, window, tt.n, tt.window)
+		}
+	}
+}
+
+func TestCooldownMultipliersClamped(t *testing.T) {
+	reg := builtinRegistry(t)
+	pack := *reg.GetSpecies("cat")
+	pack.Species.ID = "fastcat"
+	pack.DynamicCooldown.LowUrgencyMultiplier = 0.1
+	pack.DynamicCooldown.HighUrgencyMultiplier = 5
+
+	errs, warnings := plugin.Validate(&pack)
+	if len(errs) != 0 {
+		t.Fatalf("out-of-range multipliers should only warn, got errors %v", errs)
+	}
+	fields := map[string]bool{}
+	for _, w := range warnings {
+		fields[w.Field] = true
+	}
+	for _, want := range []string{"dynamic_cooldown.low_urgency_multiplier", "dynamic_cooldown.high_urgency_multiplier"} {
+		if !fields[want] {
+			t.Errorf("no warning for %s (got %v)", want, warnings)
+		}
+	}
+
+	reg.Register(&pack)
+	c := reg.Constraints()
+	dcc := reg.GetDynamicCooldownConfig("fastcat")
+	if got := dcc.GetMultiplier(0); got != c.MinCooldownMultiplier {
+		t.Errorf("low urgency multiplier = %v, want clamped to %v", got, c.MinCooldownMultiplier)
+	}
+	if got := dcc.GetMultiplier(100); got != c.MaxCooldownMultiplier {
+		t.Errorf("high urgency multiplier = %v, want clamped to %v", got, c.MaxCooldownMultiplier)
+	}
+	if _, warnings := plugin.Validate(reg.GetSpecies("cat")); len(warnings) != 0 {
+		t.Errorf("builtin cat pack has constraint warnings: %v", warnings)
+	}
+}
@@ -0,0 +1,244 @@
+package game
+
+import (
+	"clipet/internal/game/capabilities"
+	"clipet/internal/plugin"
+	"math"
+	"math/rand"
+	"slices"
+	"time"
+)
+
+// Crisis event phases.
+const (
+	CrisisStarted  = "started"
+	CrisisResolved = "resolved"
+	CrisisFailed   = "failed"
+)
+
+// ActiveCrisis is a crisis the pet is currently in. Its clock only moves
+// when time advances for the pet: offline settlement, daemon steps and
+// TickCrises while a front end is open.
+type ActiveCrisis struct {
+	ID       string        `json:"id"`
+	Elapsed  time.Duration `json:"elapsed"`  // time since the crisis started
+	Deadline time.Duration `json:"deadline"` // time allowed to resolve it
+}
+
+// Left returns the time left to resolve the crisis.
+func (c ActiveCrisis) Left() time.Duration {
+	return max(c.Deadline-c.Elapsed, 0)
+}
+
+// CrisisEvent reports a crisis starting, being resolved or failing.
+// Name and Text are taken from the (localized) pack definition.
+type CrisisEvent struct {
+	ID       string            `json:"id"`
+	Name     string            `json:"name"`
+	Phase    string            `json:"phase"`
+	At       time.Time         `json:"at"`
+	Deadline time.Time         `json:"deadline,omitzero"` // started only
+	Resolve  []string          `json:"resolve,omitempty"` // started only
+	Text     string            `json:"text,omitempty"`    // outcome text when resolved or failed
+	Changes  map[string][2]int `json:"changes,omitempty"`
+}
+
+// CrisisHook starts and expires crises as time advances. Crises are
+// throttled by the registry's MaxCrisisEventsPerHour and
+// MinCrisisEventInterval constraints, both for live ticks and for offline
+// settlement.
+type CrisisHook struct {
+	registry *plugin.Registry
+}
+
+// NewCrisisHook creates a crisis hook.
+func NewCrisisHook(registry *plugin.Registry) *CrisisHook {
+	return &CrisisHook{registry: registry}
+}
+
+func (h *CrisisHook) Name() string {
+	return "Crisis"
+}
+
+func (h *CrisisHook) OnTimeAdvance(elapsed time.Duration, pet *Pet) {
+	if !pet.Alive || h.registry == nil || elapsed <= 0 {
+		return
+	}
+	pet.advanceCrises(elapsed, time.Now(), h.registry, h.registry.Constraints())
+}
+
+// TickCrises moves only the crisis clock forward by elapsed, for front ends
+// that keep the pet's other attributes still while they are open (the TUI).
+// Crises may start and fail just as with AdvanceTime; read the events with
+// TakeCrisisEvents.
+func (p *Pet) TickCrises(elapsed time.Duration) {
+	if !p.Alive || p.registry == nil || elapsed <= 0 {
+		return
+	}
+	p.advanceCrises(elapsed, time.Now(), p.registry, p.registry.Constraints())
+}
+
+// TakeCrisisEvents returns the crises started or failed by time advances
+// since the last call and clears them.
+func (p *Pet) TakeCrisisEvents() []CrisisEvent {
+	events := p.crisisEvents
+	p.crisisEvents = nil
+	return events
+}
+
+// HasCrisis reports whether the pet is in the crisis with the given ID.
+func (p *Pet) HasCrisis(id string) bool {
+	return slices.ContainsFunc(p.ActiveCrises, func(c ActiveCrisis) bool { return c.ID == id })
+}
+
+// advanceCrises moves the crisis clock forward by elapsed, ending at now.
+// The elapsed time is walked in slots of MinCrisisEventInterval; at the end
+// of each slot overdue crises fail and at most one new crisis may start.
+func (p *Pet) advanceCrises(elapsed time.Duration, now time.Time, reg *plugin.Registry,
+	constraints capabilities.PluginConstraints) {
+	interval := time.Duration(constraints.MinCrisisEventInterval * float64(time.Hour))
+	if interval <= 0 {
+		interval = elapsed
+	}
+	for done := time.Duration(0); done < elapsed; {
+		step := min(interval, elapsed-done)
+		done += step
+		at := now.Add(done - elapsed)
+
+		p.ageCrises(step)
+		p.expireCrises(at, reg)
+		if p.Alive {
+			p.rollCrisis(at, step.Hours(), reg, constraints)
+		}
+	}
+}
+
+// ageCrises adds d to the clock of active and recently started crises.
+// Starts an hour or more ago no longer count against the throttle.
+func (p *Pet) ageCrises(d time.Duration) {
+	for i := range p.ActiveCrises {
+		p.ActiveCrises[i].Elapsed += d
+	}
+	for i := range p.RecentCrises {
+		p.RecentCrises[i] += d
+	}
+	p.RecentCrises = slices.DeleteFunc(p.RecentCrises, func(age time.Duration) bool {
+		return age >= time.Hour
+	})
+}
+
+// expireCrises fails every active crisis whose deadline has passed.
+func (p *Pet) expireCrises(at time.Time, reg *plugin.Registry) {
+	p.ActiveCrises = slices.DeleteFunc(p.ActiveCrises, func(active ActiveCrisis) bool {
+		if active.Left() > 0 {
+			return false
+		}
+		def := reg.GetCrisis(p.Species, active.ID)
+		if def == nil {
+			return true // removed from the pack
+		}
+		changes := make(map[string][2]int)
+		p.applyTrackedEffects(def.OnFail.Effects, changes)
+		p.crisisEvents = append(p.crisisEvents, CrisisEvent{
+			ID:      def.ID,
+			Name:    def.Name,
+			Phase:   CrisisFailed,
+			At:      at,
+			Text:    def.OnFail.Text,
+			Changes: changes,
+		})
+		return true
+	})
+}
+
+// rollCrisis tries to start one crisis at the end of a slot of the given
+// length in hours, unless the throttle constraints forbid it.
+func (p *Pet) rollCrisis(at time.Time, hours float64, reg *plugin.Registry,
+	constraints capabilities.PluginConstraints) {
+	if !p.crisisAllowed(constraints) {
+		return
+	}
+	for _, def := range reg.GetCrises(p.Species, p.StageID) {
+		if p.HasCrisis(def.ID) || !p.EvalCondition(def.Trigger) {
+			continue
+		}
+		chance := 1 - math.Pow(1-min(max(def.Chance, 0), 1), hours)
+		if rand.Float64() >= chance {
+			continue
+		}
+		p.ActiveCrises = append(p.ActiveCrises, ActiveCrisis{ID: def.ID, Deadline: def.Deadline})
+		p.RecentCrises = append(p.RecentCrises, 0)
+		p.crisisEvents = append(p.crisisEvents, CrisisEvent{
+			ID:       def.ID,
+			Name:     def.Name,
+			Phase:    CrisisStarted,
+			At:       at,
+			Deadline: at.Add(def.Deadline),
+			Resolve:  def.Resolve,
+		})
+		return
+	}
+}
+
+// crisisAllowed reports whether a new crisis may start now: the last one
+// started at least MinCrisisEventInterval ago and fewer than
+// MaxCrisisEventsPerHour started within the last hour.
+func (p *Pet) crisisAllowed(constraints capabilities.PluginConstraints) bool {
+	interval := time.Duration(constraints.MinCrisisEventInterval * float64(time.Hour))
+	for _, age := range p.RecentCrises {
+		if age < interval {
+			return false
+		}
+	}
+	return len(p.RecentCrises) < constraints.MaxCrisisEventsPerHour
+}
+
+// resolveCrises ends every active crisis that lists action as a remedy,
+// applying its on_resolve effects. Attribute changes are merged into the
+// action's changes.
+func (p *Pet) resolveCrises(action string, changes map[string][2]int) []CrisisEvent {
+	if p.registry == nil || len(p.ActiveCrises) == 0 {
+		return nil
+	}
+	var events []CrisisEvent
+	now := time.Now()
+	p.ActiveCrises = slices.DeleteFunc(p.ActiveCrises, func(active ActiveCrisis) bool {
+		def := p.registry.GetCrisis(p.Species, active.ID)
+		if def == nil || !slices.Contains(def.Resolve, action) {
+			return false
+		}
+		own := make(map[string][2]int)
+		p.applyTrackedEffects(def.OnResolve.Effects, own)
+		for attr, ch := range own {
+			if prev, ok := changes[attr]; ok {
+				ch[0] = prev[0]
+			}
+			changes[attr] = ch
+		}
+		events = append(events, CrisisEvent{
+			ID:      def.ID,
+			Name:    def.Name,
+			Phase:   CrisisResolved,
+			At:      now,
+			Text:    def.OnResolve.Text,
+			Changes: own,
+		})
+		return true
+	})
+	return events
+}
+
+// applyTrackedEffects applies attribute deltas and records each changed
+// attribute in changes as {old, new}, keeping an existing old value.
+func (p *Pet) applyTrackedEffects(effects map[string]int, changes map[string][2]int) {
+	for attr, delta := range effects {
+		old := p.GetAttr(attr)
+		p.applyEffects(map[string]int{attr: delta})
+		if now := p.GetAttr(attr); now != old {
+			if prev, ok := changes[attr]; ok {
+				old = prev[0]
+			}
+			changes[attr] = [2]int{old, now}
+		}
+	}
+}
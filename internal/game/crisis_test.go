@@ -0,0 +1,203 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"clipet/internal/game/capabilities"
+	"clipet/internal/plugin"
+)
+
+// crisisRegistry loads a pack with deterministic crises (chance 1) through
+// the regular parser.
+func crisisRegistry(t *testing.T) *plugin.Registry {
+	t.Helper()
+	reg, _ := testRegistry(t, "", map[string]string{"crises.toml": `
+[[crises]]
+id = "fever"
+trigger = "health < 50"
+chance = 1.0
+deadline = "2h"
+resolve = ["heal"]
+on_resolve = { text = "cooled down", effects = { happiness = 5 } }
+on_fail = { text = "burned out", effects = { health = -10 } }
+
+[[crises]]
+id = "gloom"
+trigger = "happiness < 50"
+chance = 1.0
+deadline = "2h"
+resolve = ["play", "talk"]
+on_fail = { effects = { happiness = -10 } }
+
+[[crises]]
+id = "sulk"
+trigger = "happiness < 50"
+chance = 1.0
+deadline = "2h"
+resolve = ["talk"]
+`})
+	return reg
+}
+
+func crisisPet(reg *plugin.Registry) *Pet {
+	pet := testPet(reg)
+	pet.Hunger, pet.Happiness, pet.Health, pet.Energy = 80, 80, 80, 80
+	return pet
+}
+
+func TestCrisisStartAndFail(t *testing.T) {
+	reg := crisisRegistry(t)
+	pet := crisisPet(reg)
+	pet.Health = 40
+	now := time.Now()
+
+	pet.advanceCrises(30*time.Minute, now, reg, capabilities.DefaultConstraints())
+	if !pet.HasCrisis("fever") {
+		t.Fatalf("fever not started with health 40: %+v", pet.ActiveCrises)
+	}
+	events := pet.TakeCrisisEvents()
+	if len(events) != 1 || events[0].Phase != CrisisStarted || !events[0].At.Equal(now) {
+		t.Fatalf("events = %+v, want one fever start at now", events)
+	}
+
+	// Health recovers so fever cannot restart; its deadline passes after 2h
+	pet.Health = 90
+	pet.advanceCrises(90*time.Minute, now, reg, capabilities.DefaultConstraints())
+	if !pet.HasCrisis("fever") {
+		t.Fatal("fever failed before its deadline")
+	}
+	pet.advanceCrises(30*time.Minute, now, reg, capabilities.DefaultConstraints())
+	if pet.HasCrisis("fever") {
+		t.Fatal("fever still active after its deadline")
+	}
+	if pet.Health != 80 {
+		t.Errorf("health after failed fever = %d, want 80", pet.Health)
+	}
+	events = pet.TakeCrisisEvents()
+	if len(events) != 1 || events[0].Phase != CrisisFailed || events[0].Text != "burned out" {
+		t.Errorf("events = %+v, want one fever failure", events)
+	}
+}
+
+func TestTickCrises(t *testing.T) {
+	reg := crisisRegistry(t)
+	pet := crisisPet(reg)
+	pet.Health = 40
+
+	pet.TickCrises(time.Minute)
+	if !pet.HasCrisis("fever") {
+		t.Fatalf("fever not started by a live tick: %+v", pet.ActiveCrises)
+	}
+	pet.TakeCrisisEvents()
+
+	// Only the crisis clock moves: two hours of ticks fail the fever
+	// without any decay.
+	pet.Health = 90
+	for range 120 {
+		pet.TickCrises(time.Minute)
+	}
+	if pet.HasCrisis("fever") {
+		t.Fatal("fever still active after its deadline")
+	}
+	if pet.Health != 80 || pet.Hunger != 80 || pet.Energy != 80 {
+		t.Errorf("attrs after ticks = health %d hunger %d energy %d, want 80 each", pet.Health, pet.Hunger, pet.Energy)
+	}
+	events := pet.TakeCrisisEvents()
+	if len(events) != 1 || events[0].Phase != CrisisFailed {
+		t.Errorf("events = %+v, want one fever failure", events)
+	}
+}
+
+func TestCrisisResolvedByAction(t *testing.T) {
+	reg := crisisRegistry(t)
+	pet := crisisPet(reg)
+	pet.Health = 40
+	pet.advanceCrises(time.Hour, time.Now(), reg, capabilities.DefaultConstraints())
+	pet.LastHealedAt = time.Now().Add(-24 * time.Hour)
+
+	res := pet.Heal()
+	if !res.OK {
+		t.Fatalf("Heal failed: %s", res.Message)
+	}
+	if len(res.Crises) != 1 || res.Crises[0].ID != "fever" || res.Crises[0].Phase != CrisisResolved {
+		t.Fatalf("res.Crises = %+v, want fever resolved", res.Crises)
+	}
+	if pet.HasCrisis("fever") {
+		t.Error("fever still active after heal")
+	}
+	if ch := res.Changes["happiness"]; ch != [2]int{80, 85} {
+		t.Errorf("happiness change = %v, want [80 85] from on_resolve", ch)
+	}
+}
+
+func TestCrisisThrottle(t *testing.T) {
+	reg := crisisRegistry(t)
+	now := time.Now()
+
+	// Defaults: starts are at least 30 minutes apart
+	pet := crisisPet(reg)
+	pet.Happiness = 10
+	pet.advanceCrises(20*time.Minute, now, reg, capabilities.DefaultConstraints())
+	pet.advanceCrises(5*time.Minute, now, reg, capabilities.DefaultConstraints())
+	if len(pet.ActiveCrises) != 1 {
+		t.Fatalf("%d crises within 25 minutes, want 1", len(pet.ActiveCrises))
+	}
+	pet.advanceCrises(25*time.Minute, now, reg, capabilities.DefaultConstraints())
+	if len(pet.ActiveCrises) != 2 {
+		t.Fatalf("%d crises 30 minutes after the first, want 2", len(pet.ActiveCrises))
+	}
+
+	// One per hour: a long offline stretch is spread out, not bunched up
+	limits := capabilities.DefaultConstraints()
+	limits.MaxCrisisEventsPerHour = 1
+	limits.MinCrisisEventInterval = 0.25
+	pet = crisisPet(reg)
+	pet.Happiness = 10
+	pet.advanceCrises(3*time.Hour, now, reg, limits)
+	var starts []time.Time
+	for _, e := range pet.TakeCrisisEvents() {
+		if e.Phase == CrisisStarted {
+			starts = append(starts, e.At)
+		}
+	}
+	if len(starts) != 3 {
+		t.Fatalf("%d crises in 3h at 1/h, want 3", len(starts))
+	}
+	for i := 1; i < len(starts); i++ {
+		if gap := starts[i].Sub(starts[i-1]); gap < time.Hour {
+			t.Errorf("crises %d and %d only %v apart", i-1, i, gap)
+		}
+	}
+}
+
+func TestCrisisHookSkipsEggs(t *testing.T) {
+	reg := crisisRegistry(t)
+	pet := NewPet("Tabby", testSpecies, "egg", 10, 10, 10, 10, reg)
+
+	NewCrisisHook(reg).OnTimeAdvance(time.Hour, pet)
+	if len(pet.ActiveCrises) != 0 {
+		t.Errorf("egg got crises %+v, want none without an explicit stage list", pet.ActiveCrises)
+	}
+}
+
+func TestValidateCrises(t *testing.T) {
+	pack := crisisRegistry(t).GetSpecies(testSpecies)
+	bad := *pack
+	bad.Crises = append([]plugin.Crisis{{
+		ID:      "fever",
+		Trigger: "health <",
+		Resolve: []string{"dance", "skill:unknown"},
+	}}, pack.Crises...)
+
+	fields := map[string]bool{}
+	errs, _ := plugin.Validate(&bad)
+	for _, e := range errs {
+		fields[e.Field] = true
+	}
+	for _, want := range []string{"crises[0].chance", "crises[0].deadline", "crises[0].trigger", "crises[0].resolve", "crises[1].id"} {
+		if !fields[want] {
+			t.Errorf("Validate did not report %s (got %v)", want, fields)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package game
 
 import (
 	"clipet/internal/plugin"
+	"clipet/internal/script"
 )
 
 // EvolveCandidate represents a single eligible evolution path.
@@ -57,19 +58,8 @@ func evaluateCondition(pet *Pet, cond plugin.EvolutionCondition) (bool, int) {
 
 	// attr_bias - check the corresponding accumulator
 	if cond.AttrBias != "" {
-		switch cond.AttrBias {
-		case "happiness":
-			if pet.AccHappiness <= 0 {
-				return false, 0
-			}
-		case "health":
-			if pet.AccHealth <= 0 {
-				return false, 0
-			}
-		case "playful":
-			if pet.AccPlayful <= 0 {
-				return false, 0
-			}
+		if acc := AttrBiasAccumulator(cond.AttrBias); acc != "" && pet.GetCustomAcc(acc) <= 0 {
+			return false, 0
 		}
 		score++
 	}
@@ -141,6 +131,15 @@ func evaluateCondition(pet *Pet, cond plugin.EvolutionCondition) (bool, int) {
 		score++
 	}
 
+	// expr - free-form condition expression
+	if cond.Expr != "" {
+		pet.UpdateFeedRegularity()
+		if !pet.EvalCondition(cond.Expr) {
+			return false, 0
+		}
+		score++
+	}
+
 	return true, score
 }
 
@@ -160,12 +159,47 @@ func BestCandidate(candidates []EvolveCandidate) *EvolveCandidate {
 	return best
 }
 
-// DoEvolve executes an evolution, updating the pet's stage fields.
+// DoEvolve executes an evolution, updating the pet's stage fields, then
+// applies the effects of the species' on_evolve script, if any.
 func DoEvolve(pet *Pet, candidate EvolveCandidate) {
+	from := pet.StageID
 	pet.StageID = candidate.ToStage.ID
 	pet.Stage = PetStage(candidate.ToStage.Phase)
 	// Reset accumulators for the new stage
-	pet.AccHappiness = 0
-	pet.AccHealth = 0
-	pet.AccPlayful = 0
+	for _, acc := range []string{AccHappiness, AccHealth, AccPlayful} {
+		delete(pet.CustomAttributes, acc)
+	}
+
+	pet.applyEffects(pet.scriptEffects(script.HookOnEvolve, map[string]any{
+		"from":  from,
+		"to":    candidate.ToStage.ID,
+		"phase": candidate.ToStage.Phase,
+	}))
+}
+
+// AutoEvolve evolves the pet to the best qualifying candidate without asking,
+// as non-interactive front ends do. It returns the previous stage ID and the
+// chosen candidate, or nil if the pet does not qualify.
+func AutoEvolve(pet *Pet, reg *plugin.Registry) (string, *EvolveCandidate) {
+	best := BestCandidate(CheckEvolution(pet, reg))
+	if best == nil {
+		return "", nil
+	}
+	from := pet.StageID
+	DoEvolve(pet, *best)
+	return from, best
+}
+
+// AttrBiasAccumulator maps an attr_bias value (happiness, health, playful)
+// to the custom accumulator that backs it. Returns "" for unknown biases.
+func AttrBiasAccumulator(bias string) string {
+	switch bias {
+	case "happiness":
+		return AccHappiness
+	case "health":
+		return AccHealth
+	case "playful":
+		return AccPlayful
+	}
+	return ""
 }
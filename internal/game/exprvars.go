@@ -0,0 +1,75 @@
+package game
+
+import (
+	"clipet/internal/expr"
+	"time"
+)
+
+// ExprVars returns the variables visible to pack expressions: core
+// attributes, counters, age, local time of day and, for any other name,
+// the custom attribute or accumulator of that name (0 if unset). The
+// built-in names are listed in plugin.ExprVariables, which the validator
+// uses to reject unknown ones.
+func (p *Pet) ExprVars() expr.Vars {
+	now := time.Now()
+	return func(name string) float64 {
+		switch name {
+		case "hunger":
+			return float64(p.Hunger)
+		case "happiness":
+			return float64(p.Happiness)
+		case "health":
+			return float64(p.Health)
+		case "energy":
+			return float64(p.Energy)
+		case "coins":
+			return float64(p.Coins)
+		case "care_streak":
+			return float64(p.CareStreak)
+		case "mood_score":
+			return float64(p.MoodScore())
+		case "age_hours":
+			return p.AgeHours()
+		case "age_days":
+			return p.AgeHours() / 24
+		case "interactions":
+			return float64(p.TotalInteractions)
+		case "games_won":
+			return float64(p.GamesWon)
+		case "adventures":
+			return float64(p.AdventuresCompleted)
+		case "dialogues":
+			return float64(p.DialogueCount)
+		case "feed_count":
+			return float64(p.FeedCount)
+		case "feed_regularity":
+			return p.FeedRegularity
+		case "day_interactions":
+			return float64(p.DayInteractions)
+		case "night_interactions":
+			return float64(p.NightInteractions)
+		case "generation":
+			return float64(p.GenerationNumber())
+		case "hour":
+			return float64(now.Hour())
+		case "minute":
+			return float64(now.Minute())
+		}
+		return float64(p.GetCustomAcc(name))
+	}
+}
+
+// EvalCondition evaluates a boolean pack expression for the pet. An empty
+// expression holds; an invalid one (or an evaluation error such as a
+// division by zero) does not.
+func (p *Pet) EvalCondition(src string) bool {
+	if src == "" {
+		return true
+	}
+	e, err := expr.ParseBool(src)
+	if err != nil {
+		return false
+	}
+	ok, err := e.Bool(p.ExprVars())
+	return err == nil && ok
+}
@@ -0,0 +1,131 @@
+package game
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"clipet/internal/game/capabilities"
+	"clipet/internal/plugin"
+)
+
+func TestCheckEvolution_ExprCondition(t *testing.T) {
+	reg := plugin.NewRegistry()
+	reg.Register(&plugin.SpeciesPack{
+		Species: plugin.SpeciesConfig{ID: "test"},
+		Stages: []plugin.Stage{
+			{ID: "child", Phase: "child"},
+			{ID: "adult_fire", Phase: "adult"},
+			{ID: "adult_ice", Phase: "adult"},
+		},
+		Evolutions: []plugin.Evolution{
+			{From: "child", To: "adult_fire", Condition: plugin.EvolutionCondition{
+				Expr: "happiness > health and fire_points >= 2 * ice_points"}},
+			{From: "child", To: "adult_ice", Condition: plugin.EvolutionCondition{
+				Expr: "any(ice_points > fire_points, health >= 95)"}},
+		},
+	})
+
+	pet := &Pet{Species: "test", StageID: "child", Alive: true, Birthday: time.Now(),
+		Happiness: 80, Health: 60, CustomAttributes: map[string]int{"fire_points": 10, "ice_points": 5}}
+	got := CheckEvolution(pet, reg)
+	if len(got) != 1 || got[0].ToStage.ID != "adult_fire" || got[0].Score != 1 {
+		t.Fatalf("candidates = %+v, want only adult_fire with score 1", got)
+	}
+
+	pet.CustomAttributes["ice_points"] = 11
+	got = CheckEvolution(pet, reg)
+	if len(got) != 1 || got[0].ToStage.ID != "adult_ice" {
+		t.Errorf("candidates = %+v, want only adult_ice", got)
+	}
+}
+
+func TestTriggerEnding_ExprCondition(t *testing.T) {
+	reg := plugin.NewRegistry()
+	reg.Register(&plugin.SpeciesPack{
+		Species: plugin.SpeciesConfig{ID: "test"},
+		Endings: []capabilities.Ending{
+			{Type: "explorer", Condition: capabilities.EndingCondition{Expr: "adventures >= 3 and games_won < adventures"}},
+			{Type: "homebody", Condition: capabilities.EndingCondition{Expr: "adventures == 0"}},
+		},
+	})
+	mgr := NewLifecycleManager(reg)
+
+	pet := &Pet{Species: "test", AdventuresCompleted: 4, GamesWon: 1}
+	if got := mgr.TriggerEnding(pet).Type; got != "explorer" {
+		t.Errorf("ending = %q, want explorer", got)
+	}
+	pet.AdventuresCompleted = 0
+	if got := mgr.TriggerEnding(pet).Type; got != "homebody" {
+		t.Errorf("ending = %q, want homebody", got)
+	}
+}
+
+func TestValidate_ReportsExprPosition(t *testing.T) {
+	pack := &plugin.SpeciesPack{
+		Species: plugin.SpeciesConfig{ID: "test", Name: "Test", Version: "1.0.0"},
+		Stages:  []plugin.Stage{{ID: "egg", Name: "Egg", Phase: "egg"}, {ID: "baby", Name: "Baby", Phase: "baby"}},
+		Evolutions: []plugin.Evolution{
+			{From: "egg", To: "baby", Condition: plugin.EvolutionCondition{Expr: "happiness > health and"}},
+		},
+	}
+	var found bool
+	errs, _ := plugin.Validate(pack)
+	for _, e := range errs {
+		if e.Field == "evolutions[0].condition.expr" {
+			found = true
+			if !strings.Contains(e.Message, "col 23") {
+				t.Errorf("message = %q, want the error column", e.Message)
+			}
+		}
+	}
+	if !found {
+		t.Error("Validate did not report the broken expression")
+	}
+}
+
+func TestValidate_ReportsUnknownExprVariables(t *testing.T) {
+	pack := &plugin.SpeciesPack{
+		Species: plugin.SpeciesConfig{ID: "test", Name: "Test", Version: "1.0.0"},
+		Stages:  []plugin.Stage{{ID: "egg", Name: "Egg", Phase: "egg"}, {ID: "baby", Name: "Baby", Phase: "baby"}},
+		Evolutions: []plugin.Evolution{
+			{From: "egg", To: "baby", Condition: plugin.EvolutionCondition{
+				CustomAcc: map[string]int{"ice_points": 1},
+				Expr:      "fier_points > ice_points and fire_points > 0 and sparkle < 1"}},
+		},
+		Crises: []plugin.Crisis{{ID: "storm", Chance: 0.5, Deadline: time.Hour, Resolve: []string{"rest"},
+			Trigger: "fire_points > 0", OnFail: plugin.CrisisOutcome{Effects: map[string]int{"Fire_Points": -1}}}},
+	}
+	var msgs []string
+	errs, _ := plugin.Validate(pack)
+	for _, e := range errs {
+		if strings.Contains(e.Message, "unknown variable") {
+			msgs = append(msgs, e.Field+": "+e.Message)
+		}
+	}
+	// fire_points is written by the crisis and ice_points declared in
+	// custom_acc; the misspelling and the undeclared name are reported
+	if len(msgs) != 1 || !strings.HasPrefix(msgs[0], "evolutions[0].condition.expr") ||
+		!strings.Contains(msgs[0], `col 1: unknown variable "fier_points"`) ||
+		!strings.Contains(msgs[0], `col 50: unknown variable "sparkle"`) {
+		t.Errorf("unknown variable errors = %q", msgs)
+	}
+}
+
+func TestExprVars_ResolvesBuiltins(t *testing.T) {
+	// A built-in variable must not fall through to the accumulator of the
+	// same name, or the validator's list and ExprVars have drifted apart
+	pet := &Pet{Birthday: time.Now(), CustomAttributes: map[string]int{}}
+	for _, name := range plugin.ExprVariables {
+		pet.CustomAttributes[name] = 12345
+	}
+	vars := pet.ExprVars()
+	for _, name := range plugin.ExprVariables {
+		if strings.HasPrefix(name, "acc_") {
+			continue // the game's own accumulators
+		}
+		if vars(name) == 12345 {
+			t.Errorf("ExprVars(%q) read the accumulator, not the built-in", name)
+		}
+	}
+}
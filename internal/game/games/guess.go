@@ -143,10 +143,15 @@ func (g *guessNumberGame) GetResult() *GameResult {
 	} else {
 		msg = fmt.Sprintf("答案是 %d", g.targetNum)
 	}
+	coins := 0
+	if g.won {
+		coins = 3 * (g.maxAttempts - g.attempts + 1) // 3-21 枚，次数越少越多
+	}
 	return &GameResult{
 		GameType: GameGuessNumber,
 		Won:      g.won,
 		Score:    g.attempts,
+		Coins:    coins,
 		Message:  msg,
 	}
 }
@@ -144,10 +144,15 @@ func (g *reactionSpeedGame) GetResult() *GameResult {
 	} else {
 		msg = "超时了！"
 	}
+	coins := 0
+	if g.won {
+		coins = 5 + (1000-g.score)/100 // 5-14 枚，越快越多
+	}
 	return &GameResult{
 		GameType: GameReactionSpeed,
 		Won:      g.won,
 		Score:    g.score,
+		Coins:    coins,
 		Message:  msg,
 	}
 }
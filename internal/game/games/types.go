@@ -26,6 +26,7 @@ type GameResult struct {
 	GameType GameType
 	Won      bool
 	Score    int    // 游戏特定分数（反应时间 ms / 猜测次数）
+	Coins    int    // 赢得的金币（输了为 0），成绩越好越多
 	Message  string // 格式化的结果描述
 }
 
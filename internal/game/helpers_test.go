@@ -0,0 +1,86 @@
+package game
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"clipet/internal/assets"
+	"clipet/internal/game/capabilities"
+	"clipet/internal/plugin"
+)
+
+// testSpecies is the species of the pack built by testRegistry.
+const testSpecies = "tabby"
+
+// testSpeciesTOML is the base species.toml of test packs: no base stats and
+// an egg, a baby and an adult stage. %s is replaced by the species ID.
+const testSpeciesTOML = `
+[species]
+id = "%s"
+name = "%s"
+version = "1.0.0"
+
+[[stages]]
+id = "egg"
+phase = "egg"
+
+[[stages]]
+id = "baby"
+phase = "baby"
+
+[[stages]]
+id = "adult"
+phase = "adult"
+`
+
+// testRegistry loads a testSpecies pack through the regular parser, as an
+// external pack would be. extraTOML is appended to species.toml (traits,
+// [scripts], further stages, ...) and files adds other pack files such as
+// items.toml. The pack's traits are registered in the returned capabilities
+// registry.
+func testRegistry(t *testing.T, extraTOML string, files map[string]string) (*plugin.Registry, *capabilities.Registry) {
+	t.Helper()
+	reg := plugin.NewRegistry()
+	capReg := capabilities.NewRegistry()
+	addTestSpecies(t, reg, capReg, testSpecies, extraTOML, files)
+	return reg, capReg
+}
+
+// addTestSpecies parses a test pack for species id and registers it in reg
+// and its traits in capReg.
+func addTestSpecies(t *testing.T, reg *plugin.Registry, capReg *capabilities.Registry,
+	id, extraTOML string, files map[string]string) {
+	t.Helper()
+	fsys := fstest.MapFS{
+		"species.toml": {Data: []byte(strings.ReplaceAll(testSpeciesTOML, "%s", id) + extraTOML)},
+	}
+	for name, data := range files {
+		fsys[name] = &fstest.MapFile{Data: []byte(data)}
+	}
+	pack, err := plugin.ParsePack(fsys, ".")
+	if err != nil {
+		t.Fatalf("ParsePack(%s): %v", id, err)
+	}
+	reg.Register(pack)
+	if err := capReg.RegisterTraits(id, pack.Traits); err != nil {
+		t.Fatalf("RegisterTraits(%s): %v", id, err)
+	}
+}
+
+// testPet returns a baby of testSpecies with all attributes at 50.
+func testPet(reg *plugin.Registry) *Pet {
+	pet := NewPet("Tabby", testSpecies, "egg", 50, 50, 50, 50, reg)
+	pet.Stage, pet.StageID = StageBaby, "baby"
+	return pet
+}
+
+// builtinRegistry loads the builtin species packs.
+func builtinRegistry(t *testing.T) *plugin.Registry {
+	t.Helper()
+	reg := plugin.NewRegistry()
+	if err := reg.LoadFromFS(assets.BuiltinFS, "builtins", plugin.SourceBuiltin); err != nil {
+		t.Fatalf("load builtins: %v", err)
+	}
+	return reg
+}
@@ -39,4 +39,10 @@ func (h *AttrDecayHook) OnTimeAdvance(elapsed time.Duration, pet *Pet) {
 	if pet.Hunger < 20 {
 		pet.Health = clamp(pet.Health-int(decayConfig.Health*hours), 0, 100)
 	}
+
+	// Passive health regeneration (health_regen_multiplier traits)
+	if h.registry != nil {
+		pet.applyHealthRegen(hours, pet.unlockedTraits(),
+			h.registry.GetAttributeInteractionConfig(pet.Species))
+	}
 }
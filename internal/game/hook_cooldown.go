@@ -21,6 +21,7 @@ func (h *CooldownHook) OnTimeAdvance(elapsed time.Duration, pet *Pet) {
 	pet.LastHealedAt = pet.LastHealedAt.Add(-elapsed)
 	pet.LastTalkedAt = pet.LastTalkedAt.Add(-elapsed)
 	pet.LastAdventureAt = pet.LastAdventureAt.Add(-elapsed)
+	pet.rewindAdventureTimes(elapsed)
 
 	// Update last checked time
 	pet.LastCheckedAt = time.Now()
@@ -35,19 +35,16 @@ func (h *DeathCheckHook) OnTimeAdvance(elapsed time.Duration, pet *Pet) {
 
 	// No resurrection or failed, mark pet as dead
 	pet.Alive = false
+	pet.DiedAt = time.Now()
 }
 
-// attemptResurrection tries to resurrect the pet based on passive traits
+// attemptResurrection tries to resurrect the pet based on its own passive traits
 // Returns true if resurrection succeeded
 func (h *DeathCheckHook) attemptResurrection(pet *Pet) bool {
-	traits := h.capabilitiesReg.GetAllTraits(pet.Species)
-	if len(traits) == 0 {
-		return false
-	}
-
 	// Check for resurrection traits
-	for _, trait := range traits {
-		if trait.Type != "passive" || trait.PassiveEffect == nil {
+	for _, id := range pet.UnlockedTraitIDs() {
+		trait, ok := h.capabilitiesReg.GetTrait(pet.Species, id)
+		if !ok || trait.Type != "passive" || trait.PassiveEffect == nil {
 			continue
 		}
 
@@ -63,6 +63,7 @@ func (h *LifecycleHook) OnTimeAdvance(elapsed time.Duration, pet *Pet) {
 // applyEnding applies the ending result to the pet
 func (h *LifecycleHook) applyEnding(pet *Pet, result capabilities.EndingResult) {
 	pet.Alive = false
+	pet.DiedAt = time.Now()
 	pet.EndingType = result.Type
 	pet.EndingMessage = result.Message // Plugin-provided message (may be empty)
 
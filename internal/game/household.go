@@ -0,0 +1,89 @@
+package game
+
+import (
+	"clipet/internal/game/capabilities"
+	"time"
+)
+
+// Household interactions between pets of the same profile.
+const (
+	PlayTogetherBonus       = 8   // extra happiness for each pet playing together
+	NeglectHappinessPerHour = 1.0 // happiness lost per hour for each neglected housemate
+	MaxNeglectLoss          = 30  // cap on the happiness lost in one settlement
+)
+
+// PlayTogether lets two pets of a household play with each other. Both
+// play as with Play and gain PlayTogetherBonus extra happiness. Nothing
+// changes unless both can play: the pet that cannot play gets its own
+// failure and the other one ErrPartnerBusy.
+func PlayTogether(a, b *Pet) (ActionResult, ActionResult) {
+	busy := failResultWithType(ErrPartnerBusy, "另一只宠物现在不能一起玩")
+	if res, blocked := a.playBlocked(); blocked {
+		return res, busy
+	}
+	if res, blocked := b.playBlocked(); blocked {
+		return busy, res
+	}
+
+	results := [2]ActionResult{}
+	for i, p := range []*Pet{a, b} {
+		res := p.play(nil)
+		p.applyTrackedEffects(map[string]int{"happiness": PlayTogetherBonus}, res.Changes)
+		res.Message = "一起玩得很开心！"
+		results[i] = res
+	}
+	return results[0], results[1]
+}
+
+// Neglected reports whether the pet is hatched, alive and in need of care:
+// hunger below the species' hunger_health_threshold or health below its
+// health_crit_threshold.
+func (p *Pet) Neglected() bool {
+	if !p.Alive || p.Stage == StageEgg {
+		return false
+	}
+	ic := capabilities.AttributeInteractionConfig{}.Defaults()
+	if p.registry != nil {
+		ic = p.registry.GetAttributeInteractionConfig(p.Species)
+	}
+	return p.Hunger < ic.HungerHealthThreshold || p.Health < ic.HealthCritThreshold
+}
+
+// NeglectResult reports the happiness a pet lost over neglected housemates.
+type NeglectResult struct {
+	Housemates []string // names of the neglected housemates
+	Happiness  [2]int   // {old, new}
+}
+
+// ApplyHouseholdNeglect saddens the pets of a household that spent time
+// next to neglected housemates. elapsed holds the time settled for each pet,
+// in the same order. A hatched, living pet loses NeglectHappinessPerHour per
+// hour for each neglected housemate, at most MaxNeglectLoss. The result has
+// one entry per pet; pets that lost nothing have no housemates listed.
+func ApplyHouseholdNeglect(pets []*Pet, elapsed []time.Duration) []NeglectResult {
+	neglected := make([]bool, len(pets))
+	for i, p := range pets {
+		neglected[i] = p.Neglected()
+	}
+
+	results := make([]NeglectResult, len(pets))
+	for i, p := range pets {
+		if !p.Alive || p.Stage == StageEgg || i >= len(elapsed) || elapsed[i] <= 0 {
+			continue
+		}
+		var names []string
+		for j, other := range pets {
+			if j != i && neglected[j] {
+				names = append(names, other.Name)
+			}
+		}
+		loss := min(int(NeglectHappinessPerHour*elapsed[i].Hours()*float64(len(names))), MaxNeglectLoss)
+		if loss <= 0 {
+			continue
+		}
+		old := p.Happiness
+		p.Happiness = clamp(p.Happiness-loss, 0, 100)
+		results[i] = NeglectResult{Housemates: names, Happiness: [2]int{old, p.Happiness}}
+	}
+	return results
+}
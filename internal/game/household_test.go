@@ -0,0 +1,65 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+func householdPet(name string) *Pet {
+	pet := NewPet(name, "tabby", "egg", 80, 50, 80, 80, nil)
+	pet.Stage, pet.StageID = StageBaby, "baby"
+	pet.LastPlayedAt = time.Now().Add(-24 * time.Hour)
+	return pet
+}
+
+func TestPlayTogether(t *testing.T) {
+	a, b := householdPet("Mochi"), householdPet("Kiki")
+	solo := householdPet("Solo")
+	solo.Play()
+
+	resA, resB := PlayTogether(a, b)
+	if !resA.OK || !resB.OK {
+		t.Fatalf("PlayTogether failed: %s / %s", resA.Message, resB.Message)
+	}
+	for _, p := range []*Pet{a, b} {
+		if want := solo.Happiness + PlayTogetherBonus; p.Happiness != want {
+			t.Errorf("%s happiness = %d, want %d (play + bonus)", p.Name, p.Happiness, want)
+		}
+	}
+	if ch := resA.Changes["happiness"]; ch[0] != 50 || ch[1] != a.Happiness {
+		t.Errorf("happiness change = %v, want [50 %d]", ch, a.Happiness)
+	}
+
+	// Both are on cooldown now; a fresh pet cannot play with a tired one
+	c := householdPet("Tofu")
+	resC, resA := PlayTogether(c, a)
+	if resC.ErrorType != ErrPartnerBusy || resA.ErrorType != ErrCooldown {
+		t.Errorf("errors = %q/%q, want %q/%q", resC.ErrorType, resA.ErrorType, ErrPartnerBusy, ErrCooldown)
+	}
+	if c.Happiness != 50 {
+		t.Errorf("partner played alone: happiness %d", c.Happiness)
+	}
+}
+
+func TestHouseholdNeglect(t *testing.T) {
+	happy, hungry, egg := householdPet("Mochi"), householdPet("Kiki"), householdPet("Egg")
+	hungry.Hunger = 5
+	egg.Stage = StageEgg
+
+	results := ApplyHouseholdNeglect([]*Pet{happy, hungry, egg}, []time.Duration{5 * time.Hour, 5 * time.Hour, 5 * time.Hour})
+	if happy.Happiness != 45 {
+		t.Errorf("happy pet's happiness = %d, want 45 after 5h next to a hungry housemate", happy.Happiness)
+	}
+	if r := results[0]; len(r.Housemates) != 1 || r.Housemates[0] != "Kiki" || r.Happiness != [2]int{50, 45} {
+		t.Errorf("result = %+v", r)
+	}
+	if hungry.Happiness != 50 || egg.Happiness != 50 {
+		t.Errorf("pets without neglected housemates lost happiness: %d, %d", hungry.Happiness, egg.Happiness)
+	}
+
+	// Long absences are capped
+	ApplyHouseholdNeglect([]*Pet{happy, hungry}, []time.Duration{100 * time.Hour, 0})
+	if happy.Happiness != 45-MaxNeglectLoss {
+		t.Errorf("happiness after 100h = %d, want %d", happy.Happiness, 45-MaxNeglectLoss)
+	}
+}
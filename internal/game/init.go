@@ -9,8 +9,9 @@ import (
 // Must be called once at program startup.
 func InitTimeSystem(pluginRegistry *plugin.Registry, capReg *capabilities.Registry) {
 	// Register core hooks (in priority order, highest first)
-	RegisterTimeHook(NewDeathCheckHook(capReg), PriorityCritical) // 100
+	RegisterTimeHook(NewDeathCheckHook(capReg), PriorityCritical)    // 100
 	RegisterTimeHook(NewAttrDecayHook(pluginRegistry), PriorityHigh) // 80
-	RegisterTimeHook(NewCooldownHook(), PriorityNormal)     // 50
-	RegisterTimeHook(NewLifecycleHook(pluginRegistry), PriorityLow) // 20
+	RegisterTimeHook(NewCooldownHook(), PriorityNormal)              // 50
+	RegisterTimeHook(NewCrisisHook(pluginRegistry), PriorityNormal)  // 50
+	RegisterTimeHook(NewLifecycleHook(pluginRegistry), PriorityLow)  // 20
 }
@@ -0,0 +1,162 @@
+package game
+
+import (
+	"clipet/internal/plugin"
+	"maps"
+	"math/rand"
+	"slices"
+)
+
+// MaxItemStack is the most items of one kind a pet can carry.
+const MaxItemStack = 99
+
+// AddItem puts count items into the pet's inventory, up to the item's stack
+// limit, and returns how many were actually added.
+func (p *Pet) AddItem(itemID string, count int) int {
+	if count <= 0 {
+		return 0
+	}
+	if p.Inventory == nil {
+		p.Inventory = make(map[string]int)
+	}
+	added := min(count, p.stackLimit(itemID)-p.Inventory[itemID])
+	if added <= 0 {
+		return 0
+	}
+	p.Inventory[itemID] += added
+	return added
+}
+
+// RemoveItem takes count items out of the inventory. It reports false and
+// leaves the inventory unchanged if the pet does not have that many.
+func (p *Pet) RemoveItem(itemID string, count int) bool {
+	if count <= 0 || p.Inventory[itemID] < count {
+		return false
+	}
+	p.Inventory[itemID] -= count
+	if p.Inventory[itemID] == 0 {
+		delete(p.Inventory, itemID)
+		p.Equipped = slices.DeleteFunc(p.Equipped, func(id string) bool { return id == itemID })
+	}
+	return true
+}
+
+// stackLimit returns how many of an item the pet can carry: one of each
+// accessory and MaxItemStack of anything else.
+func (p *Pet) stackLimit(itemID string) int {
+	if p.registry != nil {
+		if item := p.registry.GetItem(p.Species, itemID); item != nil && item.Kind == plugin.ItemAccessory {
+			return 1
+		}
+	}
+	return MaxItemStack
+}
+
+// ItemCount returns how many of an item the pet carries.
+func (p *Pet) ItemCount(itemID string) int {
+	return p.Inventory[itemID]
+}
+
+// InventoryIDs returns the IDs of the items the pet carries, sorted.
+func (p *Pet) InventoryIDs() []string {
+	return slices.Sorted(maps.Keys(p.Inventory))
+}
+
+// UseItem uses an item from the inventory through the care action of its
+// kind: food is fed, toys are played with and medicine heals. The action's
+// own checks and cooldown apply; on success the item's effects are added
+// and one item is consumed.
+func (p *Pet) UseItem(itemID string) ActionResult {
+	if p.ItemCount(itemID) <= 0 {
+		return failResultWithType(ErrNoItem, "背包里没有这个物品！")
+	}
+	var item *plugin.Item
+	if p.registry != nil {
+		item = p.registry.GetItem(p.Species, itemID)
+	}
+	if item == nil {
+		return failResultWithType(ErrInvalidAction, "未知的物品")
+	}
+
+	var res ActionResult
+	switch item.Kind {
+	case plugin.ItemFood:
+		res = p.feed(item)
+	case plugin.ItemToy:
+		res = p.play(item)
+	case plugin.ItemMedicine:
+		res = p.heal(item)
+	case plugin.ItemAccessory:
+		return failResultWithType(ErrInvalidAction, "饰品需要装备，不能使用")
+	default:
+		return failResultWithType(ErrInvalidAction, "这个物品不能使用")
+	}
+	if res.OK {
+		p.RemoveItem(itemID, 1)
+		res.Message = item.Name + "：" + res.Message
+	}
+	return res
+}
+
+// applyItem adds the effects of item (if any) to the pet, recording them in
+// changes. Gains are scaled by the species' item tag preferences and, for
+// core attributes, have diminishing returns like the actions themselves.
+func (p *Pet) applyItem(item *plugin.Item, changes map[string][2]int) {
+	if item == nil || len(item.Effects) == 0 {
+		return
+	}
+	mult := 1.0
+	if p.capabilitiesReg != nil {
+		mult = p.capabilitiesReg.ItemTagMultiplier(p.Species, p.UnlockedTraitIDs(), item.Tags, p.constraints())
+	}
+	effects := make(map[string]int, len(item.Effects))
+	for attr, delta := range item.Effects {
+		if delta > 0 {
+			delta = int(float64(delta) * mult)
+			if isCoreAttr(attr) {
+				delta = diminish(delta, p.GetAttr(attr))
+			}
+		}
+		effects[attr] = delta
+	}
+	p.applyTrackedEffects(effects, changes)
+}
+
+// isCoreAttr reports whether attr is one of the four core attributes.
+func isCoreAttr(attr string) bool {
+	switch attr {
+	case "hunger", "happiness", "health", "energy":
+		return true
+	}
+	return false
+}
+
+// DrawPrize gives the pet a random mini-game prize, weighted by the items'
+// prize_weight, and returns it. Returns nil if the species has no prizes
+// or the drawn item's stack is full.
+func (p *Pet) DrawPrize() *plugin.Item {
+	if p.registry == nil {
+		return nil
+	}
+	var prizes []plugin.Item
+	total := 0
+	for _, item := range p.registry.GetItems(p.Species) {
+		if item.PrizeWeight > 0 {
+			prizes = append(prizes, item)
+			total += item.PrizeWeight
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+	roll := rand.Intn(total)
+	for _, item := range prizes {
+		if roll -= item.PrizeWeight; roll < 0 {
+			if p.AddItem(item.ID, 1) == 0 {
+				return nil
+			}
+			return &item
+		}
+	}
+	return nil
+}
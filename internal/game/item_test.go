@@ -0,0 +1,190 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"clipet/internal/game/capabilities"
+	"clipet/internal/plugin"
+)
+
+// itemRegistry loads a pack with items for sale and a picky trait that likes fish and
+// dislikes dry food.
+func itemRegistry(t *testing.T) (*plugin.Registry, *capabilities.Registry) {
+	t.Helper()
+	return testRegistry(t, `
+[[traits]]
+id = "picky"
+name = "Picky"
+type = "passive"
+[traits.passive_effect.item_tag_bonus]
+fish = 0.5
+dry = -0.3
+`, map[string]string{
+		"items.toml": `
+[[items]]
+id = "fish"
+name = "Fish"
+kind = "food"
+tags = ["fish"]
+effects = { health = 10 }
+prize_weight = 1
+price = 10
+
+[[items]]
+id = "kibble"
+name = "Kibble"
+kind = "food"
+tags = ["dry"]
+effects = { health = 10 }
+
+[[items]]
+id = "ball"
+name = "Ball"
+kind = "toy"
+effects = { health = 10 }
+
+[[items]]
+id = "bow"
+name = "Bow"
+kind = "accessory"
+price = 50
+`,
+		"adventures.toml": `
+[[adventures]]
+id = "pond"
+name = "Pond"
+
+  [[adventures.choices]]
+  text = "fish"
+  outcomes = [{ weight = 1, text = "caught two", effects = { coins = 7 }, items = { fish = 2 } }]
+`,
+	})
+}
+
+func itemPet(reg *plugin.Registry, capReg *capabilities.Registry) *Pet {
+	pet := testPet(reg)
+	pet.Health, pet.Energy = 0, 100
+	pet.SetCapabilitiesRegistry(capReg)
+	pet.LastFedAt = time.Now().Add(-24 * time.Hour)
+	pet.LastPlayedAt = time.Now().Add(-24 * time.Hour)
+	return pet
+}
+
+func TestInventoryStacks(t *testing.T) {
+	pet := itemPet(itemRegistry(t))
+
+	if n := pet.AddItem("fish", 3); n != 3 {
+		t.Errorf("AddItem(3) = %d, want 3", n)
+	}
+	if n := pet.AddItem("fish", MaxItemStack); n != MaxItemStack-3 {
+		t.Errorf("AddItem past the stack = %d, want %d", n, MaxItemStack-3)
+	}
+	if pet.RemoveItem("fish", MaxItemStack+1) {
+		t.Error("removed more items than carried")
+	}
+	if !pet.RemoveItem("fish", MaxItemStack) {
+		t.Fatal("could not remove the whole stack")
+	}
+	if _, ok := pet.Inventory["fish"]; ok {
+		t.Error("empty stack left in the inventory")
+	}
+}
+
+func TestUseItem(t *testing.T) {
+	pet := itemPet(itemRegistry(t))
+	pet.AddItem("ball", 2)
+
+	res := pet.UseItem("ball")
+	if !res.OK {
+		t.Fatalf("UseItem failed: %s", res.Message)
+	}
+	if ch := res.Changes["health"]; ch != [2]int{0, 10} {
+		t.Errorf("health change = %v, want [0 10]", ch)
+	}
+	if _, ok := res.Changes["happiness"]; !ok {
+		t.Error("toy did not apply the play action")
+	}
+	if n := pet.ItemCount("ball"); n != 1 {
+		t.Errorf("%d balls left, want 1", n)
+	}
+
+	// Play is on cooldown now, so the item is kept
+	res = pet.UseItem("ball")
+	if res.OK || res.ErrorType != ErrCooldown {
+		t.Fatalf("second use = %+v, want a cooldown failure", res)
+	}
+	if n := pet.ItemCount("ball"); n != 1 {
+		t.Errorf("failed use consumed the item: %d left", n)
+	}
+
+	if res := pet.UseItem("fish"); res.ErrorType != ErrNoItem {
+		t.Errorf("using a missing item = %q, want %q", res.ErrorType, ErrNoItem)
+	}
+}
+
+func TestItemTagBonus(t *testing.T) {
+	reg, capReg := itemRegistry(t)
+
+	for _, tt := range []struct {
+		item string
+		want int
+	}{
+		{"fish", 15},  // +50%
+		{"kibble", 7}, // -30%
+	} {
+		pet := itemPet(reg, capReg)
+		pet.AddItem(tt.item, 1)
+		if res := pet.UseItem(tt.item); !res.OK {
+			t.Fatalf("UseItem(%s) failed: %s", tt.item, res.Message)
+		}
+		if pet.Health != tt.want {
+			t.Errorf("%s: health = %d, want %d", tt.item, pet.Health, tt.want)
+		}
+	}
+}
+
+func TestAdventureItemRewards(t *testing.T) {
+	reg, capReg := itemRegistry(t)
+	pet := itemPet(reg, capReg)
+
+	outcome := reg.GetSpecies(testSpecies).Adventures[0].Choices[0].Outcomes[0]
+	ApplyAdventureOutcome(pet, outcome)
+	if n := pet.ItemCount("fish"); n != 2 {
+		t.Errorf("%d fish after the adventure, want 2", n)
+	}
+}
+
+func TestDrawPrize(t *testing.T) {
+	pet := itemPet(itemRegistry(t))
+
+	// Only fish has a prize weight
+	prize := pet.DrawPrize()
+	if prize == nil || prize.ID != "fish" {
+		t.Fatalf("DrawPrize = %+v, want fish", prize)
+	}
+	if n := pet.ItemCount("fish"); n != 1 {
+		t.Errorf("%d fish after the prize, want 1", n)
+	}
+}
+
+func TestValidateItems(t *testing.T) {
+	reg, _ := itemRegistry(t)
+	bad := *reg.GetSpecies(testSpecies)
+	bad.Items = append([]plugin.Item{{ID: "fish", Kind: "hat", PrizeWeight: -1}}, bad.Items...)
+	bad.Adventures = []plugin.Adventure{{ID: "pond", Name: "Pond", Choices: []plugin.AdventureChoice{{
+		Text:     "dig",
+		Outcomes: []plugin.AdventureOutcome{{Weight: 1, Items: map[string]int{"bone": 1}}},
+	}}}}
+
+	fields := map[string]bool{}
+	errs, _ := plugin.Validate(&bad)
+	for _, e := range errs {
+		fields[e.Field] = true
+	}
+	for _, want := range []string{"items[0].kind", "items[0].prize_weight", "items[1].id", "adventures[0].choices[0].outcomes[0].items"} {
+		if !fields[want] {
+			t.Errorf("Validate did not report %s (got %v)", want, fields)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package game
+
+import (
+	"clipet/internal/game/capabilities"
+	"clipet/internal/plugin"
+	"maps"
+	"math/rand"
+	"slices"
+	"strings"
+)
+
+// Legacy tuning for successors.
+const (
+	// LegacyInteractionsPerPoint is how many interactions of the predecessor
+	// add one point of happiness to its successor.
+	LegacyInteractionsPerPoint = 50
+	// LegacyAdventuresPerPoint is how many completed adventures of the
+	// predecessor add one point of health to its successor.
+	LegacyAdventuresPerPoint = 5
+	// MaxLegacyStatBonus caps each of the stat-based bonuses above.
+	MaxLegacyStatBonus = 10
+	// LegacyCoinShare is the share of the predecessor's coins the successor
+	// inherits.
+	LegacyCoinShare = 0.5
+)
+
+// defaultEndingLegacy is the attribute bonus of the built-in endings, used
+// when the species gives the ending no legacy of its own.
+var defaultEndingLegacy = map[string]map[string]int{
+	"blissful_passing": {"happiness": 15},
+	"heroic_tale":      {"health": 10, "energy": 10},
+	"peaceful_rest":    {"health": 10},
+}
+
+// Legacy is what a successor inherits from its predecessor.
+type Legacy struct {
+	Predecessor string         `json:"predecessor"`
+	EndingType  string         `json:"ending_type,omitempty"` // empty if the predecessor died without an ending
+	Attrs       map[string]int `json:"attrs,omitempty"`       // bonus on top of the species' base stats
+	Coins       int            `json:"coins,omitempty"`
+	Trait       string         `json:"trait,omitempty"` // a trait of the predecessor passed down
+}
+
+// GenerationNumber returns the pet's generation, counting from 1.
+func (p *Pet) GenerationNumber() int {
+	return max(p.Generation, 1)
+}
+
+// NewLegacy works out what the successor of the dead pet pred inherits:
+// the attribute bonus of its ending (from the species pack, or the
+// built-in default), happiness for its interactions and health for its
+// adventures, a share of its coins and one of its traits at random.
+func NewLegacy(pred *Pet) Legacy {
+	legacy := Legacy{
+		Predecessor: pred.Name,
+		EndingType:  pred.EndingType,
+		Attrs:       map[string]int{},
+		Coins:       int(float64(pred.Coins) * LegacyCoinShare),
+	}
+
+	attrs := defaultEndingLegacy[pred.EndingType]
+	if pred.registry != nil {
+		if own := pred.registry.GetEndingLegacy(pred.Species, pred.EndingType); len(own) > 0 {
+			attrs = own
+		}
+	}
+	maps.Copy(legacy.Attrs, attrs)
+
+	if bonus := min(pred.TotalInteractions/LegacyInteractionsPerPoint, MaxLegacyStatBonus); bonus > 0 {
+		legacy.Attrs["happiness"] += bonus
+	}
+	if bonus := min(pred.AdventuresCompleted/LegacyAdventuresPerPoint, MaxLegacyStatBonus); bonus > 0 {
+		legacy.Attrs["health"] += bonus
+	}
+	if len(legacy.Attrs) == 0 {
+		legacy.Attrs = nil
+	}
+
+	if traits := pred.TraitIDs(); len(traits) > 0 {
+		legacy.Trait = traits[rand.Intn(len(traits))]
+	}
+	return legacy
+}
+
+// NewSuccessor hatches the successor of the dead pet pred: an egg of the
+// same species named name, one generation later, with freshly rolled
+// traits and pred's legacy applied. The inherited trait replaces any
+// rolled trait of its group.
+func NewSuccessor(pred *Pet, name string) (*Pet, Legacy) {
+	reg := pred.registry
+	legacy := NewLegacy(pred)
+
+	base := plugin.BaseStats{Hunger: 50, Happiness: 50, Health: 50, Energy: 50}
+	eggStageID := ""
+	if reg != nil {
+		if bs := reg.GetBaseStats(pred.Species); bs != nil {
+			base = *bs
+		}
+		if egg := reg.GetEggStage(pred.Species); egg != nil {
+			eggStageID = egg.ID
+		}
+	}
+	bonus := func(stat int, attr string) int {
+		return clamp(stat+legacy.Attrs[attr], 0, 100)
+	}
+	succ := NewPet(name, pred.Species, eggStageID,
+		bonus(base.Hunger, "hunger"),
+		bonus(base.Happiness, "happiness"),
+		bonus(base.Health, "health"),
+		bonus(base.Energy, "energy"),
+		reg)
+	succ.SetCapabilitiesRegistry(pred.capabilitiesReg)
+
+	if reg != nil {
+		succ.Traits = inheritLegacyTrait(RollTraits(reg, pred.Species), legacy.Trait, speciesTraits(reg, pred.Species))
+	}
+	succ.Generation = pred.GenerationNumber() + 1
+	succ.Predecessor = pred.Name
+	succ.Coins = legacy.Coins
+	succ.Legacy = &legacy
+	return succ, legacy
+}
+
+// inheritLegacyTrait adds the trait to the rolled traits, dropping rolled
+// traits of its group. Traits no longer in the species pool are not
+// inherited.
+func inheritLegacyTrait(rolled []string, trait string, pool []capabilities.PersonalityTrait) []string {
+	i := slices.IndexFunc(pool, func(t capabilities.PersonalityTrait) bool { return t.ID == trait })
+	if i < 0 || slices.Contains(rolled, trait) {
+		return rolled
+	}
+	weights := make([]int, len(pool))
+	for j, t := range pool {
+		if slices.Contains(rolled, t.ID) {
+			weights[j] = 1
+		}
+	}
+	excludeGroup(pool, weights, i)
+	var kept []string
+	for j, t := range pool {
+		if weights[j] > 0 {
+			kept = append(kept, t.ID)
+		}
+	}
+	return inPackOrder(pool, append(kept, trait))
+}
+
+// SuccessorName suggests a name for the successor of pred: its name with
+// the next generation as a roman numeral, e.g. "Mochi II" after "Mochi" and
+// "Mochi III" after "Mochi II".
+func SuccessorName(pred *Pet) string {
+	gen := pred.GenerationNumber()
+	name := strings.TrimSpace(pred.Name)
+	if gen > 1 {
+		name = strings.TrimSuffix(name, " "+roman(gen))
+	}
+	return name + " " + roman(gen+1)
+}
+
+var romanNumerals = []struct {
+	value  int
+	symbol string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"}, {100, "C"}, {90, "XC"},
+	{50, "L"}, {40, "XL"}, {10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+// roman formats n (> 0) as a roman numeral.
+func roman(n int) string {
+	var b strings.Builder
+	for _, r := range romanNumerals {
+		for n >= r.value {
+			b.WriteString(r.symbol)
+			n -= r.value
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,98 @@
+package game
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"clipet/internal/game/capabilities"
+	"clipet/internal/plugin"
+)
+
+func TestNewSuccessor(t *testing.T) {
+	reg, capReg := traitRegistry(t)
+	pred := traitPet(reg, capReg, "shy", "glutton")
+	pred.Stage, pred.StageID = StageAdult, "adult"
+	pred.Alive, pred.EndingType = false, "blissful_passing"
+	pred.TotalInteractions = 120 // +2 happiness
+	pred.AdventuresCompleted = 500
+	pred.Coins = 41
+
+	for range 20 {
+		succ, legacy := NewSuccessor(pred, "Tabby II")
+		// The test species defines no legacy of its own: the built-in one applies
+		if want := map[string]int{"happiness": 17, "health": MaxLegacyStatBonus}; !maps.Equal(legacy.Attrs, want) {
+			t.Fatalf("legacy attrs = %v, want %v", legacy.Attrs, want)
+		}
+		// The test species has no base stats: the legacy is all the egg starts with
+		if succ.Hunger != 0 || succ.Happiness != 17 || succ.Health != 10 {
+			t.Errorf("successor stats = %d/%d/%d, want 0/17/10", succ.Hunger, succ.Happiness, succ.Health)
+		}
+		if succ.Coins != 20 || legacy.Coins != 20 {
+			t.Errorf("successor coins = %d, want 20", succ.Coins)
+		}
+		if succ.Stage != StageEgg || succ.StageID != "egg" || !succ.Alive {
+			t.Errorf("successor = %s/%s alive=%v, want a living egg", succ.Stage, succ.StageID, succ.Alive)
+		}
+		if succ.Generation != 2 || succ.Predecessor != "Tabby" || succ.Legacy == nil {
+			t.Errorf("successor generation = %d, predecessor = %q", succ.Generation, succ.Predecessor)
+		}
+
+		// The inherited trait replaces a rolled trait of its group
+		if !slices.Contains(pred.Traits, legacy.Trait) || !slices.Contains(succ.Traits, legacy.Trait) {
+			t.Fatalf("legacy trait %q not inherited: %v", legacy.Trait, succ.Traits)
+		}
+		n := 0
+		for _, id := range succ.Traits {
+			if id == "bold" || id == "shy" || id == "calm" {
+				n++
+			}
+		}
+		if n != 1 {
+			t.Fatalf("successor traits = %v, want exactly one temperament", succ.Traits)
+		}
+	}
+}
+
+func TestSuccessorName(t *testing.T) {
+	reg, capReg := traitRegistry(t)
+	pet := traitPet(reg, capReg)
+	for _, tt := range []struct {
+		name string
+		gen  int
+		want string
+	}{
+		{"Vix", 0, "Vix II"},
+		{"Vix II", 2, "Vix III"},
+		{"Vix VIII", 8, "Vix IX"},
+		{"Ivy", 3, "Ivy IV"},
+	} {
+		pet.Name, pet.Generation = tt.name, tt.gen
+		if got := SuccessorName(pet); got != tt.want {
+			t.Errorf("SuccessorName(%q, gen %d) = %q, want %q", tt.name, tt.gen, got, tt.want)
+		}
+	}
+}
+
+func TestValidateEndingLegacy(t *testing.T) {
+	reg, _ := traitRegistry(t)
+	bad := *reg.GetSpecies(testSpecies)
+	bad.Endings = []capabilities.Ending{
+		{Type: "peaceful_rest", Legacy: map[string]int{"health": 10, "luck": 5}},
+		{Type: "heroic_tale", Legacy: map[string]int{"energy": plugin.MaxEndingLegacy + 1}},
+	}
+
+	fields := map[string]bool{}
+	errs, _ := plugin.Validate(&bad)
+	for _, e := range errs {
+		fields[e.Field] = true
+	}
+	if fields["endings[0].legacy.health"] {
+		t.Error("Validate reported a valid legacy")
+	}
+	for _, want := range []string{"endings[0].legacy.luck", "endings[1].legacy.energy"} {
+		if !fields[want] {
+			t.Errorf("Validate did not report %s (got %v)", want, fields)
+		}
+	}
+}
@@ -113,5 +113,5 @@ func (m *LifecycleManager) matchesEndingCondition(pet *Pet, condition capabiliti
 	if condition.MinAdventures > 0 && pet.AdventuresCompleted < condition.MinAdventures {
 		return false
 	}
-	return true
+	return pet.EvalCondition(condition.Expr)
 }
@@ -5,6 +5,7 @@ import (
 	"clipet/internal/game/capabilities"
 	"clipet/internal/plugin"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -64,7 +65,7 @@ const (
 	DefaultRestHappiness = -5
 
 	// Heal action
-	DefaultHealHealth    = 25
+	DefaultHealHealth     = 25
 	DefaultHealEnergyCost = 15
 
 	// Talk action
@@ -83,6 +84,17 @@ const (
 	ErrSkillSystem    = "skill_system"
 	ErrSkillUnknown   = "skill_unknown"
 	ErrSkillNotActive = "skill_not_active"
+	ErrNoAdventure    = "no_adventure"
+	ErrAdventureLimit = "adventure_limit"
+	ErrNoItem         = "no_item"
+	ErrNotForSale     = "not_for_sale"
+	ErrNoCoins        = "not_enough_coins"
+	ErrInventoryFull  = "inventory_full"
+	ErrNotAccessory   = "not_accessory"
+	ErrPartnerBusy    = "partner_busy"
+	ErrCannotBreed    = "cannot_breed"
+	ErrNotGrown       = "not_grown"
+	ErrBreedMismatch  = "breed_mismatch"
 )
 
 // ActionResult holds the outcome of a pet action.
@@ -93,6 +105,7 @@ type ActionResult struct {
 	Changes           map[string][2]int // attr name -> {old, new}
 	Animation         AnimState         // animation to play (empty = no change)
 	AnimationDuration time.Duration     // how long the animation should last
+	Crises            []CrisisEvent     // crises resolved by this action
 }
 
 // diminish calculates a diminishing-return gain.
@@ -127,6 +140,14 @@ func cooldownLeft(last time.Time, cd time.Duration) string {
 	return fmt.Sprintf("%d分%d秒", int(remaining.Minutes()), int(remaining.Seconds())%60)
 }
 
+// Built-in evolution accumulators, stored in CustomAttributes.
+// They back the attr_bias evolution condition and reset on every evolution.
+const (
+	AccHappiness = "acc_happiness"
+	AccHealth    = "acc_health"
+	AccPlayful   = "acc_playful"
+)
+
 // Pet is the central game entity representing the player's virtual pet.
 type Pet struct {
 	// Basic info
@@ -148,14 +169,15 @@ type Pet struct {
 	Energy    int `json:"energy"`
 
 	// Timestamps
-	LastFedAt     time.Time `json:"last_fed_at"`
-	LastPlayedAt  time.Time `json:"last_played_at"`
-	LastRestedAt  time.Time `json:"last_rested_at"`
-	LastHealedAt  time.Time `json:"last_healed_at"`
-	LastTalkedAt     time.Time `json:"last_talked_at"`
-	LastCheckedAt    time.Time `json:"last_checked_at"`
-	LastAdventureAt  time.Time `json:"last_adventure_at"`
-	LastSkillUsedAt  time.Time `json:"last_skill_used_at"` // NEW: skill cooldown tracking
+	LastFedAt       time.Time   `json:"last_fed_at"`
+	LastPlayedAt    time.Time   `json:"last_played_at"`
+	LastRestedAt    time.Time   `json:"last_rested_at"`
+	LastHealedAt    time.Time   `json:"last_healed_at"`
+	LastTalkedAt    time.Time   `json:"last_talked_at"`
+	LastCheckedAt   time.Time   `json:"last_checked_at"`
+	LastAdventureAt time.Time   `json:"last_adventure_at"`
+	LastSkillUsedAt time.Time   `json:"last_skill_used_at"`        // NEW: skill cooldown tracking
+	AdventureTimes  []time.Time `json:"adventure_times,omitempty"` // recent adventures, for MaxAdventureFrequency
 
 	// Statistics
 	TotalInteractions   int `json:"total_interactions"`
@@ -164,9 +186,7 @@ type Pet struct {
 	DialogueCount       int `json:"dialogue_count"`
 
 	// Evolution accumulation scores
-	AccHappiness      int     `json:"acc_happiness"`
-	AccHealth         int     `json:"acc_health"`
-	AccPlayful        int     `json:"acc_playful"`
+	// (happiness/health/playful accumulators live in CustomAttributes, see AccHappiness)
 	NightInteractions int     `json:"night_interactions"`
 	DayInteractions   int     `json:"day_interactions"`
 	FeedRegularity    float64 `json:"feed_regularity"`
@@ -174,18 +194,51 @@ type Pet struct {
 	FeedExpectedCount int     `json:"feed_expected_count"`
 
 	// State
-	Alive                 bool          `json:"alive"`
-	CurrentAnimation      AnimState     `json:"current_animation"`
-	AnimationEndTime      time.Time     `json:"animation_end_time"`      // when current animation should end
-	LifecycleWarningShown bool          `json:"lifecycle_warning_shown"` // NEW: lifecycle tracking
+	Alive                 bool      `json:"alive"`
+	CurrentAnimation      AnimState `json:"current_animation"`
+	AnimationEndTime      time.Time `json:"animation_end_time"`      // when current animation should end
+	LifecycleWarningShown bool      `json:"lifecycle_warning_shown"` // NEW: lifecycle tracking
 
 	// Ending information
-	EndingType    string `json:"ending_type,omitempty"`    // Ending type for i18n lookup
-	EndingMessage string `json:"ending_message,omitempty"` // Plugin-provided message (optional)
+	EndingType    string    `json:"ending_type,omitempty"`    // Ending type for i18n lookup
+	EndingMessage string    `json:"ending_message,omitempty"` // Plugin-provided message (optional)
+	DiedAt        time.Time `json:"died_at,omitzero"`         // when the pet died or reached its ending
+
+	// Generations (see legacy.go)
+	Generation  int     `json:"generation,omitempty"`  // 0 for a first-generation pet, see GenerationNumber
+	Predecessor string  `json:"predecessor,omitempty"` // name of the pet this one succeeded
+	Legacy      *Legacy `json:"legacy,omitempty"`      // what the pet inherited from its predecessor
+
+	// Passive health regeneration (see regen.go)
+	HealthRegenCarry float64 `json:"health_regen_carry,omitempty"` // fraction of a point regenerated but not applied yet
+	healthRegained   int     // regenerated since the last TakeHealthRegen (not serialized)
 
 	// Custom attributes (Phase 3)
 	CustomAttributes map[string]int `json:"custom_attributes,omitempty"` // NEW: custom attribute storage
 
+	// Items carried by the pet: item ID -> count (see item.go)
+	Inventory map[string]int `json:"inventory,omitempty"`
+	Equipped  []string       `json:"equipped,omitempty"` // worn accessories, drawn in this order
+
+	// Coins and the daily care streak (see shop.go)
+	Coins       int    `json:"coins,omitempty"`
+	CareStreak  int    `json:"care_streak,omitempty"`   // consecutive days with at least one care action
+	LastCareDay string `json:"last_care_day,omitempty"` // local date of the last care action, "2006-01-02"
+
+	// Personality and ancestry (see breeding.go)
+	Traits     []string  `json:"traits"`            // the pet's own traits; null means every trait of the species
+	Parents    []string  `json:"parents,omitempty"` // names of the parents of a bred pet
+	LastBredAt time.Time `json:"last_bred_at,omitzero"`
+
+	// Crises (see crisis.go)
+	ActiveCrises []ActiveCrisis  `json:"active_crises,omitempty"`
+	RecentCrises []time.Duration `json:"recent_crises,omitempty"` // time since each start within the last hour, for throttling
+	crisisEvents []CrisisEvent   // started/failed since the last TakeCrisisEvents (not serialized)
+
+	// Achievements unlocked since the last TakeAchievements (not serialized;
+	// unlocks are kept per profile, see achievement.go)
+	achievements []plugin.Achievement
+
 	// Plugin registry (not serialized)
 	registry *plugin.Registry `json:"-"`
 
@@ -227,6 +280,14 @@ func (p *Pet) SetRegistry(registry *plugin.Registry) {
 	p.registry = registry
 }
 
+// ReplaceState overwrites p's saved state with other's, keeping p's
+// registry references. Used when another process changed the save.
+func (p *Pet) ReplaceState(other *Pet) {
+	reg, capReg := p.registry, p.capabilitiesReg
+	*p = *other
+	p.registry, p.capabilitiesReg = reg, capReg
+}
+
 // SetCapabilitiesRegistry sets the capabilities registry for the pet.
 func (p *Pet) SetCapabilitiesRegistry(capReg *capabilities.Registry) {
 	p.capabilitiesReg = capReg
@@ -245,6 +306,11 @@ func (p *Pet) CapabilitiesRegistry() *capabilities.Registry {
 // Feed increases the pet's hunger (fullness) level.
 // Dynamic cooldown based on urgency. Prerequisite: hunger < 95. Diminishing returns on gain.
 func (p *Pet) Feed() ActionResult {
+	return p.feed(nil)
+}
+
+// feed implements Feed, adding the effects of item if it is not nil.
+func (p *Pet) feed(item *plugin.Item) ActionResult {
 	if !p.Alive {
 		return failResultWithType(ErrDead, "宠物已经不在了...")
 	}
@@ -264,7 +330,7 @@ func (p *Pet) Feed() ActionResult {
 	// Apply passive trait effects
 	if p.capabilitiesReg != nil {
 		hungerGain, happinessGain, _, _ = p.capabilitiesReg.ApplyPassiveEffects(
-			p.Species, "feed", hungerGain, happinessGain, 0, 0)
+			p.Species, p.UnlockedTraitIDs(), "feed", hungerGain, happinessGain, 0, 0)
 	}
 
 	ch := make(map[string][2]int)
@@ -278,11 +344,14 @@ func (p *Pet) Feed() ActionResult {
 	p.TotalInteractions++
 	p.FeedCount++
 	p.trackTimeOfDay()
+	p.recordCareDay(time.Now(), ch)
+	p.applyItem(item, ch)
 	// Evolution modifiers are applied in evolution checks, not here
 	return ActionResult{
 		OK:                true,
 		Message:           "喂食成功！",
 		Changes:           ch,
+		Crises:            p.resolveCrises("feed", ch),
 		Animation:         AnimEating,
 		AnimationDuration: 2 * time.Second,
 	}
@@ -291,23 +360,13 @@ func (p *Pet) Feed() ActionResult {
 // Play increases the pet's happiness and decreases energy.
 // Dynamic cooldown based on urgency. Prerequisite: energy >= cost. Diminishing returns on happiness gain.
 func (p *Pet) Play() ActionResult {
-	if !p.Alive {
-		return failResultWithType(ErrDead, "宠物已经不在了...")
-	}
+	return p.play(nil)
+}
 
-	// Get energy cost from plugin or default
-	energyCost := GetActionEnergyCost(p.registry, p.Species, "play")
-	if energyCost == 0 {
-		energyCost = 10 // fallback
-	}
-
-	// Calculate dynamic cooldown based on current happiness (urgency)
-	cooldown := CalculateDynamicCooldown(p.registry, p.Species, "play", p.Happiness)
-	if left := cooldownLeft(p.LastPlayedAt, cooldown); left != "" {
-		return failResultWithType(ErrCooldown, fmt.Sprintf("宠物还在喘气，%s后可以再玩", left))
-	}
-	if p.Energy < energyCost {
-		return failResultWithType(ErrEnergyLow, "宠物太累了，先休息一下吧！")
+// play implements Play, adding the effects of item if it is not nil.
+func (p *Pet) play(item *plugin.Item) ActionResult {
+	if res, blocked := p.playBlocked(); blocked {
+		return res
 	}
 
 	// Get effects from plugin or defaults
@@ -316,7 +375,7 @@ func (p *Pet) Play() ActionResult {
 	// Apply passive trait effects
 	if p.capabilitiesReg != nil {
 		_, happinessGain, _, energyLoss = p.capabilitiesReg.ApplyPassiveEffects(
-			p.Species, "play", 0, happinessGain, 0, energyLoss)
+			p.Species, p.UnlockedTraitIDs(), "play", 0, happinessGain, 0, energyLoss)
 	}
 
 	ch := make(map[string][2]int)
@@ -326,19 +385,46 @@ func (p *Pet) Play() ActionResult {
 	p.Energy = clamp(p.Energy+energyLoss, 0, 100) // energyLoss is negative
 	ch["happiness"] = [2]int{oldHp, p.Happiness}
 	ch["energy"] = [2]int{oldE, p.Energy}
-	p.AccPlayful += p.addEvolutionPoints(1, "play")
+	p.AddCustomAcc(AccPlayful, p.addEvolutionPoints(1, "play"))
 	p.LastPlayedAt = time.Now()
 	p.TotalInteractions++
 	p.trackTimeOfDay()
+	p.recordCareDay(time.Now(), ch)
+	p.applyItem(item, ch)
 	return ActionResult{
 		OK:                true,
 		Message:           "玩耍愉快！",
 		Changes:           ch,
+		Crises:            p.resolveCrises("play", ch),
 		Animation:         AnimPlaying,
 		AnimationDuration: 2 * time.Second,
 	}
 }
 
+// playBlocked reports whether the pet cannot play now, with the failed
+// result to report.
+func (p *Pet) playBlocked() (ActionResult, bool) {
+	if !p.Alive {
+		return failResultWithType(ErrDead, "宠物已经不在了..."), true
+	}
+
+	// Get energy cost from plugin or default
+	energyCost := GetActionEnergyCost(p.registry, p.Species, "play")
+	if energyCost == 0 {
+		energyCost = 10 // fallback
+	}
+
+	// Calculate dynamic cooldown based on current happiness (urgency)
+	cooldown := CalculateDynamicCooldown(p.registry, p.Species, "play", p.Happiness)
+	if left := cooldownLeft(p.LastPlayedAt, cooldown); left != "" {
+		return failResultWithType(ErrCooldown, fmt.Sprintf("宠物还在喘气，%s后可以再玩", left)), true
+	}
+	if p.Energy < energyCost {
+		return failResultWithType(ErrEnergyLow, "宠物太累了，先休息一下吧！"), true
+	}
+	return ActionResult{}, false
+}
+
 // Talk records a dialogue interaction.
 // Dynamic cooldown based on urgency. Diminishing returns on happiness gain.
 func (p *Pet) Talk() ActionResult {
@@ -361,10 +447,11 @@ func (p *Pet) Talk() ActionResult {
 	ch["happiness"] = [2]int{oldHp, p.Happiness}
 	p.DialogueCount++
 	p.TotalInteractions++
-	p.AccHappiness += p.addEvolutionPoints(1, "happiness")
+	p.AddCustomAcc(AccHappiness, p.addEvolutionPoints(1, "happiness"))
 	p.LastTalkedAt = time.Now()
 	p.trackTimeOfDay()
-	return ActionResult{OK: true, Message: "聊天愉快！", Changes: ch}
+	p.recordCareDay(time.Now(), ch)
+	return ActionResult{OK: true, Message: "聊天愉快！", Changes: ch, Crises: p.resolveCrises("talk", ch)}
 }
 
 // Rest lets the pet sleep/rest, recovering energy and a small amount of health.
@@ -390,7 +477,7 @@ func (p *Pet) Rest() ActionResult {
 	// Apply passive trait effects (use "sleep" for rest action)
 	if p.capabilitiesReg != nil {
 		_, happinessLoss, healthGain, energyGain = p.capabilitiesReg.ApplyPassiveEffects(
-			p.Species, "sleep", 0, happinessLoss, healthGain, energyGain)
+			p.Species, p.UnlockedTraitIDs(), "sleep", 0, happinessLoss, healthGain, energyGain)
 	}
 
 	ch := make(map[string][2]int)
@@ -406,10 +493,12 @@ func (p *Pet) Rest() ActionResult {
 	p.LastRestedAt = time.Now()
 	p.TotalInteractions++
 	p.trackTimeOfDay()
+	p.recordCareDay(time.Now(), ch)
 	return ActionResult{
 		OK:                true,
 		Message:           "休息一下～",
 		Changes:           ch,
+		Crises:            p.resolveCrises("rest", ch),
 		Animation:         AnimSleeping,
 		AnimationDuration: 2 * time.Second,
 	}
@@ -418,6 +507,11 @@ func (p *Pet) Rest() ActionResult {
 // Heal treats the pet, recovering health but costing energy.
 // Dynamic cooldown based on urgency. Prerequisite: energy >= cost. Diminishing returns on health gain.
 func (p *Pet) Heal() ActionResult {
+	return p.heal(nil)
+}
+
+// heal implements Heal, adding the effects of item if it is not nil.
+func (p *Pet) heal(item *plugin.Item) ActionResult {
 	if !p.Alive {
 		return failResultWithType(ErrDead, "宠物已经不在了...")
 	}
@@ -448,11 +542,13 @@ func (p *Pet) Heal() ActionResult {
 	p.Energy = clamp(p.Energy+energyLoss, 0, 100) // energyLoss is negative
 	ch["health"] = [2]int{oldH, p.Health}
 	ch["energy"] = [2]int{oldE, p.Energy}
-	p.AccHealth += p.addEvolutionPoints(1, "health")
+	p.AddCustomAcc(AccHealth, p.addEvolutionPoints(1, "health"))
 	p.LastHealedAt = time.Now()
 	p.TotalInteractions++
 	p.trackTimeOfDay()
-	return ActionResult{OK: true, Message: "治疗完成！", Changes: ch}
+	p.recordCareDay(time.Now(), ch)
+	p.applyItem(item, ch)
+	return ActionResult{OK: true, Message: "治疗完成！", Changes: ch, Crises: p.resolveCrises("heal", ch)}
 }
 
 // MoodScore calculates the composite mood score (0-100).
@@ -465,7 +561,11 @@ func (p *Pet) MoodScore() int {
 }
 
 // MoodName returns a human-readable mood string.
+// A species custom_mood script may override it.
 func (p *Pet) MoodName() string {
+	if mood := p.scriptMood(); mood != "" {
+		return mood
+	}
 	score := p.MoodScore()
 	switch {
 	case score > 80:
@@ -481,9 +581,18 @@ func (p *Pet) MoodName() string {
 	}
 }
 
+// Age returns how long the pet has lived. A dead pet stops aging when it
+// died.
+func (p *Pet) Age() time.Duration {
+	if !p.Alive && !p.DiedAt.IsZero() {
+		return p.DiedAt.Sub(p.Birthday)
+	}
+	return time.Since(p.Birthday)
+}
+
 // AgeHours returns the pet's age in hours.
 func (p *Pet) AgeHours() float64 {
-	return time.Since(p.Birthday).Hours()
+	return p.Age().Hours()
 }
 
 // IsAlive checks if the pet is still alive.
@@ -652,27 +761,13 @@ func (p *Pet) SetField(field string, raw string) (old string, err error) {
 		p.GamesWon = v
 
 	// Evolution accumulators
-	case "acc_happiness":
-		old = strconv.Itoa(p.AccHappiness)
+	case AccHappiness, AccHealth, AccPlayful:
+		old = strconv.Itoa(p.GetCustomAcc(field))
 		v, e := strconv.Atoi(raw)
 		if e != nil {
 			return "", e
 		}
-		p.AccHappiness = v
-	case "acc_health":
-		old = strconv.Itoa(p.AccHealth)
-		v, e := strconv.Atoi(raw)
-		if e != nil {
-			return "", e
-		}
-		p.AccHealth = v
-	case "acc_playful":
-		old = strconv.Itoa(p.AccPlayful)
-		v, e := strconv.Atoi(raw)
-		if e != nil {
-			return "", e
-		}
-		p.AccPlayful = v
+		p.SetAttr(field, v)
 	case "night_interactions", "night":
 		old = strconv.Itoa(p.NightInteractions)
 		v, e := strconv.Atoi(raw)
@@ -750,6 +845,8 @@ func (p *Pet) GetAttr(name string) int {
 		return p.Health
 	case "energy":
 		return p.Energy
+	case "coins":
+		return p.Coins
 	default:
 		// Check custom attributes
 		if p.CustomAttributes != nil {
@@ -791,19 +888,21 @@ func (p *Pet) UpdateFeedRegularity() {
 
 // AccumulateOfflineTime calculates the time elapsed since LastCheckedAt
 // and accumulates it to AccumulatedOfflineDuration for later application.
-// This should be called when loading a pet from a save file.
-func (p *Pet) AccumulateOfflineTime() {
+// This should be called when loading a pet from a save file. It reports
+// whether the pet changed and needs saving.
+func (p *Pet) AccumulateOfflineTime() bool {
 	if !p.Alive {
-		return
+		return false
 	}
 	elapsed := time.Since(p.LastCheckedAt)
 	if elapsed < time.Minute {
-		return
+		return false
 	}
 	// Accumulate offline time for later application (when TUI starts)
 	p.AccumulatedOfflineDuration += elapsed
 	// Update LastCheckedAt to now so we don't double-count this time
 	p.LastCheckedAt = time.Now()
+	return true
 }
 
 // MarkAsChecked updates LastCheckedAt to current time.
@@ -814,7 +913,7 @@ func (p *Pet) MarkAsChecked() {
 }
 
 // UseSkill uses an active skill/ability.
-// The skill must be defined in the species traits with type="active".
+// The skill must be one of the pet's unlocked traits with type="active".
 // Returns an ActionResult indicating success or failure.
 func (p *Pet) UseSkill(skillID string) ActionResult {
 	if !p.Alive {
@@ -827,7 +926,7 @@ func (p *Pet) UseSkill(skillID string) ActionResult {
 	}
 
 	trait, exists := p.capabilitiesReg.GetTrait(p.Species, skillID)
-	if !exists {
+	if !exists || !slices.Contains(p.UnlockedTraitIDs(), skillID) {
 		return failResultWithType(ErrSkillUnknown, "未知技能")
 	}
 
@@ -871,6 +970,7 @@ func (p *Pet) UseSkill(skillID string) ActionResult {
 		OK:                true,
 		Message:           fmt.Sprintf("使用技能「%s」！", trait.Name),
 		Changes:           ch,
+		Crises:            p.resolveCrises("skill:"+skillID, ch),
 		Animation:         AnimHappy,
 		AnimationDuration: 2 * time.Second,
 	}
@@ -884,7 +984,7 @@ func (p *Pet) addEvolutionPoints(basePoints int, interactionType string) int {
 		return basePoints
 	}
 
-	modifier := p.capabilitiesReg.GetEvolutionModifier(p.Species)
+	modifier := p.capabilitiesReg.GetEvolutionModifier(p.Species, p.UnlockedTraitIDs())
 	if modifier == nil {
 		return basePoints
 	}
@@ -1065,6 +1165,7 @@ func (p *Pet) UpdateCooldowns(elapsed time.Duration) {
 	p.LastHealedAt = p.LastHealedAt.Add(-elapsed)
 	p.LastTalkedAt = p.LastTalkedAt.Add(-elapsed)
 	p.LastAdventureAt = p.LastAdventureAt.Add(-elapsed)
+	p.rewindAdventureTimes(elapsed)
 	p.LastSkillUsedAt = p.LastSkillUsedAt.Add(-elapsed)
 }
 
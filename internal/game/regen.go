@@ -0,0 +1,100 @@
+package game
+
+import (
+	"clipet/internal/expr"
+	"clipet/internal/game/capabilities"
+	"clipet/internal/plugin"
+	"fmt"
+)
+
+// BaseHealthRegenPerHour is the passive health regeneration per hour at a
+// health_regen_multiplier of 1.
+const BaseHealthRegenPerHour = 1.0
+
+// HealthRegenMultiplier evaluates the health_regen_multiplier expressions
+// of the given passive traits for the pet and sums them. The result is
+// clamped to [0, MaxAttributeMultiplier] of the registry's constraints;
+// invalid expressions count as 0.
+func (p *Pet) HealthRegenMultiplier(traits []capabilities.PersonalityTrait) float64 {
+	total := 0.0
+	for _, trait := range traits {
+		if trait.Type != "passive" || trait.PassiveEffect == nil || trait.PassiveEffect.HealthRegenMultiplier == "" {
+			continue
+		}
+		e, err := expr.ParseNumber(trait.PassiveEffect.HealthRegenMultiplier)
+		if err != nil {
+			continue
+		}
+		if v, err := e.Number(p.ExprVars()); err == nil {
+			total += v
+		}
+	}
+	maxMult := p.constraints().MaxAttributeMultiplier
+	if total < 0 {
+		return 0
+	}
+	if total > maxMult {
+		return maxMult
+	}
+	return total
+}
+
+// applyHealthRegen adds passive health regeneration for the given hours and
+// returns the points gained. Regeneration is reduced by the species'
+// health_recovery_penalty while health is below health_crit_threshold.
+// Fractions of a point carry over to the next call, so frequent short
+// advances regenerate as much as one long one.
+//
+// It runs only from AttrDecayHook, the time pipeline shared by offline
+// settlement, the daemon and the TUI tick.
+func (p *Pet) applyHealthRegen(hours float64, traits []capabilities.PersonalityTrait,
+	interactionConfig capabilities.AttributeInteractionConfig) int {
+	mult := p.HealthRegenMultiplier(traits)
+	if mult <= 0 || p.Health >= 100 {
+		p.HealthRegenCarry = 0
+		return 0
+	}
+	regen := BaseHealthRegenPerHour * mult * hours
+	if p.Health < interactionConfig.HealthCritThreshold {
+		regen *= interactionConfig.HealthRecoveryPenalty
+	}
+	regen += p.HealthRegenCarry
+	points := int(regen + 1e-9) // 60 × 1/60 must make a whole point
+	p.HealthRegenCarry = max(regen-float64(points), 0)
+
+	old := p.Health
+	p.Health = clamp(p.Health+points, 0, 100)
+	gain := p.Health - old
+	p.healthRegained += gain
+	return gain
+}
+
+// TakeHealthRegen returns the health regenerated by time advances since the
+// last call and resets it.
+func (p *Pet) TakeHealthRegen() int {
+	gain := p.healthRegained
+	p.healthRegained = 0
+	return gain
+}
+
+// ReportHealthRegen adds regenerated health to the last settlement round,
+// which is where time hooks apply it after ApplyMultiStageDecay.
+func ReportHealthRegen(results []DecayRoundResult, gain int) {
+	if gain <= 0 || len(results) == 0 {
+		return
+	}
+	last := &results[len(results)-1]
+	last.EndAttrs[2] = clamp(last.EndAttrs[2]+gain, 0, 100)
+	last.Effects = append(last.Effects, fmt.Sprintf("✨ 被动回复：健康 +%d", gain))
+}
+
+// speciesTraits returns the traits declared by a species pack.
+func speciesTraits(reg *plugin.Registry, species string) []capabilities.PersonalityTrait {
+	if reg == nil {
+		return nil
+	}
+	if pack := reg.GetSpecies(species); pack != nil {
+		return pack.Traits
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package game
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"clipet/internal/plugin"
+)
+
+// regenCat returns an adult cat of the builtin pack, old enough for its
+// arcane_mending trait (health_regen_multiplier = "arcane_affinity * 0.02")
+// to unlock.
+func regenCat(reg *plugin.Registry, affinity int) *Pet {
+	pet := NewPet("Mochi", "cat", "egg", 100, 100, 50, 100, reg)
+	pet.Stage, pet.StageID = StageAdult, "adult_arcane_crystal"
+	pet.AddCustomAcc("arcane_affinity", affinity)
+	return pet
+}
+
+func TestHealthRegenMultiplier(t *testing.T) {
+	reg := builtinRegistry(t)
+	traits := speciesTraits(reg, "cat")
+
+	tests := []struct {
+		affinity int
+		want     float64
+	}{
+		{0, 0},
+		{50, 1},
+		{100, 2},
+		{1000, 3}, // clamped to MaxAttributeMultiplier
+	}
+	for _, tt := range tests {
+		if got := regenCat(reg, tt.affinity).HealthRegenMultiplier(traits); got != tt.want {
+			t.Errorf("affinity %d: multiplier = %v, want %v", tt.affinity, got, tt.want)
+		}
+	}
+}
+
+func TestAttrDecayHookHealthRegen(t *testing.T) {
+	reg := builtinRegistry(t)
+	hook := NewAttrDecayHook(reg)
+
+	pet := regenCat(reg, 100)
+	hook.OnTimeAdvance(time.Hour, pet)
+	if pet.Health != 52 {
+		t.Errorf("health after 1h at affinity 100 = %d, want 52", pet.Health)
+	}
+
+	plain := regenCat(reg, 0)
+	hook.OnTimeAdvance(time.Hour, plain)
+	if plain.Health != 50 {
+		t.Errorf("health after 1h without affinity = %d, want 50", plain.Health)
+	}
+}
+
+func TestHealthRegenCarry(t *testing.T) {
+	reg := builtinRegistry(t)
+	hook := NewAttrDecayHook(reg)
+
+	// 2 points per hour: a minute at a time still adds up
+	pet := regenCat(reg, 100)
+	for range 60 {
+		hook.OnTimeAdvance(time.Minute, pet)
+	}
+	if pet.Health != 52 {
+		t.Errorf("health after 60 one-minute ticks = %d, want 52", pet.Health)
+	}
+	if gain := pet.TakeHealthRegen(); gain != 2 {
+		t.Errorf("TakeHealthRegen = %d, want 2", gain)
+	}
+	if gain := pet.TakeHealthRegen(); gain != 0 {
+		t.Errorf("TakeHealthRegen after taking = %d, want 0", gain)
+	}
+}
+
+func TestSettlementHealthRegen(t *testing.T) {
+	reg := builtinRegistry(t)
+	hook := NewAttrDecayHook(reg)
+
+	// Multi-stage decay leaves regeneration to the time hook
+	pet := regenCat(reg, 100)
+	plain := regenCat(reg, 0)
+	results := pet.ApplyMultiStageDecay(6 * time.Hour)
+	plain.ApplyMultiStageDecay(6 * time.Hour)
+	if pet.Health != plain.Health {
+		t.Fatalf("multi-stage decay regenerated: health %d, want %d", pet.Health, plain.Health)
+	}
+
+	before := pet.Health
+	hook.OnTimeAdvance(6*time.Hour, pet)
+	gain := pet.TakeHealthRegen()
+	if gain != 12 || pet.Health-before < 0 {
+		t.Fatalf("regen over 6h at affinity 100 = %d, want 12", gain)
+	}
+	ReportHealthRegen(results, gain)
+	last := results[len(results)-1]
+	found := false
+	for _, effect := range last.Effects {
+		found = found || strings.Contains(effect, "+12")
+	}
+	if !found {
+		t.Errorf("round effects %v do not report the regeneration", last.Effects)
+	}
+}
+
+func TestHealthRegenCriticalPenalty(t *testing.T) {
+	reg := builtinRegistry(t)
+	cfg := reg.GetAttributeInteractionConfig("cat")
+	traits := speciesTraits(reg, "cat")
+
+	pet := regenCat(reg, 100)
+	pet.Health = cfg.HealthCritThreshold - 1
+	gain := pet.applyHealthRegen(10, traits, cfg)
+	if want := int(20 * cfg.HealthRecoveryPenalty); gain != want {
+		t.Errorf("regen below crit threshold = %d, want %d", gain, want)
+	}
+}
+
+func TestValidateHealthRegenExpr(t *testing.T) {
+	reg := builtinRegistry(t)
+	pack := *reg.GetSpecies("cat")
+	pack.Traits = append(pack.Traits[:0:0], pack.Traits...)
+	for i := range pack.Traits {
+		if pack.Traits[i].PassiveEffect != nil && pack.Traits[i].PassiveEffect.HealthRegenMultiplier != "" {
+			effect := *pack.Traits[i].PassiveEffect
+			effect.HealthRegenMultiplier = "arcane_affinity >"
+			pack.Traits[i].PassiveEffect = &effect
+		}
+	}
+
+	errs, _ := plugin.Validate(&pack)
+	for _, e := range errs {
+		if strings.HasSuffix(e.Field, "passive_effect.health_regen_multiplier") {
+			return
+		}
+	}
+	t.Error("invalid health_regen_multiplier expression not reported")
+}
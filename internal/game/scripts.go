@@ -0,0 +1,89 @@
+package game
+
+import (
+	"clipet/internal/script"
+	"maps"
+	"time"
+)
+
+// scriptProgram returns the species' compiled script for hook, if any.
+func (p *Pet) scriptProgram(hook string) *script.Program {
+	if p.registry == nil {
+		return nil
+	}
+	return p.registry.GetScript(p.Species, hook)
+}
+
+// ScriptView returns the read-only pet state passed to hook scripts.
+func (p *Pet) ScriptView() script.PetView {
+	return script.PetView{
+		Name:         p.Name,
+		Species:      p.Species,
+		StageID:      p.StageID,
+		Phase:        string(p.Stage),
+		Alive:        p.Alive,
+		Hunger:       p.Hunger,
+		Happiness:    p.Happiness,
+		Health:       p.Health,
+		Energy:       p.Energy,
+		MoodScore:    p.MoodScore(),
+		AgeHours:     p.AgeHours(),
+		Hour:         time.Now().Hour(),
+		Interactions: p.TotalInteractions,
+		GamesWon:     p.GamesWon,
+		Adventures:   p.AdventuresCompleted,
+		Dialogues:    p.DialogueCount,
+		Custom:       maps.Clone(p.CustomAttributes),
+	}
+}
+
+// scriptMood runs the custom_mood script. It returns "" when there is no
+// script, the script returns None or it fails.
+func (p *Pet) scriptMood() string {
+	prog := p.scriptProgram(script.HookCustomMood)
+	if prog == nil {
+		return ""
+	}
+	mood, err := prog.Mood(p.ScriptView())
+	if err != nil {
+		return ""
+	}
+	return mood
+}
+
+// scriptEffects runs an effects hook and returns the requested attribute
+// deltas. A failing script contributes no effects, and scripts cannot
+// change coins: they run on every evolution and adventure and would mint
+// currency.
+func (p *Pet) scriptEffects(hook string, event map[string]any) script.Effects {
+	prog := p.scriptProgram(hook)
+	if prog == nil {
+		return nil
+	}
+	effects, err := prog.Effects(p.ScriptView(), event)
+	if err != nil {
+		return nil
+	}
+	delete(effects, "coins")
+	return effects
+}
+
+// applyEffects adds attribute deltas to the pet, clamping core attributes.
+func (p *Pet) applyEffects(effects map[string]int) {
+	for attr, delta := range effects {
+		switch attr {
+		case "hunger":
+			p.Hunger = clamp(p.Hunger+delta, 0, 100)
+		case "happiness":
+			p.Happiness = clamp(p.Happiness+delta, 0, 100)
+		case "health":
+			p.Health = clamp(p.Health+delta, 0, 100)
+		case "energy":
+			p.Energy = clamp(p.Energy+delta, 0, 100)
+		case "coins":
+			p.Coins = max(p.Coins+delta, 0)
+		default:
+			p.AddCustomAcc(attr, delta)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package game
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"clipet/internal/plugin"
+)
+
+// scriptedRegistry loads a pack with all three hook scripts through the
+// regular parser, as an external pack would be.
+func scriptedRegistry(t *testing.T) *plugin.Registry {
+	t.Helper()
+	reg, _ := testRegistry(t, `
+[[stages]]
+id = "baby_fire"
+phase = "baby"
+
+[scripts]
+custom_mood = "scripts/mood.star"
+on_evolve = "scripts/evolve.star"
+on_adventure = "scripts/adventure.star"
+`, map[string]string{
+		"scripts/mood.star": `
+def custom_mood(pet):
+    if pet.custom.get("fire_points", 0) >= 5:
+        return "blazing"
+    return None
+`,
+		"scripts/evolve.star": `
+def on_evolve(pet, evolution):
+    if evolution.to == "baby_fire":
+        change("fire_points", 5)
+        change("happiness", 200 // 4)
+        for _ in range(50):
+            change("coins", 100)
+`,
+		"scripts/adventure.star": `
+def on_adventure(pet, outcome):
+    if outcome.effects.get("happiness", 0) > 0:
+        change("fire_points", 1)
+`,
+	})
+	return reg
+}
+
+func TestScripts_MoodEvolveAdventure(t *testing.T) {
+	reg := scriptedRegistry(t)
+	pet := testPet(reg)
+	pet.Stage, pet.StageID = StageEgg, "egg"
+	pet.Hunger, pet.Happiness, pet.Health, pet.Energy = 80, 40, 80, 80
+
+	if mood := pet.MoodName(); mood != "unhappy" && mood != "normal" {
+		t.Fatalf("MoodName without fire points = %q, want the default mood", mood)
+	}
+
+	DoEvolve(pet, EvolveCandidate{ToStage: plugin.Stage{ID: "baby_fire", Phase: "baby"}})
+	if pet.GetCustomAcc("fire_points") != 5 || pet.Happiness != 90 {
+		t.Errorf("after on_evolve fire_points=%d happiness=%d, want 5 and 90",
+			pet.GetCustomAcc("fire_points"), pet.Happiness)
+	}
+	if pet.Coins != 0 || pet.GetCustomAcc("coins") != 0 {
+		t.Errorf("on_evolve minted coins: %d (custom %d)", pet.Coins, pet.GetCustomAcc("coins"))
+	}
+	if mood := pet.MoodName(); mood != "blazing" {
+		t.Errorf("MoodName = %q, want blazing from custom_mood", mood)
+	}
+
+	changes := ApplyAdventureOutcome(pet, plugin.AdventureOutcome{Effects: map[string]int{"happiness": 5}})
+	if got := changes["fire_points"]; got != [2]int{5, 6} {
+		t.Errorf("on_adventure change = %v, want [5 6] (changes %v)", got, changes)
+	}
+}
+
+func TestScripts_CompileErrorFailsLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"species.toml": {Data: []byte(`
+[species]
+id = "ember"
+name = "Ember"
+version = "1.0.0"
+
+[scripts]
+custom_mood = "mood.star"
+`)},
+		"mood.star": {Data: []byte("def custom_mood(pet)\n    return None\n")},
+	}
+	if _, err := plugin.ParsePack(fsys, "."); err == nil {
+		t.Error("ParsePack should fail on a script syntax error")
+	}
+}
@@ -0,0 +1,87 @@
+package game
+
+import (
+	"clipet/internal/plugin"
+	"fmt"
+	"time"
+)
+
+// Coins awarded for a care streak: StreakCoinsPerDay per day in a row,
+// capped at MaxStreakBonusDays days.
+const (
+	StreakCoinsPerDay  = 5
+	MaxStreakBonusDays = 7
+)
+
+// StreakCoins returns the coins awarded on the first care action of a day
+// that continues a streak of the given length.
+func StreakCoins(streak int) int {
+	return StreakCoinsPerDay * min(max(streak, 0), MaxStreakBonusDays)
+}
+
+// AddCoins changes the coin balance by delta (never below zero) and records
+// the change in changes like any other attribute.
+func (p *Pet) AddCoins(delta int, changes map[string][2]int) {
+	if delta == 0 {
+		return
+	}
+	p.applyTrackedEffects(map[string]int{"coins": delta}, changes)
+}
+
+// recordCareDay updates the daily care streak for a care action at now. The
+// first care action of a day extends the streak if the pet was cared for
+// yesterday (and restarts it otherwise), and awards StreakCoins.
+func (p *Pet) recordCareDay(now time.Time, changes map[string][2]int) {
+	today := now.Format(time.DateOnly)
+	if p.LastCareDay == today {
+		return
+	}
+	if p.LastCareDay == now.AddDate(0, 0, -1).Format(time.DateOnly) {
+		p.CareStreak++
+	} else {
+		p.CareStreak = 1
+	}
+	p.LastCareDay = today
+	p.AddCoins(StreakCoins(p.CareStreak), changes)
+}
+
+// ShopItems returns the items of the pet's species that are sold in the
+// shop (price above zero), in pack order.
+func (p *Pet) ShopItems() []plugin.Item {
+	if p.registry == nil {
+		return nil
+	}
+	var items []plugin.Item
+	for _, item := range p.registry.GetItems(p.Species) {
+		if item.Price > 0 {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// BuyItem buys one item from the shop and puts it into the inventory. The
+// coins spent are reported in the result's changes.
+func (p *Pet) BuyItem(itemID string) ActionResult {
+	if !p.Alive {
+		return failResultWithType(ErrDead, "宠物已经不在了...")
+	}
+	var item *plugin.Item
+	if p.registry != nil {
+		item = p.registry.GetItem(p.Species, itemID)
+	}
+	if item == nil || item.Price <= 0 {
+		return failResultWithType(ErrNotForSale, "商店里没有这个物品")
+	}
+	if p.Coins < item.Price {
+		return failResultWithType(ErrNoCoins, fmt.Sprintf("金币不足，还差 %d", item.Price-p.Coins))
+	}
+	if p.ItemCount(itemID) >= p.stackLimit(itemID) {
+		return failResultWithType(ErrInventoryFull, "背包里放不下了")
+	}
+
+	ch := make(map[string][2]int)
+	p.AddItem(itemID, 1)
+	p.AddCoins(-item.Price, ch)
+	return ActionResult{OK: true, Message: "购买了" + item.Name, Changes: ch}
+}
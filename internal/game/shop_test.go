@@ -0,0 +1,97 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuyItem(t *testing.T) {
+	pet := itemPet(itemRegistry(t))
+	pet.Coins = 60
+
+	if res := pet.BuyItem("ball"); res.ErrorType != ErrNotForSale {
+		t.Errorf("buying an unpriced item = %q, want %q", res.ErrorType, ErrNotForSale)
+	}
+
+	res := pet.BuyItem("fish")
+	if !res.OK {
+		t.Fatalf("BuyItem failed: %s", res.Message)
+	}
+	if ch := res.Changes["coins"]; ch != [2]int{60, 50} {
+		t.Errorf("coins change = %v, want [60 50]", ch)
+	}
+	if n := pet.ItemCount("fish"); n != 1 {
+		t.Errorf("%d fish after buying, want 1", n)
+	}
+
+	if !pet.BuyItem("bow").OK {
+		t.Fatal("could not buy the bow")
+	}
+	if res := pet.BuyItem("bow"); res.ErrorType != ErrNoCoins {
+		t.Errorf("buying without coins = %q, want %q", res.ErrorType, ErrNoCoins)
+	}
+	pet.Coins = 100
+	if res := pet.BuyItem("bow"); res.ErrorType != ErrInventoryFull {
+		t.Errorf("buying a second accessory = %q, want %q", res.ErrorType, ErrInventoryFull)
+	}
+	if pet.AddItem("bow", 1) != 0 {
+		t.Error("accessory stacked past one")
+	}
+}
+
+func TestCareStreak(t *testing.T) {
+	pet := itemPet(itemRegistry(t))
+	day := time.Date(2025, 3, 1, 9, 0, 0, 0, time.Local)
+	ch := make(map[string][2]int)
+
+	pet.recordCareDay(day, ch)
+	pet.recordCareDay(day.Add(8*time.Hour), ch)
+	if pet.CareStreak != 1 || pet.Coins != StreakCoins(1) {
+		t.Fatalf("first day: streak %d, coins %d", pet.CareStreak, pet.Coins)
+	}
+
+	for i := 1; i < 10; i++ {
+		pet.recordCareDay(day.AddDate(0, 0, i), ch)
+	}
+	if pet.CareStreak != 10 {
+		t.Errorf("streak after 10 days = %d, want 10", pet.CareStreak)
+	}
+	if got := StreakCoins(pet.CareStreak); got != StreakCoinsPerDay*MaxStreakBonusDays {
+		t.Errorf("StreakCoins(10) = %d, want the cap", got)
+	}
+	if ch["coins"][1] != pet.Coins {
+		t.Errorf("changes %v do not end at the balance %d", ch["coins"], pet.Coins)
+	}
+
+	// A missed day restarts the streak
+	pet.recordCareDay(day.AddDate(0, 0, 11), ch)
+	if pet.CareStreak != 1 {
+		t.Errorf("streak after a missed day = %d, want 1", pet.CareStreak)
+	}
+}
+
+func TestCareActionAwardsStreakCoins(t *testing.T) {
+	pet := itemPet(itemRegistry(t))
+
+	res := pet.Feed()
+	if !res.OK {
+		t.Fatalf("Feed failed: %s", res.Message)
+	}
+	if ch := res.Changes["coins"]; ch != [2]int{0, StreakCoins(1)} {
+		t.Errorf("coins change = %v, want the first streak day", ch)
+	}
+}
+
+func TestAdventureCoins(t *testing.T) {
+	reg, capReg := itemRegistry(t)
+	pet := itemPet(reg, capReg)
+	pet.Coins = 3
+
+	changes := ApplyAdventureOutcome(pet, reg.GetSpecies(testSpecies).Adventures[0].Choices[0].Outcomes[0])
+	if ch := changes["coins"]; ch != [2]int{3, 10} {
+		t.Errorf("coins change = %v, want [3 10]", ch)
+	}
+	if !pet.EvalCondition("coins >= 10") {
+		t.Error("coins not visible to expressions")
+	}
+}
@@ -15,6 +15,7 @@ type TimeHook interface {
 type TimeHookPriority int
 
 const (
+	PriorityObserver TimeHookPriority = 0   // 通知等只读取结果的模块
 	PriorityLow      TimeHookPriority = 10  // 统计、日志等
 	PriorityNormal   TimeHookPriority = 50  // 常规逻辑
 	PriorityHigh     TimeHookPriority = 80  // 核心逻辑（属性衰减）
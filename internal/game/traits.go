@@ -0,0 +1,125 @@
+package game
+
+import (
+	"clipet/internal/game/capabilities"
+	"clipet/internal/plugin"
+	"math/rand"
+	"slices"
+)
+
+// TraitIDs returns the IDs of the pet's traits. Pets without their own
+// trait set have every trait of their species.
+func (p *Pet) TraitIDs() []string {
+	if p.Traits != nil || p.registry == nil {
+		return p.Traits
+	}
+	return p.registry.GetTraitIDs(p.Species)
+}
+
+// HasTrait reports whether the pet has the trait with the given ID.
+func (p *Pet) HasTrait(id string) bool {
+	return slices.Contains(p.TraitIDs(), id)
+}
+
+// UnlockedTraitIDs returns the IDs of the pet's traits that take effect in
+// its current phase, in pack order. Traits with an unlock_phase the pet has
+// not grown to yet are skipped.
+func (p *Pet) UnlockedTraitIDs() []string {
+	traits := p.unlockedTraits()
+	ids := make([]string, len(traits))
+	for i, t := range traits {
+		ids[i] = t.ID
+	}
+	return ids
+}
+
+// TraitUnlocked reports whether the pet has grown to the trait's unlock phase.
+func (p *Pet) TraitUnlocked(trait capabilities.PersonalityTrait) bool {
+	return plugin.PhaseReached(string(p.Stage), trait.UnlockPhase)
+}
+
+// unlockedTraits returns the definitions of the pet's unlocked traits.
+func (p *Pet) unlockedTraits() []capabilities.PersonalityTrait {
+	own := p.TraitIDs()
+	var traits []capabilities.PersonalityTrait
+	for _, trait := range speciesTraits(p.registry, p.Species) {
+		if slices.Contains(own, trait.ID) && p.TraitUnlocked(trait) {
+			traits = append(traits, trait)
+		}
+	}
+	return traits
+}
+
+// RollTraits returns the trait set of a newly created pet of a species. With
+// a [trait_pool] the pet draws Rolls traits by weight, at most one of each
+// group; without one it gets every trait the species defines.
+func RollTraits(reg *plugin.Registry, species string) []string {
+	traits := speciesTraits(reg, species)
+	pool := reg.GetTraitPool(species)
+	if pool == nil {
+		ids := make([]string, len(traits))
+		for i, t := range traits {
+			ids[i] = t.ID
+		}
+		return ids
+	}
+	weights := make([]int, len(traits))
+	for i, t := range traits {
+		weights[i] = t.RollWeight()
+	}
+	return drawTraits(traits, weights, pool.Rolls)
+}
+
+// drawTraits draws up to n of traits without replacement, weighted by
+// weights. Once a trait of a group is drawn, the other traits of the group
+// are no longer candidates. The result is in the order of traits and never
+// nil. weights is consumed.
+func drawTraits(traits []capabilities.PersonalityTrait, weights []int, n int) []string {
+	drawn := []string{}
+	for len(drawn) < n {
+		total := 0
+		for _, w := range weights {
+			total += w
+		}
+		if total <= 0 {
+			break
+		}
+		roll := rand.Intn(total)
+		for i, w := range weights {
+			if roll < w {
+				drawn = append(drawn, traits[i].ID)
+				excludeGroup(traits, weights, i)
+				break
+			}
+			roll -= w
+		}
+	}
+
+	return inPackOrder(traits, drawn)
+}
+
+// inPackOrder returns the IDs of traits that are in ids, in the order of
+// traits, for stable display. The result is never nil.
+func inPackOrder(traits []capabilities.PersonalityTrait, ids []string) []string {
+	ordered := make([]string, 0, len(ids))
+	for _, t := range traits {
+		if slices.Contains(ids, t.ID) {
+			ordered = append(ordered, t.ID)
+		}
+	}
+	return ordered
+}
+
+// excludeGroup zeroes the weight of traits[i] and of every trait sharing its
+// group.
+func excludeGroup(traits []capabilities.PersonalityTrait, weights []int, i int) {
+	weights[i] = 0
+	if traits[i].Group == "" {
+		return
+	}
+	for j, t := range traits {
+		if t.Group == traits[i].Group {
+			weights[j] = 0
+		}
+	}
+}
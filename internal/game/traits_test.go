@@ -0,0 +1,163 @@
+package game
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"clipet/internal/game/capabilities"
+	"clipet/internal/plugin"
+)
+
+// traitRegistry loads a species whose traits roll from a trait pool: three
+// mutually exclusive temperaments, a feeding bonus and a skill and an
+// evolution modifier that unlock once the pet is an adult.
+func traitRegistry(t *testing.T) (*plugin.Registry, *capabilities.Registry) {
+	t.Helper()
+	return testRegistry(t, `
+[trait_pool]
+rolls = 5
+
+[[traits]]
+id = "bold"
+type = "passive"
+group = "temper"
+weight = 5
+
+[[traits]]
+id = "shy"
+type = "passive"
+group = "temper"
+
+[[traits]]
+id = "calm"
+type = "passive"
+group = "temper"
+
+[[traits]]
+id = "glutton"
+type = "passive"
+[traits.passive_effect]
+feed_hunger_bonus = 0.5
+
+[[traits]]
+id = "howl"
+type = "active"
+unlock_phase = "adult"
+[traits.active_effect]
+energy_cost = 5
+health_restore = 10
+
+[[traits]]
+id = "wise"
+type = "modifier"
+unlock_phase = "adult"
+[traits.evolution_modifier]
+feed_bonus = 2.0
+`, nil)
+}
+
+func traitPet(reg *plugin.Registry, capReg *capabilities.Registry, traits ...string) *Pet {
+	pet := testPet(reg)
+	pet.SetCapabilitiesRegistry(capReg)
+	pet.Traits = append([]string{}, traits...)
+	pet.LastFedAt, pet.LastSkillUsedAt = time.Time{}, time.Time{}
+	return pet
+}
+
+func TestRollTraits(t *testing.T) {
+	reg, _ := traitRegistry(t)
+	tempers := []string{"bold", "shy", "calm"}
+
+	for range 50 {
+		traits := RollTraits(reg, testSpecies)
+		// Five rolls, but only one temperament: four traits
+		if len(traits) != 4 {
+			t.Fatalf("RollTraits = %v, want 4 traits", traits)
+		}
+		n := 0
+		for _, id := range traits {
+			if slices.Contains(tempers, id) {
+				n++
+			}
+		}
+		if n != 1 {
+			t.Fatalf("RollTraits = %v, want exactly one temperament", traits)
+		}
+		if !slices.IsSortedFunc(traits, func(a, b string) int {
+			return slices.Index(reg.GetTraitIDs(testSpecies), a) - slices.Index(reg.GetTraitIDs(testSpecies), b)
+		}) {
+			t.Fatalf("RollTraits = %v, want pack order", traits)
+		}
+	}
+}
+
+func TestTraitEffectsFollowPet(t *testing.T) {
+	reg, capReg := traitRegistry(t)
+
+	plain := traitPet(reg, capReg)
+	glutton := traitPet(reg, capReg, "glutton")
+	plainRes, gluttonRes := plain.Feed(), glutton.Feed()
+	if !plainRes.OK || !gluttonRes.OK {
+		t.Fatalf("Feed failed: %s / %s", plainRes.Message, gluttonRes.Message)
+	}
+	if glutton.Hunger <= plain.Hunger {
+		t.Errorf("hunger after feeding = %d (glutton) vs %d, want the glutton's trait to apply", glutton.Hunger, plain.Hunger)
+	}
+
+	// Traits with an unlock phase only take effect once the pet grows to it
+	pet := traitPet(reg, capReg, "howl", "wise")
+	if ids := pet.UnlockedTraitIDs(); len(ids) != 0 {
+		t.Errorf("UnlockedTraitIDs of a baby = %v, want none", ids)
+	}
+	if got := pet.addEvolutionPoints(10, "feed"); got != 10 {
+		t.Errorf("baby evolution points = %d, want 10", got)
+	}
+	if res := pet.UseSkill("howl"); res.ErrorType != ErrSkillUnknown {
+		t.Errorf("locked skill: error = %q, want %q", res.ErrorType, ErrSkillUnknown)
+	}
+
+	pet.Stage, pet.StageID = StageAdult, "adult"
+	if got := pet.addEvolutionPoints(10, "feed"); got != 20 {
+		t.Errorf("adult evolution points = %d, want 20", got)
+	}
+	if res := pet.UseSkill("howl"); !res.OK {
+		t.Errorf("unlocked skill failed: %s", res.Message)
+	}
+	if res := glutton.UseSkill("howl"); res.ErrorType != ErrSkillUnknown {
+		t.Errorf("skill of another pet: error = %q, want %q", res.ErrorType, ErrSkillUnknown)
+	}
+}
+
+func TestInheritTraitsGroups(t *testing.T) {
+	reg, _ := traitRegistry(t)
+	pool := speciesTraits(reg, testSpecies)
+	rules := &plugin.Breeding{MaxTraits: 3, SharedWeight: 1, SingleWeight: 1, MutationChance: 1}
+
+	for range 50 {
+		traits := inheritTraits([]string{"bold", "glutton"}, []string{"shy"}, pool, rules)
+		if slices.Contains(traits, "bold") == slices.Contains(traits, "shy") || slices.Contains(traits, "calm") {
+			t.Fatalf("traits = %v, want exactly one temperament", traits)
+		}
+	}
+}
+
+func TestValidateTraitPool(t *testing.T) {
+	reg, _ := traitRegistry(t)
+	bad := *reg.GetSpecies(testSpecies)
+	bad.Traits = slices.Clone(bad.Traits)
+	bad.Traits[0].Weight = -1
+	bad.Traits[1].UnlockPhase = "elder"
+	bad.TraitPool = &plugin.TraitPool{Rolls: -1}
+
+	fields := map[string]bool{}
+	errs, _ := plugin.Validate(&bad)
+	for _, e := range errs {
+		fields[e.Field] = true
+	}
+	for _, want := range []string{"traits[0].weight", "traits[1].unlock_phase", "trait_pool.rolls"} {
+		if !fields[want] {
+			t.Errorf("Validate did not report %s (got %v)", want, fields)
+		}
+	}
+}
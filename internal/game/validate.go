@@ -0,0 +1,189 @@
+package game
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"clipet/internal/plugin"
+)
+
+// Issue kinds reported by ValidatePet.
+const (
+	IssueAttrRange      = "attr_range"      // core attribute outside 0-100
+	IssueNegativeCount  = "negative_count"  // statistic counter below zero
+	IssueFutureTime     = "future_time"     // timestamp later than now
+	IssueUnknownSpecies = "unknown_species" // species pack not installed
+	IssueUnknownStage   = "unknown_stage"   // stage ID not in the species pack
+	IssuePhaseMismatch  = "phase_mismatch"  // Stage does not match the stage's phase
+	IssueItemCount      = "item_count"      // inventory count outside 1-MaxItemStack
+	IssueNotOwned       = "not_owned"       // equipped accessory missing from the inventory
+)
+
+// PetIssue is one inconsistency found in a pet's saved state.
+type PetIssue struct {
+	Kind  string // one of the Issue* constants
+	Field string // JSON field name, e.g. "hunger"
+	Value string // offending value
+	Fix   string // value RepairPet sets, empty if it cannot be repaired
+}
+
+func (i PetIssue) String() string {
+	if i.Fix == "" {
+		return fmt.Sprintf("%s: %s = %s", i.Kind, i.Field, i.Value)
+	}
+	return fmt.Sprintf("%s: %s = %s (fix: %s)", i.Kind, i.Field, i.Value, i.Fix)
+}
+
+// ValidatePet checks the pet's attributes, counters, timestamps and stage
+// against the plugin registry. It does not modify the pet.
+func ValidatePet(p *Pet, reg *plugin.Registry) []PetIssue {
+	issues, _ := checkPet(p, reg, time.Now())
+	return issues
+}
+
+// RepairPet fixes every repairable issue reported by ValidatePet in place
+// and returns all issues found, including those it could not fix.
+func RepairPet(p *Pet, reg *plugin.Registry) []PetIssue {
+	issues, fixes := checkPet(p, reg, time.Now())
+	for _, fix := range fixes {
+		fix()
+	}
+	return issues
+}
+
+// checkPet collects issues together with the functions that repair them.
+func checkPet(p *Pet, reg *plugin.Registry, now time.Time) ([]PetIssue, []func()) {
+	var issues []PetIssue
+	var fixes []func()
+
+	for _, attr := range []struct {
+		name string
+		ptr  *int
+	}{
+		{"hunger", &p.Hunger},
+		{"happiness", &p.Happiness},
+		{"health", &p.Health},
+		{"energy", &p.Energy},
+	} {
+		if *attr.ptr < 0 || *attr.ptr > 100 {
+			fixed := Clamp(*attr.ptr, 0, 100)
+			issues = append(issues, PetIssue{IssueAttrRange, attr.name, fmt.Sprint(*attr.ptr), fmt.Sprint(fixed)})
+			ptr := attr.ptr
+			fixes = append(fixes, func() { *ptr = fixed })
+		}
+	}
+
+	for _, counter := range []struct {
+		name string
+		ptr  *int
+	}{
+		{"total_interactions", &p.TotalInteractions},
+		{"games_won", &p.GamesWon},
+		{"adventures_completed", &p.AdventuresCompleted},
+		{"dialogue_count", &p.DialogueCount},
+		{"night_interactions", &p.NightInteractions},
+		{"day_interactions", &p.DayInteractions},
+		{"feed_count", &p.FeedCount},
+		{"feed_expected_count", &p.FeedExpectedCount},
+	} {
+		if *counter.ptr < 0 {
+			issues = append(issues, PetIssue{IssueNegativeCount, counter.name, fmt.Sprint(*counter.ptr), "0"})
+			ptr := counter.ptr
+			fixes = append(fixes, func() { *ptr = 0 })
+		}
+	}
+
+	// A little slack for clock skew between machines sharing a save.
+	limit := now.Add(time.Minute)
+	for _, ts := range []struct {
+		name string
+		ptr  *time.Time
+	}{
+		{"birthday", &p.Birthday},
+		{"last_fed_at", &p.LastFedAt},
+		{"last_played_at", &p.LastPlayedAt},
+		{"last_rested_at", &p.LastRestedAt},
+		{"last_healed_at", &p.LastHealedAt},
+		{"last_talked_at", &p.LastTalkedAt},
+		{"last_checked_at", &p.LastCheckedAt},
+		{"last_adventure_at", &p.LastAdventureAt},
+		{"last_skill_used_at", &p.LastSkillUsedAt},
+	} {
+		if ts.ptr.After(limit) {
+			issues = append(issues, PetIssue{IssueFutureTime, ts.name, ts.ptr.Format(time.RFC3339), now.Format(time.RFC3339)})
+			ptr := ts.ptr
+			fixes = append(fixes, func() { *ptr = now })
+		}
+	}
+	for i := range p.AdventureTimes {
+		if ts := &p.AdventureTimes[i]; ts.After(limit) {
+			issues = append(issues, PetIssue{IssueFutureTime, fmt.Sprintf("adventure_times[%d]", i), ts.Format(time.RFC3339), now.Format(time.RFC3339)})
+			fixes = append(fixes, func() { *ts = now })
+		}
+	}
+	for _, id := range p.InventoryIDs() {
+		if n := p.Inventory[id]; n < 1 || n > MaxItemStack {
+			fixed := min(max(n, 0), MaxItemStack)
+			issues = append(issues, PetIssue{IssueItemCount, "inventory." + id, fmt.Sprint(n), fmt.Sprint(fixed)})
+			fixes = append(fixes, func() {
+				if fixed == 0 {
+					delete(p.Inventory, id)
+				} else {
+					p.Inventory[id] = fixed
+				}
+			})
+		}
+	}
+
+	var worn []string
+	for _, id := range p.Equipped {
+		if p.Inventory[id] >= 1 && !slices.Contains(worn, id) {
+			worn = append(worn, id)
+		}
+	}
+	if len(worn) != len(p.Equipped) {
+		issues = append(issues, PetIssue{IssueNotOwned, "equipped", fmt.Sprint(p.Equipped), fmt.Sprint(worn)})
+		fixes = append(fixes, func() { p.Equipped = worn })
+	}
+
+	if reg == nil {
+		return issues, fixes
+	}
+	if reg.GetSpecies(p.Species) == nil {
+		issues = append(issues, PetIssue{Kind: IssueUnknownSpecies, Field: "species", Value: p.Species})
+		return issues, fixes
+	}
+
+	stage := reg.GetStage(p.Species, p.StageID)
+	if stage == nil {
+		replacement := replacementStage(reg, p.Species, p.Stage)
+		issue := PetIssue{Kind: IssueUnknownStage, Field: "stage_id", Value: p.StageID}
+		if replacement != nil {
+			issue.Fix = replacement.ID
+			fixes = append(fixes, func() {
+				p.StageID = replacement.ID
+				p.Stage = PetStage(replacement.Phase)
+			})
+		}
+		return append(issues, issue), fixes
+	}
+
+	if stage.Phase != "" && PetStage(stage.Phase) != p.Stage {
+		issues = append(issues, PetIssue{IssuePhaseMismatch, "stage", string(p.Stage), stage.Phase})
+		fixes = append(fixes, func() { p.Stage = PetStage(stage.Phase) })
+	}
+	return issues, fixes
+}
+
+// replacementStage picks the stage a pet with an unknown stage ID is moved
+// to: the first stage of the same phase, or the species' egg stage.
+func replacementStage(reg *plugin.Registry, species string, phase PetStage) *plugin.Stage {
+	pack := reg.GetSpecies(species)
+	for i := range pack.Stages {
+		if PetStage(pack.Stages[i].Phase) == phase {
+			return &pack.Stages[i]
+		}
+	}
+	return reg.GetEggStage(species)
+}
@@ -0,0 +1,61 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"clipet/internal/plugin"
+)
+
+func TestRepairPet(t *testing.T) {
+	reg := plugin.NewRegistry()
+	reg.Register(&plugin.SpeciesPack{
+		Species: plugin.SpeciesConfig{ID: "test"},
+		Stages: []plugin.Stage{
+			{ID: "egg", Phase: "egg"},
+			{ID: "baby_a", Phase: "baby"},
+		},
+	})
+
+	pet := &Pet{
+		Species:   "test",
+		Stage:     StageBaby,
+		StageID:   "removed_stage",
+		Hunger:    9999,
+		Happiness: -5,
+		Health:    50,
+		Energy:    50,
+		FeedCount: -1,
+		LastFedAt: time.Now().Add(48 * time.Hour),
+		Inventory: map[string]int{"fish": 500, "yarn": -2},
+		Equipped:  []string{"fish", "yarn"},
+	}
+
+	issues := ValidatePet(pet, reg)
+	if len(issues) != 8 {
+		t.Fatalf("ValidatePet found %d issues, want 8: %v", len(issues), issues)
+	}
+	if pet.Hunger != 9999 {
+		t.Fatal("ValidatePet must not modify the pet")
+	}
+
+	RepairPet(pet, reg)
+	if pet.Hunger != 100 || pet.Happiness != 0 || pet.FeedCount != 0 {
+		t.Errorf("attributes not repaired: %+v", pet)
+	}
+	if pet.StageID != "baby_a" || pet.Stage != StageBaby {
+		t.Errorf("stage repaired to %s/%s, want baby_a/baby", pet.StageID, pet.Stage)
+	}
+	if pet.LastFedAt.After(time.Now()) {
+		t.Error("future timestamp not repaired")
+	}
+	if pet.ItemCount("fish") != MaxItemStack || pet.ItemCount("yarn") != 0 {
+		t.Errorf("inventory not repaired: %v", pet.Inventory)
+	}
+	if len(pet.Equipped) != 1 || pet.Equipped[0] != "fish" {
+		t.Errorf("equipped = %v, want only the owned fish", pet.Equipped)
+	}
+	if left := ValidatePet(pet, reg); len(left) != 0 {
+		t.Errorf("issues left after repair: %v", left)
+	}
+}
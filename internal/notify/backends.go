@@ -0,0 +1,107 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"syscall"
+	"time"
+)
+
+// execTimeout bounds how long a notification command may run.
+const execTimeout = 10 * time.Second
+
+// Bell rings the terminal bell.
+type Bell struct {
+	w io.Writer
+}
+
+// NewBell creates a bell notifier writing to w (os.Stderr if nil).
+func NewBell(w io.Writer) *Bell {
+	if w == nil {
+		w = os.Stderr
+	}
+	return &Bell{w: w}
+}
+
+func (b *Bell) Name() string { return "bell" }
+
+func (b *Bell) Notify(n Notification) error {
+	_, err := io.WriteString(b.w, "\a")
+	return err
+}
+
+// Exec runs a shell command for every notification. The notification is
+// passed as JSON on stdin and in CLIPET_* environment variables.
+type Exec struct {
+	command string
+}
+
+// NewExec creates a notifier running command through the system shell.
+func NewExec(command string) *Exec {
+	return &Exec{command: command}
+}
+
+func (e *Exec) Name() string { return "exec" }
+
+func (e *Exec) Notify(n Notification) error {
+	data, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), execTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", e.command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", e.command)
+	}
+	cmd.Env = append(os.Environ(),
+		"CLIPET_CONDITION="+string(n.Condition),
+		"CLIPET_PET="+n.Pet,
+		"CLIPET_SPECIES="+n.Species,
+		"CLIPET_MESSAGE="+n.Message,
+	)
+	cmd.Stdin = bytes.NewReader(data)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("run %q: %w: %s", e.command, err, out)
+	}
+	return nil
+}
+
+// File appends one JSON line per notification to a file or FIFO. A FIFO
+// without a reader fails immediately instead of blocking the caller.
+type File struct {
+	path string
+}
+
+// NewFile creates a notifier appending to path.
+func NewFile(path string) *File {
+	return &File{path: path}
+}
+
+func (f *File) Name() string { return "file" }
+
+func (f *File) Notify(n Notification) error {
+	data, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE|syscall.O_NONBLOCK, 0o644)
+	if err != nil {
+		return fmt.Errorf("open %s: %w", f.path, err)
+	}
+	defer file.Close()
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("write %s: %w", f.path, err)
+	}
+	return nil
+}
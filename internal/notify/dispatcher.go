@@ -0,0 +1,297 @@
+package notify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultRateLimit is the minimum gap between two notifications for the
+// same condition of the same pet.
+const DefaultRateLimit = time.Hour
+
+// queueSize bounds the notifications waiting for delivery. Update drops
+// notifications that do not fit rather than wait for slow notifiers.
+const queueSize = 16
+
+// QuietHours is a daily local time range during which nothing is sent.
+// The range may wrap around midnight. The zero value disables it.
+type QuietHours struct {
+	Start time.Duration // offset from midnight
+	End   time.Duration // offset from midnight
+}
+
+// ParseQuietHours parses a range like "22:00-07:00". An empty string
+// disables quiet hours.
+func ParseQuietHours(s string) (QuietHours, error) {
+	if s == "" {
+		return QuietHours{}, nil
+	}
+	from, to, ok := strings.Cut(s, "-")
+	if !ok {
+		return QuietHours{}, fmt.Errorf("quiet hours %q: want HH:MM-HH:MM", s)
+	}
+	start, err := parseClock(strings.TrimSpace(from))
+	if err != nil {
+		return QuietHours{}, fmt.Errorf("quiet hours %q: %w", s, err)
+	}
+	end, err := parseClock(strings.TrimSpace(to))
+	if err != nil {
+		return QuietHours{}, fmt.Errorf("quiet hours %q: %w", s, err)
+	}
+	return QuietHours{Start: start, End: end}, nil
+}
+
+// parseClock parses HH:MM into an offset from midnight.
+func parseClock(s string) (time.Duration, error) {
+	hh, mm, ok := strings.Cut(s, ":")
+	if !ok {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	h, err := strconv.Atoi(hh)
+	if err != nil || h < 0 || h > 23 {
+		return 0, fmt.Errorf("invalid hour in %q", s)
+	}
+	m, err := strconv.Atoi(mm)
+	if err != nil || m < 0 || m > 59 {
+		return 0, fmt.Errorf("invalid minute in %q", s)
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
+
+// Contains reports whether t falls inside the quiet hours.
+func (q QuietHours) Contains(t time.Time) bool {
+	if q.Start == q.End {
+		return false
+	}
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	off := t.Sub(midnight)
+	if q.Start < q.End {
+		return off >= q.Start && off < q.End
+	}
+	return off >= q.Start || off < q.End
+}
+
+// Options configures a Dispatcher.
+type Options struct {
+	Notifiers []Notifier
+	// RateLimit is the minimum gap per pet and condition (DefaultRateLimit
+	// if zero).
+	RateLimit time.Duration
+	Quiet     QuietHours
+	// StatePath, if set, returns the file that persists a pet's condition
+	// state so that short-lived processes do not repeat notifications.
+	// An empty path keeps that pet's state in memory only.
+	StatePath func(pet string) string
+	// Logger receives delivery errors (discarded if nil).
+	Logger *log.Logger
+}
+
+// conditionState tracks one condition between updates.
+type conditionState struct {
+	Active   bool      `json:"active"`
+	LastSent time.Time `json:"last_sent,omitzero"`
+}
+
+// petState is the condition state of one pet.
+type petState map[Condition]*conditionState
+
+// delivery is a queued notification, or a flush marker when flushed is set.
+type delivery struct {
+	n       Notification
+	flushed chan struct{}
+}
+
+// Dispatcher decides which notifications to send and delivers them.
+// State is kept per pet, so the pets of a household notify independently.
+// Notifiers run on the dispatcher's own goroutine, so a slow command never
+// holds up the time step that raised the notification.
+type Dispatcher struct {
+	opts  Options
+	queue chan delivery
+
+	mu    sync.Mutex
+	state map[string]petState // by pet key, loaded on first use
+}
+
+// New creates a dispatcher and starts its delivery goroutine. Saved state is
+// loaded from opts.StatePath the first time a pet is updated.
+func New(opts Options) *Dispatcher {
+	if opts.RateLimit <= 0 {
+		opts.RateLimit = DefaultRateLimit
+	}
+	if opts.Logger == nil {
+		opts.Logger = log.New(io.Discard, "", 0)
+	}
+	d := &Dispatcher{opts: opts, queue: make(chan delivery, queueSize), state: map[string]petState{}}
+	go d.run()
+	return d
+}
+
+// Flush waits up to timeout for the notifications queued so far to be
+// delivered and reports whether they were. Short-lived processes call it
+// before exiting.
+func (d *Dispatcher) Flush(timeout time.Duration) bool {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	flushed := make(chan struct{})
+	select {
+	case d.queue <- delivery{flushed: flushed}:
+	case <-timer.C:
+		return false
+	}
+	select {
+	case <-flushed:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
+
+// Update takes a notification for every condition that currently holds for
+// pet, a key that identifies it (such as its household slot), and queues
+// those whose condition was not active before for delivery. Conditions missing from
+// current are cleared so they notify again next time they hold.
+//
+// During quiet hours a new condition is held back and sent on the first
+// update after they end, if it still holds. Within the rate limit it is
+// dropped until the condition clears and holds again.
+func (d *Dispatcher) Update(now time.Time, pet string, current []Notification) []Notification {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	state := d.petState(pet)
+	holding := make(map[Condition]bool, len(current))
+	changed := false
+	var sent []Notification
+
+	for _, n := range current {
+		holding[n.Condition] = true
+		st := state[n.Condition]
+		if st == nil {
+			st = &conditionState{}
+			state[n.Condition] = st
+		}
+		if st.Active || d.opts.Quiet.Contains(now) {
+			continue
+		}
+		st.Active = true
+		changed = true
+		if !st.LastSent.IsZero() && now.Sub(st.LastSent) < d.opts.RateLimit {
+			continue
+		}
+		st.LastSent = now
+		n.Time = now
+		d.enqueue(n)
+		sent = append(sent, n)
+	}
+
+	for cond, st := range state {
+		if st.Active && !holding[cond] {
+			st.Active = false
+			changed = true
+		}
+	}
+
+	if changed {
+		d.save(pet)
+	}
+	return sent
+}
+
+// petState returns the state of pet, loading it on first use.
+func (d *Dispatcher) petState(pet string) petState {
+	state, ok := d.state[pet]
+	if !ok {
+		state = d.load(pet)
+		d.state[pet] = state
+	}
+	return state
+}
+
+// enqueue hands n to the delivery goroutine without waiting. A full queue
+// means the notifiers are stuck; n is dropped and logged.
+func (d *Dispatcher) enqueue(n Notification) {
+	select {
+	case d.queue <- delivery{n: n}:
+	default:
+		d.opts.Logger.Printf("notify: delivery queue full, dropped %s for %s", n.Condition, n.Pet)
+	}
+}
+
+// run delivers queued notifications in order.
+func (d *Dispatcher) run() {
+	for item := range d.queue {
+		if item.flushed != nil {
+			close(item.flushed)
+			continue
+		}
+		d.deliver(item.n)
+	}
+}
+
+// deliver sends n to every notifier, logging failures.
+func (d *Dispatcher) deliver(n Notification) {
+	for _, nt := range d.opts.Notifiers {
+		if err := nt.Notify(n); err != nil {
+			d.opts.Logger.Printf("notify %s: %v", nt.Name(), err)
+		}
+	}
+}
+
+// statePath returns the state file of pet, or "" if it is not persisted.
+func (d *Dispatcher) statePath(pet string) string {
+	if d.opts.StatePath == nil {
+		return ""
+	}
+	return d.opts.StatePath(pet)
+}
+
+// load reads the saved state of pet; a missing or unreadable file starts
+// fresh.
+func (d *Dispatcher) load(pet string) petState {
+	state := petState{}
+	path := d.statePath(pet)
+	if path == "" {
+		return state
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			d.opts.Logger.Printf("read notify state: %v", err)
+		}
+		return state
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		d.opts.Logger.Printf("decode notify state: %v", err)
+		return petState{}
+	}
+	return state
+}
+
+// save writes the state of pet atomically.
+func (d *Dispatcher) save(pet string) {
+	path := d.statePath(pet)
+	if path == "" {
+		return
+	}
+	data, err := json.Marshal(d.state[pet])
+	if err != nil {
+		return
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		d.opts.Logger.Printf("write notify state: %v", err)
+		return
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		d.opts.Logger.Printf("write notify state: %v", err)
+	}
+}
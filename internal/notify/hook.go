@@ -0,0 +1,146 @@
+package notify
+
+import (
+	"clipet/internal/config"
+	"clipet/internal/game"
+	"clipet/internal/game/capabilities"
+	"clipet/internal/plugin"
+	"fmt"
+	"log"
+	"time"
+)
+
+// MessageFunc returns the text shown for a condition.
+type MessageFunc func(c Condition, pet *game.Pet) string
+
+// KeyFunc returns the key that identifies a pet's condition state in the
+// Dispatcher, such as its household slot.
+type KeyFunc func(pet *game.Pet) string
+
+// Hook is a game.TimeHook that reports the pet's attention conditions to
+// a Dispatcher after every time step.
+type Hook struct {
+	dispatcher *Dispatcher
+	registry   *plugin.Registry
+	lifecycle  *game.LifecycleManager
+	key        KeyFunc
+	message    MessageFunc
+}
+
+// NewHook creates a notification hook. key may be nil to tell pets apart by
+// name, message may be nil for plain English messages.
+func NewHook(d *Dispatcher, registry *plugin.Registry, key KeyFunc, message MessageFunc) *Hook {
+	if key == nil {
+		key = func(pet *game.Pet) string { return pet.Name }
+	}
+	if message == nil {
+		message = defaultMessage
+	}
+	h := &Hook{dispatcher: d, registry: registry, key: key, message: message}
+	if registry != nil {
+		h.lifecycle = game.NewLifecycleManager(registry)
+	}
+	return h
+}
+
+func (h *Hook) Name() string {
+	return "Notify"
+}
+
+func (h *Hook) OnTimeAdvance(elapsed time.Duration, pet *game.Pet) {
+	if !pet.Alive {
+		// Clear the conditions so a successor in the same slot starts fresh
+		h.dispatcher.Update(time.Now(), h.key(pet), nil)
+		return
+	}
+	conds := Check(pet, h.registry, h.lifecycle)
+	current := make([]Notification, 0, len(conds))
+	for _, c := range conds {
+		current = append(current, Notification{
+			Condition: c,
+			Pet:       pet.Name,
+			Species:   pet.Species,
+			Message:   h.message(c, pet),
+		})
+	}
+	h.dispatcher.Update(time.Now(), h.key(pet), current)
+}
+
+// Check returns the attention conditions that hold for pet. lifecycle may
+// be nil to skip the end-of-life check.
+func Check(pet *game.Pet, registry *plugin.Registry, lifecycle *game.LifecycleManager) []Condition {
+	ic := capabilities.AttributeInteractionConfig{}.Defaults()
+	if registry != nil {
+		ic = registry.GetAttributeInteractionConfig(pet.Species)
+	}
+
+	var conds []Condition
+	if pet.Hunger < ic.HungerHealthThreshold {
+		conds = append(conds, ConditionHungry)
+	}
+	if pet.Health < ic.HealthCritThreshold {
+		conds = append(conds, ConditionSick)
+	}
+	if pet.Energy < ic.EnergyLowThreshold {
+		conds = append(conds, ConditionTired)
+	}
+	if lifecycle != nil && lifecycle.CheckLifecycle(pet).NearEnd {
+		conds = append(conds, ConditionNearEnd)
+	}
+	return conds
+}
+
+// defaultMessage is the untranslated message for a condition.
+func defaultMessage(c Condition, pet *game.Pet) string {
+	switch c {
+	case ConditionHungry:
+		return pet.Name + " is hungry"
+	case ConditionSick:
+		return pet.Name + " is not feeling well"
+	case ConditionTired:
+		return pet.Name + " is exhausted"
+	case ConditionNearEnd:
+		return pet.Name + " is nearing the end of its life"
+	}
+	return pet.Name + " needs attention"
+}
+
+// FromConfig builds a dispatcher from the notify section of the config.
+// statePath returns the state file of a pet (see Options.StatePath).
+// It returns nil when no backend is configured.
+func FromConfig(c config.NotifyConfig, statePath func(pet string) string, logger *log.Logger) (*Dispatcher, error) {
+	var notifiers []Notifier
+	if c.Bell {
+		notifiers = append(notifiers, NewBell(nil))
+	}
+	if c.Exec != "" {
+		notifiers = append(notifiers, NewExec(c.Exec))
+	}
+	if c.File != "" {
+		notifiers = append(notifiers, NewFile(c.File))
+	}
+	if len(notifiers) == 0 {
+		return nil, nil
+	}
+
+	var rate time.Duration
+	if c.RateLimit != "" {
+		d, err := time.ParseDuration(c.RateLimit)
+		if err != nil {
+			return nil, fmt.Errorf("notify rate_limit: %w", err)
+		}
+		rate = d
+	}
+	quiet, err := ParseQuietHours(c.QuietHours)
+	if err != nil {
+		return nil, fmt.Errorf("notify %w", err)
+	}
+
+	return New(Options{
+		Notifiers: notifiers,
+		RateLimit: rate,
+		Quiet:     quiet,
+		StatePath: statePath,
+		Logger:    logger,
+	}), nil
+}
@@ -0,0 +1,45 @@
+// Package notify alerts the owner when the pet needs attention.
+//
+// A Hook runs at the end of the time-hook pipeline and reports which
+// attention conditions hold for the pet. The Dispatcher sends a
+// notification when a condition starts to hold, subject to quiet hours and
+// a per-condition rate limit, and fans it out to the configured Notifiers
+// (terminal bell, command, file or FIFO).
+package notify
+
+import "time"
+
+// StateFileName is the dispatcher state kept in the profile directory.
+const StateFileName = "notify.json"
+
+// Condition is a reason to notify the owner.
+type Condition string
+
+// Attention conditions. The attribute thresholds come from the species'
+// AttributeInteractionConfig.
+const (
+	ConditionHungry  Condition = "hungry"   // hunger below hunger_health_threshold
+	ConditionSick    Condition = "sick"     // health below health_crit_threshold
+	ConditionTired   Condition = "tired"    // energy below energy_low_threshold
+	ConditionNearEnd Condition = "near_end" // lifecycle warning threshold reached
+)
+
+// Conditions lists all conditions in a stable order.
+var Conditions = []Condition{ConditionHungry, ConditionSick, ConditionTired, ConditionNearEnd}
+
+// Notification is one alert handed to the notifiers.
+type Notification struct {
+	Condition Condition `json:"condition"`
+	Pet       string    `json:"pet"`
+	Species   string    `json:"species"`
+	Message   string    `json:"message"`
+	Time      time.Time `json:"time"`
+}
+
+// Notifier delivers notifications to the owner.
+type Notifier interface {
+	// Name identifies the backend in error messages.
+	Name() string
+	// Notify delivers one notification.
+	Notify(n Notification) error
+}
@@ -0,0 +1,223 @@
+package notify
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"clipet/internal/game"
+)
+
+// recorder is a Notifier that keeps what it receives.
+type recorder struct {
+	got []Notification
+}
+
+func (r *recorder) Name() string { return "recorder" }
+
+func (r *recorder) Notify(n Notification) error {
+	r.got = append(r.got, n)
+	return nil
+}
+
+func hungry() []Notification {
+	return []Notification{{Condition: ConditionHungry, Pet: "Mochi"}}
+}
+
+func TestParseQuietHours(t *testing.T) {
+	q, err := ParseQuietHours("22:00-07:30")
+	if err != nil {
+		t.Fatalf("ParseQuietHours: %v", err)
+	}
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.Local)
+	for _, tc := range []struct {
+		at   time.Duration
+		want bool
+	}{
+		{23 * time.Hour, true},
+		{2 * time.Hour, true},
+		{7*time.Hour + 29*time.Minute, true},
+		{7*time.Hour + 30*time.Minute, false},
+		{12 * time.Hour, false},
+		{22 * time.Hour, true},
+	} {
+		if got := q.Contains(day.Add(tc.at)); got != tc.want {
+			t.Errorf("Contains(%v) = %v, want %v", tc.at, got, tc.want)
+		}
+	}
+
+	for _, bad := range []string{"22:00", "25:00-07:00", "22:00-07:61", "ab-cd"} {
+		if _, err := ParseQuietHours(bad); err == nil {
+			t.Errorf("ParseQuietHours(%q) should fail", bad)
+		}
+	}
+	if q, err := ParseQuietHours(""); err != nil || q.Contains(day) {
+		t.Errorf("empty quiet hours = %+v, %v; want disabled", q, err)
+	}
+}
+
+func TestDispatcher_NotifiesOnCrossingWithRateLimit(t *testing.T) {
+	rec := &recorder{}
+	d := New(Options{Notifiers: []Notifier{rec}, RateLimit: time.Hour})
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.Local)
+
+	if sent := d.Update(now, "mochi", hungry()); len(sent) != 1 {
+		t.Fatalf("first crossing sent %d notifications, want 1", len(sent))
+	}
+	if sent := d.Update(now.Add(time.Minute), "mochi", hungry()); len(sent) != 0 {
+		t.Errorf("condition still active, sent %d, want 0", len(sent))
+	}
+
+	// Cleared and crossed again within the rate limit: dropped.
+	d.Update(now.Add(2*time.Minute), "mochi", nil)
+	if sent := d.Update(now.Add(3*time.Minute), "mochi", hungry()); len(sent) != 0 {
+		t.Errorf("rate limited crossing sent %d, want 0", len(sent))
+	}
+
+	// After the rate limit a new crossing notifies again.
+	d.Update(now.Add(2*time.Hour), "mochi", nil)
+	if sent := d.Update(now.Add(2*time.Hour+time.Minute), "mochi", hungry()); len(sent) != 1 {
+		t.Errorf("crossing after rate limit sent %d, want 1", len(sent))
+	}
+	if !d.Flush(time.Second) {
+		t.Fatal("Flush timed out")
+	}
+	if len(rec.got) != 2 || rec.got[1].Time.IsZero() {
+		t.Errorf("recorder got %+v", rec.got)
+	}
+}
+
+func TestDispatcher_QuietHoursHoldBack(t *testing.T) {
+	rec := &recorder{}
+	d := New(Options{Notifiers: []Notifier{rec}, Quiet: QuietHours{Start: 22 * time.Hour, End: 7 * time.Hour}})
+	night := time.Date(2025, 3, 1, 23, 0, 0, 0, time.Local)
+
+	if sent := d.Update(night, "mochi", hungry()); len(sent) != 0 {
+		t.Fatalf("sent %d during quiet hours, want 0", len(sent))
+	}
+	morning := night.Add(9 * time.Hour)
+	if sent := d.Update(morning, "mochi", hungry()); len(sent) != 1 {
+		t.Errorf("held back condition sent %d after quiet hours, want 1", len(sent))
+	}
+}
+
+func TestDispatcher_PersistsState(t *testing.T) {
+	dir := t.TempDir()
+	statePath := func(pet string) string { return filepath.Join(dir, pet+"-"+StateFileName) }
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.Local)
+
+	first := &recorder{}
+	d := New(Options{Notifiers: []Notifier{first}, StatePath: statePath})
+	d.Update(now, "mochi", hungry())
+	d.Flush(time.Second)
+
+	// A later process sees the condition is already active.
+	second := &recorder{}
+	d = New(Options{Notifiers: []Notifier{second}, StatePath: statePath})
+	d.Update(now.Add(time.Minute), "mochi", hungry())
+	d.Flush(time.Second)
+
+	if len(first.got) != 1 || len(second.got) != 0 {
+		t.Errorf("first got %d, second got %d; want 1 and 0", len(first.got), len(second.got))
+	}
+	if _, err := os.Stat(statePath("mochi")); err != nil {
+		t.Errorf("state not saved in the pet's file: %v", err)
+	}
+}
+
+func TestDispatcher_StatePerPet(t *testing.T) {
+	rec := &recorder{}
+	d := New(Options{Notifiers: []Notifier{rec}})
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.Local)
+
+	d.Update(now, "mochi", hungry())
+	// A housemate that is fine must not clear Mochi's condition...
+	d.Update(now.Add(time.Minute), "kiki", nil)
+	if sent := d.Update(now.Add(2*time.Minute), "mochi", hungry()); len(sent) != 0 {
+		t.Errorf("mochi notified again after a housemate update, sent %d", len(sent))
+	}
+	// ...and a hungry housemate notifies on its own.
+	kiki := []Notification{{Condition: ConditionHungry, Pet: "Kiki"}}
+	if sent := d.Update(now.Add(3*time.Minute), "kiki", kiki); len(sent) != 1 {
+		t.Errorf("kiki sent %d, want 1", len(sent))
+	}
+}
+
+// blocker is a Notifier that waits until release is closed.
+type blocker struct {
+	release chan struct{}
+}
+
+func (b *blocker) Name() string { return "blocker" }
+
+func (b *blocker) Notify(n Notification) error {
+	<-b.release
+	return nil
+}
+
+func TestDispatcher_SlowNotifierDoesNotBlockUpdate(t *testing.T) {
+	slow := &blocker{release: make(chan struct{})}
+	d := New(Options{Notifiers: []Notifier{slow}})
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.Local)
+
+	// More crossings than the queue holds: extra ones are dropped, not waited for
+	start := time.Now()
+	for i := range queueSize + 2 {
+		d.Update(now, fmt.Sprintf("pet%d", i), hungry())
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Update blocked for %v behind a slow notifier", elapsed)
+	}
+	if d.Flush(10 * time.Millisecond) {
+		t.Error("Flush reported delivery while the notifier is stuck")
+	}
+	close(slow.release)
+	if !d.Flush(time.Second) {
+		t.Error("Flush timed out after the notifier recovered")
+	}
+}
+
+func TestFile_AppendsJSONLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "alerts.jsonl")
+	f := NewFile(path)
+	for _, c := range []Condition{ConditionHungry, ConditionTired} {
+		if err := f.Notify(Notification{Condition: c, Pet: "Mochi"}); err != nil {
+			t.Fatalf("Notify: %v", err)
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	var got []Condition
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var n Notification
+		if err := json.Unmarshal(scanner.Bytes(), &n); err != nil {
+			t.Fatalf("line %q: %v", scanner.Text(), err)
+		}
+		got = append(got, n.Condition)
+	}
+	if len(got) != 2 || got[0] != ConditionHungry || got[1] != ConditionTired {
+		t.Errorf("conditions = %v", got)
+	}
+}
+
+func TestCheck_UsesInteractionThresholds(t *testing.T) {
+	pet := &game.Pet{Name: "Mochi", Alive: true, Hunger: 10, Health: 50, Energy: 5}
+	got := Check(pet, nil, nil)
+	if len(got) != 2 || got[0] != ConditionHungry || got[1] != ConditionTired {
+		t.Errorf("Check = %v, want [hungry tired]", got)
+	}
+
+	pet.Hunger, pet.Energy, pet.Health = 20, 20, 19
+	if got := Check(pet, nil, nil); len(got) != 1 || got[0] != ConditionSick {
+		t.Errorf("Check at thresholds = %v, want [sick]", got)
+	}
+}
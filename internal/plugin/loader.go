@@ -3,6 +3,7 @@ package plugin
 import (
 	"fmt"
 	"io/fs"
+	"log"
 )
 
 // Loader handles discovering and loading species packs from a filesystem.
@@ -57,13 +58,15 @@ func (l *Loader) LoadAll(fsys fs.FS, root string, source PluginSource) ([]*Speci
 		pack.Source = source
 
 		// Validate
-		if errs := Validate(pack); len(errs) > 0 {
+		errs, warnings := Validate(pack)
+		if len(errs) > 0 {
 			var msg string
 			for _, e := range errs {
 				msg += "\n  - " + e.Error()
 			}
 			return nil, fmt.Errorf("validate pack %q:%s", entry.Name(), msg)
 		}
+		logWarnings(pack, warnings)
 
 		packs = append(packs, pack)
 	}
@@ -79,13 +82,22 @@ func (l *Loader) LoadOne(fsys fs.FS, dir string, source PluginSource) (*SpeciesP
 	}
 	pack.Source = source
 
-	if errs := Validate(pack); len(errs) > 0 {
+	errs, warnings := Validate(pack)
+	if len(errs) > 0 {
 		var msg string
 		for _, e := range errs {
 			msg += "\n  - " + e.Error()
 		}
 		return nil, fmt.Errorf("validate pack:%s", msg)
 	}
+	logWarnings(pack, warnings)
 
 	return pack, nil
 }
+
+// logWarnings logs validation warnings; the pack is still loaded.
+func logWarnings(pack *SpeciesPack, warnings []ValidationError) {
+	for _, w := range warnings {
+		log.Printf("[Plugin] Warning: species %q %s", pack.Species.ID, w.Error())
+	}
+}
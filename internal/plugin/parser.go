@@ -2,11 +2,14 @@ package plugin
 
 import (
 	"clipet/internal/game/capabilities"
+	"clipet/internal/script"
 	"encoding/json"
 	"fmt"
 	"io/fs"
 	"log"
+	"maps"
 	"path"
+	"slices"
 	"sort"
 	"strings"
 
@@ -43,6 +46,35 @@ func ParseSpecies(fsys fs.FS, dir string) (*SpeciesPack, error) {
 	return &pack, nil
 }
 
+// ParseScripts reads and compiles the hook scripts listed in [scripts].
+// A missing file or a script that does not compile is an error.
+func ParseScripts(fsys fs.FS, dir string, scripts *ScriptsConfig) error {
+	paths := scripts.Paths()
+	for _, hook := range slices.Sorted(maps.Keys(paths)) {
+		rel := paths[hook]
+		if rel == "" {
+			continue
+		}
+		filePath := path.Join(dir, rel)
+		if !fs.ValidPath(filePath) || (dir != "." && !strings.HasPrefix(filePath, dir+"/")) {
+			return fmt.Errorf("scripts.%s: path %q must stay inside the pack", hook, rel)
+		}
+		data, err := fs.ReadFile(fsys, filePath)
+		if err != nil {
+			return fmt.Errorf("read scripts.%s: %w", hook, err)
+		}
+		prog, err := script.Compile(rel, string(data), hook)
+		if err != nil {
+			return fmt.Errorf("compile scripts.%s: %w", hook, err)
+		}
+		if scripts.Programs == nil {
+			scripts.Programs = make(map[string]*script.Program)
+		}
+		scripts.Programs[hook] = prog
+	}
+	return nil
+}
+
 // ParseDialogues reads and decodes dialogues.toml from the given filesystem.
 // Returns nil (no error) if the file does not exist.
 func ParseDialogues(fsys fs.FS, dir string) ([]DialogueGroup, error) {
@@ -79,6 +111,60 @@ func ParseAdventures(fsys fs.FS, dir string) ([]Adventure, error) {
 	return af.Adventures, nil
 }
 
+// ParseCrises reads and decodes crises.toml from the given filesystem.
+// Returns nil (no error) if the file does not exist.
+func ParseCrises(fsys fs.FS, dir string) ([]Crisis, error) {
+	filePath := path.Join(dir, "crises.toml")
+	data, err := fs.ReadFile(fsys, filePath)
+	if err != nil {
+		// crises.toml is optional
+		return nil, nil
+	}
+
+	var cf CrisesFile
+	if err := toml.Unmarshal(data, &cf); err != nil {
+		return nil, fmt.Errorf("parse crises.toml: %w", err)
+	}
+
+	return cf.Crises, nil
+}
+
+// ParseItems reads and decodes items.toml from the given filesystem.
+// Returns nil (no error) if the file does not exist.
+func ParseItems(fsys fs.FS, dir string) ([]Item, error) {
+	filePath := path.Join(dir, "items.toml")
+	data, err := fs.ReadFile(fsys, filePath)
+	if err != nil {
+		// items.toml is optional
+		return nil, nil
+	}
+
+	var itf ItemsFile
+	if err := toml.Unmarshal(data, &itf); err != nil {
+		return nil, fmt.Errorf("parse items.toml: %w", err)
+	}
+
+	return itf.Items, nil
+}
+
+// ParseAchievements reads and decodes achievements.toml from the given
+// filesystem. Returns nil (no error) if the file does not exist.
+func ParseAchievements(fsys fs.FS, dir string) ([]Achievement, error) {
+	filePath := path.Join(dir, "achievements.toml")
+	data, err := fs.ReadFile(fsys, filePath)
+	if err != nil {
+		// achievements.toml is optional
+		return nil, nil
+	}
+
+	var af AchievementsFile
+	if err := toml.Unmarshal(data, &af); err != nil {
+		return nil, fmt.Errorf("parse achievements.toml: %w", err)
+	}
+
+	return af.Achievements, nil
+}
+
 // ParseLocale reads and decodes a locale JSON file from the given filesystem.
 // Returns nil (no error) if the file does not exist.
 func ParseLocale(fsys fs.FS, dir, lang string) (*Locale, error) {
@@ -107,8 +193,8 @@ func ParseLocale(fsys fs.FS, dir, lang string) (*Locale, error) {
 //  1. Multi-level subdirectory tree (recommended):
 //     frames/{phase}/{variant}/.../animState.txt
 //     Path components are joined with "_" to form the stageID.
-//     Example: frames/adult/arcane_shadow/idle.txt  →  stageID="adult_arcane_shadow"
-//              frames/egg/idle.txt                    →  stageID="egg"
+//     Examples: frames/adult/arcane_shadow/idle.txt → stageID="adult_arcane_shadow",
+//     frames/egg/idle.txt → stageID="egg"
 //
 //  2. Root sprite sheet: frames/{stageID}_{animState}.txt
 //
@@ -497,12 +583,34 @@ func ParsePackWithLocale(fsys fs.FS, dir, lang, fallbackLang string) (*SpeciesPa
 	}
 	pack.Adventures = adventures
 
+	crises, err := ParseCrises(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	pack.Crises = crises
+
+	items, err := ParseItems(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	pack.Items = items
+
+	achievements, err := ParseAchievements(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	pack.Achievements = achievements
+
 	frames, err := ParseFrames(fsys, dir)
 	if err != nil {
 		return nil, err
 	}
 	pack.Frames = frames
 
+	if err := ParseScripts(fsys, dir, &pack.Scripts); err != nil {
+		return nil, err
+	}
+
 	// Load locale if language is specified
 	if lang != "" {
 		locale, err := ParseLocale(fsys, dir, lang)
@@ -0,0 +1,67 @@
+package plugin
+
+import (
+	"fmt"
+
+	"clipet/internal/game/capabilities"
+)
+
+// boundedValue is a plugin-supplied multiplier that the constraints keep
+// within [min, max]. Zero means "not set" and is left to the defaults.
+type boundedValue struct {
+	field    string
+	val      *float64
+	min, max float64
+}
+
+// boundedValues lists every multiplier of the pack that the constraints bound.
+func boundedValues(pack *SpeciesPack, c capabilities.PluginConstraints) []boundedValue {
+	dcc := &pack.DynamicCooldown
+	values := []boundedValue{
+		{"dynamic_cooldown.low_urgency_multiplier", &dcc.LowUrgencyMultiplier, c.MinCooldownMultiplier, c.MaxCooldownMultiplier},
+		{"dynamic_cooldown.medium_urgency_multiplier", &dcc.MediumUrgencyMultiplier, c.MinCooldownMultiplier, c.MaxCooldownMultiplier},
+		{"dynamic_cooldown.high_urgency_multiplier", &dcc.HighUrgencyMultiplier, c.MinCooldownMultiplier, c.MaxCooldownMultiplier},
+	}
+	for i := range pack.Traits {
+		mod := pack.Traits[i].EvolutionModifier
+		if mod == nil {
+			continue
+		}
+		prefix := fmt.Sprintf("traits[%d].evolution_modifier.", i)
+		for _, v := range []struct {
+			name string
+			val  *float64
+		}{
+			{"night_interaction_bonus", &mod.NightInteractionBonus},
+			{"day_interaction_bonus", &mod.DayInteractionBonus},
+			{"feed_bonus", &mod.FeedBonus},
+			{"play_bonus", &mod.PlayBonus},
+			{"adventure_bonus", &mod.AdventureBonus},
+		} {
+			values = append(values, boundedValue{prefix + v.name, v.val, c.MinAttributeMultiplier, c.MaxAttributeMultiplier})
+		}
+	}
+	return values
+}
+
+// constraintWarnings reports the multipliers that applyConstraints will clamp.
+func constraintWarnings(pack *SpeciesPack, c capabilities.PluginConstraints) []ValidationError {
+	var warnings []ValidationError
+	for _, v := range boundedValues(pack, c) {
+		if *v.val != 0 && (*v.val < v.min || *v.val > v.max) {
+			warnings = append(warnings, ValidationError{v.field,
+				fmt.Sprintf("%.2f is outside [%.2f, %.2f], clamped to %.2f", *v.val, v.min, v.max, min(max(*v.val, v.min), v.max))})
+		}
+	}
+	return warnings
+}
+
+// applyConstraints clamps the pack's multipliers to the constraints. It runs
+// when a pack is registered, so every lookup sees values within bounds.
+func applyConstraints(pack *SpeciesPack, c capabilities.PluginConstraints) {
+	for _, v := range boundedValues(pack, c) {
+		if *v.val != 0 {
+			*v.val = min(max(*v.val, v.min), v.max)
+		}
+	}
+}
@@ -2,9 +2,11 @@ package plugin
 
 import (
 	"clipet/internal/game/capabilities"
+	"clipet/internal/script"
 	"fmt"
 	"io/fs"
 	"math/rand"
+	"sort"
 	"strings"
 	"sync"
 )
@@ -12,11 +14,13 @@ import (
 // Registry is the central store for all loaded species packs.
 // Both builtin and external packs are registered through the same interface.
 type Registry struct {
-	mu     sync.RWMutex
-	packs  map[string]*SpeciesPack // keyed by species ID
-	loader *Loader
-	lang   string // current language for locale loading
-	fallbackLang string // fallback language
+	mu           sync.RWMutex
+	packs        map[string]*SpeciesPack // keyed by species ID
+	loader       *Loader
+	lang         string                         // current language for locale loading
+	fallbackLang string                         // fallback language
+	constraints  capabilities.PluginConstraints // safety bounds applied to every pack
+	achievements []Achievement                  // built-in achievements, shared by all species
 }
 
 // NewRegistry creates a new empty Registry.
@@ -26,9 +30,16 @@ func NewRegistry() *Registry {
 		loader:       NewLoader(),
 		lang:         "zh-CN", // default language
 		fallbackLang: "en-US",
+		constraints:  capabilities.DefaultConstraints(),
 	}
 }
 
+// Constraints returns the safety constraints packs are held to, both when
+// they are registered and at runtime (adventure and crisis frequency).
+func (r *Registry) Constraints() capabilities.PluginConstraints {
+	return r.constraints
+}
+
 // SetLanguage sets the active language for locale loading.
 // Must be called before LoadFromFS to take effect.
 func (r *Registry) SetLanguage(lang, fallback string) {
@@ -55,6 +66,7 @@ func (r *Registry) LoadFromFS(fsys fs.FS, root string, source PluginSource) erro
 	defer r.mu.Unlock()
 
 	for _, pack := range packs {
+		applyConstraints(pack, r.constraints)
 		r.packs[pack.Species.ID] = pack
 	}
 
@@ -62,9 +74,11 @@ func (r *Registry) LoadFromFS(fsys fs.FS, root string, source PluginSource) erro
 }
 
 // Register adds or replaces a single species pack in the registry.
+// The pack's multipliers are clamped to the registry's constraints.
 func (r *Registry) Register(pack *SpeciesPack) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	applyConstraints(pack, r.constraints)
 	r.packs[pack.Species.ID] = pack
 }
 
@@ -348,6 +362,112 @@ func (r *Registry) GetAdventures(speciesID, stageID string) []Adventure {
 	return result
 }
 
+// GetCrises returns the crises a pet of the given stage can fall into.
+// Uses locale if available, falls back to inline TOML texts.
+func (r *Registry) GetCrises(speciesID, stageID string) []Crisis {
+	pack := r.GetSpecies(speciesID)
+	if pack == nil {
+		return nil
+	}
+
+	var result []Crisis
+	for _, c := range pack.Crises {
+		if len(c.Stage) == 0 {
+			if stage := r.GetStage(speciesID, stageID); stage == nil || stage.Phase == PhaseEgg {
+				continue
+			}
+		} else if !matchesStage(c.Stage, stageID) {
+			continue
+		}
+		result = append(result, localizeCrisis(pack, c))
+	}
+	return result
+}
+
+// GetCrisis returns a crisis definition by ID, or nil if not found.
+// Uses locale if available, falls back to inline TOML texts.
+func (r *Registry) GetCrisis(speciesID, crisisID string) *Crisis {
+	pack := r.GetSpecies(speciesID)
+	if pack == nil {
+		return nil
+	}
+	for _, c := range pack.Crises {
+		if c.ID == crisisID {
+			localized := localizeCrisis(pack, c)
+			return &localized
+		}
+	}
+	return nil
+}
+
+// localizeCrisis returns a copy of c with its texts taken from the pack
+// locale ("crises.<id>.name", ".description", ".resolve", ".fail").
+func localizeCrisis(pack *SpeciesPack, c Crisis) Crisis {
+	if pack.Locale == nil {
+		return c
+	}
+	key := "crises." + c.ID
+	if localized := getLocaleValue(pack.Locale.Data, key+".name"); localized != "" {
+		c.Name = localized
+	}
+	if localized := getLocaleValue(pack.Locale.Data, key+".description"); localized != "" {
+		c.Description = localized
+	}
+	if localized := getLocaleValue(pack.Locale.Data, key+".resolve"); localized != "" {
+		c.OnResolve.Text = localized
+	}
+	if localized := getLocaleValue(pack.Locale.Data, key+".fail"); localized != "" {
+		c.OnFail.Text = localized
+	}
+	return c
+}
+
+// GetItems returns the items defined by a species.
+// Uses locale if available, falls back to inline TOML texts.
+func (r *Registry) GetItems(speciesID string) []Item {
+	pack := r.GetSpecies(speciesID)
+	if pack == nil {
+		return nil
+	}
+	result := make([]Item, 0, len(pack.Items))
+	for _, item := range pack.Items {
+		result = append(result, localizeItem(pack, item))
+	}
+	return result
+}
+
+// GetItem returns an item definition by ID, or nil if not found.
+// Uses locale if available, falls back to inline TOML texts.
+func (r *Registry) GetItem(speciesID, itemID string) *Item {
+	pack := r.GetSpecies(speciesID)
+	if pack == nil {
+		return nil
+	}
+	for _, item := range pack.Items {
+		if item.ID == itemID {
+			localized := localizeItem(pack, item)
+			return &localized
+		}
+	}
+	return nil
+}
+
+// localizeItem returns a copy of item with its texts taken from the pack
+// locale ("items.<id>.name", ".description").
+func localizeItem(pack *SpeciesPack, item Item) Item {
+	if pack.Locale == nil {
+		return item
+	}
+	key := "items." + item.ID
+	if localized := getLocaleValue(pack.Locale.Data, key+".name"); localized != "" {
+		item.Name = localized
+	}
+	if localized := getLocaleValue(pack.Locale.Data, key+".description"); localized != "" {
+		item.Description = localized
+	}
+	return item
+}
+
 // GetBaseStats returns the base stats for a species.
 func (r *Registry) GetBaseStats(speciesID string) *BaseStats {
 	pack := r.GetSpecies(speciesID)
@@ -392,6 +512,51 @@ func (r *Registry) GetDynamicCooldownConfig(speciesID string) capabilities.Dynam
 	return pack.DynamicCooldown.Defaults()
 }
 
+// GetBreeding returns the breeding rules of a species with defaults filled
+// in, or nil if its pets cannot breed.
+func (r *Registry) GetBreeding(speciesID string) *Breeding {
+	pack := r.GetSpecies(speciesID)
+	if pack == nil || pack.Breeding == nil {
+		return nil
+	}
+	b := pack.Breeding.Defaults()
+	return &b
+}
+
+// GetTraitPool returns the trait pool of a species with defaults filled in,
+// or nil if every pet of the species has all its traits.
+func (r *Registry) GetTraitPool(speciesID string) *TraitPool {
+	pack := r.GetSpecies(speciesID)
+	if pack == nil || pack.TraitPool == nil {
+		return nil
+	}
+	tp := pack.TraitPool.Defaults()
+	return &tp
+}
+
+// GetTraitIDs returns the IDs of all traits of a species in pack order.
+func (r *Registry) GetTraitIDs(speciesID string) []string {
+	pack := r.GetSpecies(speciesID)
+	if pack == nil {
+		return nil
+	}
+	ids := make([]string, len(pack.Traits))
+	for i, t := range pack.Traits {
+		ids[i] = t.ID
+	}
+	return ids
+}
+
+// GetScript returns the compiled hook script of a species, or nil if the
+// pack does not define one.
+func (r *Registry) GetScript(speciesID, hook string) *script.Program {
+	pack := r.GetSpecies(speciesID)
+	if pack == nil {
+		return nil
+	}
+	return pack.Scripts.Programs[hook]
+}
+
 // GetAttributeInteractionConfig returns the attribute interaction configuration for a species.
 // Returns defaults if not configured.
 func (r *Registry) GetAttributeInteractionConfig(speciesID string) capabilities.AttributeInteractionConfig {
@@ -428,6 +593,95 @@ func (r *Registry) GetEndingMessage(speciesID, endingType string) string {
 	return ""
 }
 
+// GetEndingLegacy returns the attribute bonus a successor inherits from a
+// pet of the species that reached the ending, or nil if the species does
+// not define one.
+func (r *Registry) GetEndingLegacy(speciesID, endingType string) map[string]int {
+	pack := r.GetSpecies(speciesID)
+	if pack == nil {
+		return nil
+	}
+	for _, ending := range pack.Endings {
+		if ending.Type == endingType {
+			return ending.Legacy
+		}
+	}
+	return nil
+}
+
+// SetBuiltinAchievements sets the achievements every species can unlock.
+func (r *Registry) SetBuiltinAchievements(achievements []Achievement) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.achievements = achievements
+}
+
+// GetAchievements returns the achievements a pet of the species can
+// unlock: the built-in ones, then the species' own.
+// Species achievements use the pack locale if available.
+func (r *Registry) GetAchievements(speciesID string) []Achievement {
+	r.mu.RLock()
+	result := append([]Achievement(nil), r.achievements...)
+	r.mu.RUnlock()
+
+	if pack := r.GetSpecies(speciesID); pack != nil {
+		for _, a := range pack.Achievements {
+			result = append(result, localizeAchievement(pack, a))
+		}
+	}
+	return result
+}
+
+// ListAchievements returns every known achievement: the built-in ones,
+// then those of each species ordered by species ID.
+func (r *Registry) ListAchievements() []Achievement {
+	r.mu.RLock()
+	result := append([]Achievement(nil), r.achievements...)
+	ids := make([]string, 0, len(r.packs))
+	for id := range r.packs {
+		ids = append(ids, id)
+	}
+	r.mu.RUnlock()
+
+	sort.Strings(ids)
+	for _, id := range ids {
+		pack := r.GetSpecies(id)
+		for _, a := range pack.Achievements {
+			result = append(result, localizeAchievement(pack, a))
+		}
+	}
+	return result
+}
+
+// GetAchievement returns an achievement by key (see Achievement.Key), or
+// nil if not found.
+func (r *Registry) GetAchievement(key string) *Achievement {
+	for _, a := range r.ListAchievements() {
+		if a.Key() == key {
+			return &a
+		}
+	}
+	return nil
+}
+
+// localizeAchievement returns a copy of a species achievement with its
+// species set and its texts taken from the pack locale
+// ("achievements.<id>.name", ".description").
+func localizeAchievement(pack *SpeciesPack, a Achievement) Achievement {
+	a.Species = pack.Species.ID
+	if pack.Locale == nil {
+		return a
+	}
+	key := "achievements." + a.ID
+	if localized := getLocaleValue(pack.Locale.Data, key+".name"); localized != "" {
+		a.Name = localized
+	}
+	if localized := getLocaleValue(pack.Locale.Data, key+".description"); localized != "" {
+		a.Description = localized
+	}
+	return a
+}
+
 // Count returns the number of registered species.
 func (r *Registry) Count() int {
 	r.mu.RLock()
@@ -5,6 +5,7 @@ package plugin
 
 import (
 	"clipet/internal/game/capabilities"
+	"clipet/internal/script"
 	"time"
 )
 
@@ -20,28 +21,33 @@ const (
 // containing species definition, evolution tree, dialogues,
 // adventures, and ASCII art frames.
 type SpeciesPack struct {
-	Species       SpeciesConfig       `toml:"species"`
-	Lifecycle     capabilities.LifecycleConfig     `toml:"lifecycle"`     // Phase 2: lifecycle configuration
-	Decay         capabilities.DecayConfig         `toml:"decay"`         // Phase 7: attribute decay rates
-	DynamicCooldown capabilities.DynamicCooldownConfig `toml:"dynamic_cooldown"` // Phase 7: dynamic cooldown config
-	Interactions  capabilities.AttributeInteractionConfig `toml:"interactions"` // Multi-stage decay: attribute interactions
-	Stages        []Stage            `toml:"stages"`
-	Evolutions    []Evolution        `toml:"evolutions"`
-	Traits        []capabilities.PersonalityTrait `toml:"traits"` // Phase 1: personality traits
-	Endings       []capabilities.Ending `toml:"endings"` // Phase 2: possible endings
-	Actions       []ActionConfig     `toml:"actions"` // Phase 7: action configurations
-	Dialogues     []DialogueGroup    `toml:"-"` // loaded from dialogues.toml
-	Adventures    []Adventure        `toml:"-"` // loaded from adventures.toml
-	Frames        map[string]Frame   `toml:"-"` // loaded from frames/ directory
-	Scripts       ScriptsConfig      `toml:"scripts"`
-	Locale        *Locale            `toml:"-"` // loaded from locales/{lang}.json
-	Source        PluginSource       `toml:"-"`
+	Species         SpeciesConfig                           `toml:"species"`
+	Lifecycle       capabilities.LifecycleConfig            `toml:"lifecycle"`        // Phase 2: lifecycle configuration
+	Decay           capabilities.DecayConfig                `toml:"decay"`            // Phase 7: attribute decay rates
+	DynamicCooldown capabilities.DynamicCooldownConfig      `toml:"dynamic_cooldown"` // Phase 7: dynamic cooldown config
+	Interactions    capabilities.AttributeInteractionConfig `toml:"interactions"`     // Multi-stage decay: attribute interactions
+	Stages          []Stage                                 `toml:"stages"`
+	Evolutions      []Evolution                             `toml:"evolutions"`
+	Traits          []capabilities.PersonalityTrait         `toml:"traits"`     // Phase 1: personality traits
+	Endings         []capabilities.Ending                   `toml:"endings"`    // Phase 2: possible endings
+	Actions         []ActionConfig                          `toml:"actions"`    // Phase 7: action configurations
+	Breeding        *Breeding                               `toml:"breeding"`   // nil when pets of the species cannot breed
+	TraitPool       *TraitPool                              `toml:"trait_pool"` // nil when every pet has all traits of the species
+	Dialogues       []DialogueGroup                         `toml:"-"`          // loaded from dialogues.toml
+	Adventures      []Adventure                             `toml:"-"`          // loaded from adventures.toml
+	Crises          []Crisis                                `toml:"-"`          // loaded from crises.toml
+	Items           []Item                                  `toml:"-"`          // loaded from items.toml
+	Achievements    []Achievement                           `toml:"-"`          // loaded from achievements.toml
+	Frames          map[string]Frame                        `toml:"-"`          // loaded from frames/ directory
+	Scripts         ScriptsConfig                           `toml:"scripts"`
+	Locale          *Locale                                 `toml:"-"` // loaded from locales/{lang}.json
+	Source          PluginSource                            `toml:"-"`
 }
 
 // Locale holds translations for a species pack.
 type Locale struct {
-	Language string                 `json:"-"`  // Language code (e.g., "zh-CN")
-	Data     map[string]interface{} `json:"-"`  // Raw translation data
+	Language string                 `json:"-"` // Language code (e.g., "zh-CN")
+	Data     map[string]interface{} `json:"-"` // Raw translation data
 }
 
 // SpeciesConfig holds the species metadata and base stats.
@@ -51,6 +57,7 @@ type SpeciesConfig struct {
 	Description string    `toml:"description"`
 	Author      string    `toml:"author"`
 	Version     string    `toml:"version"`
+	Emoji       string    `toml:"emoji"` // shown in shell prompt segments
 	BaseStats   BaseStats `toml:"base_stats"`
 }
 
@@ -83,11 +90,12 @@ type EvolutionCondition struct {
 	MinDialogues      int            `toml:"min_dialogues"`
 	MinAdventures     int            `toml:"min_adventures"`
 	MinFeedRegularity float64        `toml:"min_feed_regularity"`
-	NightBias         bool           `toml:"night_interactions_bias"`  // DEPRECATED: use custom_acc instead
-	DayBias           bool           `toml:"day_interactions_bias"`    // DEPRECATED: use custom_acc instead
+	NightBias         bool           `toml:"night_interactions_bias"` // DEPRECATED: use custom_acc instead
+	DayBias           bool           `toml:"day_interactions_bias"`   // DEPRECATED: use custom_acc instead
 	MinInteractions   int            `toml:"min_interactions"`
-	MinAttr           map[string]int `toml:"min_attr"`                 // Core attribute requirements (hunger, happiness, etc.)
-	CustomAcc         map[string]int `toml:"custom_acc"`               // NEW: Custom accumulator requirements (e.g., {"fire_points": 50, "ice_points": 30})
+	MinAttr           map[string]int `toml:"min_attr"`   // Core attribute requirements (hunger, happiness, etc.)
+	CustomAcc         map[string]int `toml:"custom_acc"` // NEW: Custom accumulator requirements (e.g., {"fire_points": 50, "ice_points": 30})
+	Expr              string         `toml:"expr"`       // Optional boolean expression (see package expr), e.g. "happiness > health"
 }
 
 // ActionConfig defines a pet action (feed, play, rest, etc.) - Phase 7
@@ -106,6 +114,59 @@ type ActionEffects struct {
 	Energy    int `toml:"energy"`    // Change to energy (can be negative)
 }
 
+// TraitPool declares how a new pet rolls its own traits from the species'
+// traits: Rolls weighted draws without replacement, using each trait's
+// weight and taking at most one trait of each group.
+type TraitPool struct {
+	Rolls int `toml:"rolls"` // traits a new pet rolls (default: 2)
+}
+
+// Defaults returns the trait pool with unset fields filled in.
+func (tp TraitPool) Defaults() TraitPool {
+	if tp.Rolls == 0 {
+		tp.Rolls = 2
+	}
+	return tp
+}
+
+// Breeding declares how two grown pets of the species can have an egg
+// together and what the egg inherits from its parents. The egg draws up to
+// MaxTraits of its parents' traits, weighted by how many parents have each,
+// and its base stats lean toward the parents' current attributes.
+type Breeding struct {
+	Phases         []string      `toml:"phases"`          // phases a parent must be in (default: adult, legend)
+	Cooldown       time.Duration `toml:"cooldown"`        // time before a parent can breed again (default: 72h)
+	EnergyCost     int           `toml:"energy_cost"`     // energy each parent spends (default: 30)
+	MaxTraits      int           `toml:"max_traits"`      // traits the egg inherits at most (default: 2)
+	SharedWeight   int           `toml:"shared_weight"`   // draw weight of a trait both parents have (default: 3)
+	SingleWeight   int           `toml:"single_weight"`   // draw weight of a trait one parent has (default: 1)
+	MutationChance float64       `toml:"mutation_chance"` // chance to gain a species trait neither parent has (0-1)
+	StatBias       float64       `toml:"stat_bias"`       // how far base stats move toward the parents' average (0-1)
+}
+
+// Defaults returns the breeding rules with unset fields filled in.
+func (b Breeding) Defaults() Breeding {
+	if len(b.Phases) == 0 {
+		b.Phases = []string{PhaseAdult, PhaseLegend}
+	}
+	if b.Cooldown == 0 {
+		b.Cooldown = 72 * time.Hour
+	}
+	if b.EnergyCost == 0 {
+		b.EnergyCost = 30
+	}
+	if b.MaxTraits == 0 {
+		b.MaxTraits = 2
+	}
+	if b.SharedWeight == 0 {
+		b.SharedWeight = 3
+	}
+	if b.SingleWeight == 0 {
+		b.SingleWeight = 1
+	}
+	return b
+}
+
 // DialogueGroup is a set of dialogue lines associated with
 // specific evolution stages and mood conditions.
 type DialogueGroup struct {
@@ -144,6 +205,141 @@ type AdventureOutcome struct {
 	Weight  int            `toml:"weight"`
 	Text    string         `toml:"text"`
 	Effects map[string]int `toml:"effects"` // attribute changes
+	Items   map[string]int `toml:"items"`   // item rewards: item ID -> count
+}
+
+// Crisis is an emergency a pet can fall into while time passes. It starts
+// when Trigger holds and the hourly Chance roll succeeds, and must be
+// resolved with one of the Resolve actions before Deadline runs out.
+type Crisis struct {
+	ID          string        `toml:"id"`
+	Name        string        `toml:"name"`
+	Description string        `toml:"description"`
+	Stage       []string      `toml:"stage"`    // stage IDs, supports wildcards; empty = all but eggs
+	Trigger     string        `toml:"trigger"`  // optional boolean expression (see package expr)
+	Chance      float64       `toml:"chance"`   // probability per hour while Trigger holds (0-1)
+	Deadline    time.Duration `toml:"deadline"` // time the player has to resolve it, e.g. "4h"
+	Resolve     []string      `toml:"resolve"`  // actions that resolve it: feed, play, rest, heal, talk, skill:<id>
+	OnResolve   CrisisOutcome `toml:"on_resolve"`
+	OnFail      CrisisOutcome `toml:"on_fail"`
+}
+
+// CrisisOutcome is the text and attribute changes applied when a crisis
+// is resolved or its deadline passes.
+type CrisisOutcome struct {
+	Text    string         `toml:"text"`
+	Effects map[string]int `toml:"effects"`
+}
+
+// CrisesFile is the top-level structure of crises.toml.
+type CrisesFile struct {
+	Crises []Crisis `toml:"crises"`
+}
+
+// Item kinds. Food, toys and medicine are used through the care action of
+// the same role; accessories are cosmetic and owned at most once.
+const (
+	ItemFood      = "food"      // used with feed
+	ItemToy       = "toy"       // used with play
+	ItemMedicine  = "medicine"  // used with heal
+	ItemAccessory = "accessory" // cosmetic
+)
+
+// Item is something a pet can carry in its inventory. Using an item performs
+// the care action for its kind and adds the item's effects on top.
+type Item struct {
+	ID          string         `toml:"id"`
+	Name        string         `toml:"name"`
+	Description string         `toml:"description"`
+	Kind        string         `toml:"kind"`         // food | toy | medicine | accessory
+	Tags        []string       `toml:"tags"`         // matched by passive item_tag_bonus
+	Effects     map[string]int `toml:"effects"`      // attribute changes added to the action
+	PrizeWeight int            `toml:"prize_weight"` // weight as a mini-game prize; 0 = never
+	Price       int            `toml:"price"`        // shop price in coins; 0 = not sold
+
+	// Accessories only
+	Slot     string             `toml:"slot"`     // one equipped accessory per slot, e.g. "head"
+	Overlays []AccessoryOverlay `toml:"overlays"` // art drawn over the pet's frames
+}
+
+// AccessoryOverlay is the art an accessory draws over the pet's frames for
+// the matching stages. Spaces in Art are transparent.
+type AccessoryOverlay struct {
+	Stage []string `toml:"stage"` // stage patterns, wildcards allowed; empty = every stage
+	X     int      `toml:"x"`     // column of the art's left edge in the frame; may be negative
+	Y     int      `toml:"y"`     // line of the art's top edge in the frame; negative = above
+	Art   string   `toml:"art"`
+}
+
+// OverlayFor returns the first overlay of the item that applies to the
+// stage, or nil if it draws nothing there.
+func (it *Item) OverlayFor(stageID string) *AccessoryOverlay {
+	for i := range it.Overlays {
+		if ov := &it.Overlays[i]; len(ov.Stage) == 0 || matchesStage(ov.Stage, stageID) {
+			return ov
+		}
+	}
+	return nil
+}
+
+// ItemsFile is the top-level structure of items.toml.
+type ItemsFile struct {
+	Items []Item `toml:"items"`
+}
+
+// Achievement events: what a pet must have done, and how often, to unlock
+// an achievement. Subjects are matched like stage patterns ("skill:*").
+const (
+	AchievementEventAction    = "action"    // subject: action ID (feed, play, skill:<id>, item:<id>, ...), successful only
+	AchievementEventAdventure = "adventure" // subject: adventure ID
+	AchievementEventEvolution = "evolution" // subject: new stage ID
+	AchievementEventCrisis    = "crisis"    // subject: crisis ID, resolved only
+)
+
+// Achievement is a milestone of a profile, unlocked once by any pet of its
+// household. It unlocks when Condition holds for the pet and, if Event is
+// set, once the household has done Count matching events since the
+// achievement became available. Built-in achievements come with clipet;
+// species packs add their own in achievements.toml.
+type Achievement struct {
+	ID          string `toml:"id"`
+	Name        string `toml:"name"`
+	Description string `toml:"description"`
+	Icon        string `toml:"icon"`
+	Hidden      bool   `toml:"hidden"`    // name and description stay secret until unlocked
+	Condition   string `toml:"condition"` // optional boolean expression (see package expr)
+	Event       string `toml:"event"`     // optional: action | adventure | evolution | crisis
+	Subject     string `toml:"subject"`   // event subject pattern, wildcards allowed; empty = any
+	Count       int    `toml:"count"`     // matching events needed (default: 1)
+
+	Species string `toml:"-"` // species that defines it; empty for built-in achievements
+}
+
+// Key identifies the achievement across species: built-in achievements
+// use their ID, species achievements "<species>/<id>".
+func (a Achievement) Key() string {
+	if a.Species == "" {
+		return a.ID
+	}
+	return a.Species + "/" + a.ID
+}
+
+// Needed returns the number of matching events the achievement needs.
+func (a Achievement) Needed() int {
+	if a.Event == "" {
+		return 0
+	}
+	return max(a.Count, 1)
+}
+
+// Matches reports whether an event counts toward the achievement.
+func (a Achievement) Matches(event, subject string) bool {
+	return a.Event != "" && a.Event == event && (a.Subject == "" || matchesStage([]string{a.Subject}, subject))
+}
+
+// AchievementsFile is the top-level structure of achievements.toml.
+type AchievementsFile struct {
+	Achievements []Achievement `toml:"achievements"`
 }
 
 // Frame holds the ASCII art frames for a specific stage+animation combination.
@@ -159,11 +355,23 @@ func FrameKey(stageID, animState string) string {
 	return stageID + "_" + animState
 }
 
-// ScriptsConfig holds paths for future script extension support.
+// ScriptsConfig holds the paths of the pack's Starlark hook scripts,
+// relative to the pack directory. See package script for the script API.
 type ScriptsConfig struct {
 	OnEvolve    string `toml:"on_evolve"`
 	OnAdventure string `toml:"on_adventure"`
 	CustomMood  string `toml:"custom_mood"`
+
+	Programs map[string]*script.Program `toml:"-"` // compiled at load time, keyed by hook name
+}
+
+// Paths returns the configured script path for each hook name.
+func (s ScriptsConfig) Paths() map[string]string {
+	return map[string]string{
+		script.HookOnEvolve:    s.OnEvolve,
+		script.HookOnAdventure: s.OnAdventure,
+		script.HookCustomMood:  s.CustomMood,
+	}
 }
 
 // StagePhase constants.
@@ -175,6 +383,21 @@ const (
 	PhaseLegend = "legend"
 )
 
+// phaseOrder ranks the phases in the order a pet grows through them.
+var phaseOrder = map[string]int{
+	PhaseEgg:    0,
+	PhaseBaby:   1,
+	PhaseChild:  2,
+	PhaseAdult:  3,
+	PhaseLegend: 4,
+}
+
+// PhaseReached reports whether a pet in phase has grown to target. An empty
+// target is always reached.
+func PhaseReached(phase, target string) bool {
+	return phaseOrder[phase] >= phaseOrder[target]
+}
+
 // ValidPhases is the set of valid phase values.
 var ValidPhases = map[string]bool{
 	PhaseEgg:    true,
@@ -1,9 +1,15 @@
 package plugin
 
 import (
+	"clipet/internal/expr"
 	"clipet/internal/game/capabilities"
+	"clipet/internal/script"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
+	"unicode/utf8"
 )
 
 // ValidationError holds details about a plugin validation failure.
@@ -17,9 +23,10 @@ func (e ValidationError) Error() string {
 }
 
 // Validate checks a SpeciesPack for correctness and completeness.
-// Returns a list of validation errors (empty if valid).
-func Validate(pack *SpeciesPack) []ValidationError {
-	var errs []ValidationError
+// Returns a list of validation errors (empty if valid) and a list of
+// warnings for values that are clamped to the plugin constraints on load.
+func Validate(pack *SpeciesPack) (errs, warnings []ValidationError) {
+	vars := exprVars(pack)
 
 	// Species metadata
 	if pack.Species.ID == "" {
@@ -76,6 +83,9 @@ func Validate(pack *SpeciesPack) []ValidationError {
 		} else if !stageIDs[evo.To] {
 			errs = append(errs, ValidationError{prefix + ".to", fmt.Sprintf("references unknown stage %q", evo.To)})
 		}
+		if err := validateExpr(evo.Condition.Expr, expr.Bool, vars); err != nil {
+			errs = append(errs, ValidationError{prefix + ".condition.expr", err.Error()})
+		}
 	}
 
 	// Check evolution chain connectivity: every non-egg stage should be reachable
@@ -135,6 +145,33 @@ func Validate(pack *SpeciesPack) []ValidationError {
 			if len(choice.Outcomes) == 0 {
 				errs = append(errs, ValidationError{cPrefix + ".outcomes", "must have at least one outcome"})
 			}
+			for k, outcome := range choice.Outcomes {
+				for _, itemID := range slices.Sorted(maps.Keys(outcome.Items)) {
+					if !slices.ContainsFunc(pack.Items, func(it Item) bool { return it.ID == itemID }) {
+						errs = append(errs, ValidationError{fmt.Sprintf("%s.outcomes[%d].items", cPrefix, k), fmt.Sprintf("references unknown item %q", itemID)})
+					} else if outcome.Items[itemID] <= 0 {
+						errs = append(errs, ValidationError{fmt.Sprintf("%s.outcomes[%d].items", cPrefix, k), fmt.Sprintf("count of %q must be positive", itemID)})
+					}
+				}
+			}
+		}
+	}
+
+	// Items
+	errs = append(errs, validateItems(pack, stageIDs)...)
+
+	// Endings
+	for i, ending := range pack.Endings {
+		if err := validateExpr(ending.Condition.Expr, expr.Bool, vars); err != nil {
+			errs = append(errs, ValidationError{fmt.Sprintf("endings[%d].condition.expr", i), err.Error()})
+		}
+		for _, attr := range slices.Sorted(maps.Keys(ending.Legacy)) {
+			field := fmt.Sprintf("endings[%d].legacy.%s", i, attr)
+			if !legacyAttrs[attr] {
+				errs = append(errs, ValidationError{field, "unknown attribute, must be one of: hunger, happiness, health, energy"})
+			} else if v := ending.Legacy[attr]; v < 0 || v > MaxEndingLegacy {
+				errs = append(errs, ValidationError{field, fmt.Sprintf("%d is outside [0, %d]", v, MaxEndingLegacy)})
+			}
 		}
 	}
 
@@ -157,6 +194,9 @@ func Validate(pack *SpeciesPack) []ValidationError {
 					errs = append(errs, ValidationError{prefix, errMsg})
 				}
 			}
+			if err := validateExpr(trait.PassiveEffect.HealthRegenMultiplier, expr.Number, vars); err != nil {
+				errs = append(errs, ValidationError{fmt.Sprintf("traits[%d].passive_effect.health_regen_multiplier", i), err.Error()})
+			}
 		}
 	}
 
@@ -167,13 +207,24 @@ func Validate(pack *SpeciesPack) []ValidationError {
 			fmt.Sprintf("too many adventures (%d), maximum is 20 to prevent overwhelming players", adventureCount)})
 	}
 
+	// Crises
+	errs = append(errs, validateCrises(pack, vars)...)
+
+	// Trait pool
+	errs = append(errs, validateTraitPool(pack)...)
+
+	// Breeding
+	errs = append(errs, validateBreeding(pack)...)
+
+	// Achievements
+	errs = append(errs, validateAchievements(pack.Achievements, vars)...)
+
 	// Validate dialogue count (prevent content overload)
 	if len(pack.Dialogues) > 100 {
 		errs = append(errs, ValidationError{"dialogues",
 			fmt.Sprintf("too many dialogue groups (%d), maximum is 100", len(pack.Dialogues))})
 	}
 
-
 	// Frames: check that at least egg idle frames exist
 	eggStageID := ""
 	for _, stage := range pack.Stages {
@@ -189,5 +240,330 @@ func Validate(pack *SpeciesPack) []ValidationError {
 		}
 	}
 
+	// Scripts: dry-run each hook against a freshly hatched pet
+	errs = append(errs, validateScripts(pack, eggStageID)...)
+
+	return errs, constraintWarnings(pack, constraints)
+}
+
+// crisisActions are the care actions that can resolve a crisis, besides
+// active skills ("skill:<id>").
+var crisisActions = map[string]bool{"feed": true, "play": true, "rest": true, "heal": true, "talk": true}
+
+// validateCrises checks crises.toml definitions.
+func validateCrises(pack *SpeciesPack, vars map[string]bool) []ValidationError {
+	var errs []ValidationError
+	ids := make(map[string]bool)
+	for i, c := range pack.Crises {
+		prefix := fmt.Sprintf("crises[%d]", i)
+		if c.ID == "" {
+			errs = append(errs, ValidationError{prefix + ".id", "required"})
+		} else if ids[c.ID] {
+			errs = append(errs, ValidationError{prefix + ".id", fmt.Sprintf("duplicate crisis ID %q", c.ID)})
+		}
+		ids[c.ID] = true
+
+		if c.Chance <= 0 || c.Chance > 1 {
+			errs = append(errs, ValidationError{prefix + ".chance", fmt.Sprintf("%.2f is outside (0.0, 1.0]", c.Chance)})
+		}
+		if c.Deadline <= 0 {
+			errs = append(errs, ValidationError{prefix + ".deadline", "must be a positive duration like \"4h\""})
+		}
+		if err := validateExpr(c.Trigger, expr.Bool, vars); err != nil {
+			errs = append(errs, ValidationError{prefix + ".trigger", err.Error()})
+		}
+
+		if len(c.Resolve) == 0 {
+			errs = append(errs, ValidationError{prefix + ".resolve", "at least one action required"})
+		}
+		for _, action := range c.Resolve {
+			if skillID, ok := strings.CutPrefix(action, "skill:"); ok {
+				if !slices.ContainsFunc(pack.Traits, func(t capabilities.PersonalityTrait) bool {
+					return t.ID == skillID && t.Type == "active"
+				}) {
+					errs = append(errs, ValidationError{prefix + ".resolve", fmt.Sprintf("unknown active skill %q", skillID)})
+				}
+			} else if !crisisActions[action] {
+				errs = append(errs, ValidationError{prefix + ".resolve", fmt.Sprintf("unknown action %q, must be feed, play, rest, heal, talk or skill:<id>", action)})
+			}
+		}
+	}
+	return errs
+}
+
+// validateBreeding checks the [breeding] section of species.toml.
+func validateBreeding(pack *SpeciesPack) []ValidationError {
+	b := pack.Breeding
+	if b == nil {
+		return nil
+	}
+	var errs []ValidationError
+	for _, phase := range b.Phases {
+		if !ValidPhases[phase] || phase == PhaseEgg {
+			errs = append(errs, ValidationError{"breeding.phases", fmt.Sprintf("invalid phase %q, must be one of: baby, child, adult, legend", phase)})
+		}
+	}
+	if b.Cooldown < 0 {
+		errs = append(errs, ValidationError{"breeding.cooldown", "must not be negative"})
+	}
+	if b.EnergyCost < 0 || b.EnergyCost > 100 {
+		errs = append(errs, ValidationError{"breeding.energy_cost", fmt.Sprintf("%d is outside [0, 100]", b.EnergyCost)})
+	}
+	if b.MaxTraits < 0 {
+		errs = append(errs, ValidationError{"breeding.max_traits", "must not be negative"})
+	}
+	if b.SharedWeight < 0 || b.SingleWeight < 0 {
+		errs = append(errs, ValidationError{"breeding", "shared_weight and single_weight must not be negative"})
+	}
+	if b.MutationChance < 0 || b.MutationChance > 1 {
+		errs = append(errs, ValidationError{"breeding.mutation_chance", fmt.Sprintf("%.2f is outside [0.0, 1.0]", b.MutationChance)})
+	}
+	if b.StatBias < 0 || b.StatBias > 1 {
+		errs = append(errs, ValidationError{"breeding.stat_bias", fmt.Sprintf("%.2f is outside [0.0, 1.0]", b.StatBias)})
+	}
+	return errs
+}
+
+// achievementEvents are the events an achievement can count.
+var achievementEvents = map[string]bool{
+	AchievementEventAction: true, AchievementEventAdventure: true,
+	AchievementEventEvolution: true, AchievementEventCrisis: true,
+}
+
+// ValidateAchievements checks achievement definitions, both the built-in
+// ones and those of achievements.toml.
+func ValidateAchievements(achievements []Achievement) []ValidationError {
+	return validateAchievements(achievements, nil)
+}
+
+// validateAchievements checks achievement definitions whose conditions may
+// read vars (see validateExpr).
+func validateAchievements(achievements []Achievement, vars map[string]bool) []ValidationError {
+	var errs []ValidationError
+	ids := make(map[string]bool)
+	for i, a := range achievements {
+		prefix := fmt.Sprintf("achievements[%d]", i)
+		if a.ID == "" {
+			errs = append(errs, ValidationError{prefix + ".id", "required"})
+		} else if ids[a.ID] {
+			errs = append(errs, ValidationError{prefix + ".id", fmt.Sprintf("duplicate achievement ID %q", a.ID)})
+		} else if strings.ContainsAny(a.ID, "/: ") {
+			errs = append(errs, ValidationError{prefix + ".id", fmt.Sprintf("%q must not contain '/', ':' or spaces", a.ID)})
+		}
+		ids[a.ID] = true
+
+		if a.Name == "" {
+			errs = append(errs, ValidationError{prefix + ".name", "required"})
+		}
+		if a.Condition == "" && a.Event == "" {
+			errs = append(errs, ValidationError{prefix, "condition or event required"})
+		}
+		if err := validateExpr(a.Condition, expr.Bool, vars); err != nil {
+			errs = append(errs, ValidationError{prefix + ".condition", err.Error()})
+		}
+		if a.Event != "" && !achievementEvents[a.Event] {
+			errs = append(errs, ValidationError{prefix + ".event", fmt.Sprintf("unknown event %q, must be action, adventure, evolution or crisis", a.Event)})
+		}
+		if a.Event == "" && (a.Subject != "" || a.Count != 0) {
+			errs = append(errs, ValidationError{prefix + ".event", "required with subject or count"})
+		}
+		if a.Count < 0 {
+			errs = append(errs, ValidationError{prefix + ".count", "must not be negative"})
+		}
+	}
+	return errs
+}
+
+// MaxEndingLegacy is the largest attribute bonus an ending may pass on.
+const MaxEndingLegacy = 30
+
+// legacyAttrs are the attributes an ending's legacy may raise.
+var legacyAttrs = map[string]bool{"hunger": true, "happiness": true, "health": true, "energy": true}
+
+// validateTraitPool checks the trait pool and the roll settings of traits.
+func validateTraitPool(pack *SpeciesPack) []ValidationError {
+	var errs []ValidationError
+	for i, trait := range pack.Traits {
+		if trait.Weight < 0 {
+			errs = append(errs, ValidationError{fmt.Sprintf("traits[%d].weight", i), "must not be negative"})
+		}
+		if trait.UnlockPhase != "" && !ValidPhases[trait.UnlockPhase] {
+			errs = append(errs, ValidationError{fmt.Sprintf("traits[%d].unlock_phase", i),
+				fmt.Sprintf("invalid phase %q, must be one of: egg, baby, child, adult, legend", trait.UnlockPhase)})
+		}
+	}
+	if pack.TraitPool != nil && pack.TraitPool.Rolls < 0 {
+		errs = append(errs, ValidationError{"trait_pool.rolls", "must not be negative"})
+	}
+	return errs
+}
+
+// itemKinds are the valid item kinds.
+var itemKinds = map[string]bool{ItemFood: true, ItemToy: true, ItemMedicine: true, ItemAccessory: true}
+
+// validateItems checks items.toml definitions.
+func validateItems(pack *SpeciesPack, stageIDs map[string]bool) []ValidationError {
+	var errs []ValidationError
+	ids := make(map[string]bool)
+	for i, item := range pack.Items {
+		prefix := fmt.Sprintf("items[%d]", i)
+		if item.ID == "" {
+			errs = append(errs, ValidationError{prefix + ".id", "required"})
+		} else if ids[item.ID] {
+			errs = append(errs, ValidationError{prefix + ".id", fmt.Sprintf("duplicate item ID %q", item.ID)})
+		} else if strings.ContainsAny(item.ID, ": ") {
+			errs = append(errs, ValidationError{prefix + ".id", fmt.Sprintf("%q must not contain ':' or spaces", item.ID)})
+		}
+		ids[item.ID] = true
+
+		if !itemKinds[item.Kind] {
+			errs = append(errs, ValidationError{prefix + ".kind", fmt.Sprintf("unknown kind %q, must be food, toy, medicine or accessory", item.Kind)})
+		}
+		if item.PrizeWeight < 0 {
+			errs = append(errs, ValidationError{prefix + ".prize_weight", "must not be negative"})
+		}
+		if item.Price < 0 {
+			errs = append(errs, ValidationError{prefix + ".price", "must not be negative"})
+		}
+		if len(item.Overlays) > 0 && item.Kind != ItemAccessory {
+			errs = append(errs, ValidationError{prefix + ".overlays", "only accessories can have overlays"})
+		}
+		for j, ov := range item.Overlays {
+			ovPrefix := fmt.Sprintf("%s.overlays[%d]", prefix, j)
+			if strings.TrimSpace(ov.Art) == "" {
+				errs = append(errs, ValidationError{ovPrefix + ".art", "required"})
+			}
+			for _, s := range ov.Stage {
+				if !strings.Contains(s, "*") && !stageIDs[s] {
+					errs = append(errs, ValidationError{ovPrefix + ".stage", fmt.Sprintf("references unknown stage %q", s)})
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// ExprVariables are the variables game.Pet.ExprVars resolves itself, plus
+// the evolution accumulators the game keeps for every species. Any other
+// name in a pack expression reads an accumulator.
+var ExprVariables = []string{
+	"hunger", "happiness", "health", "energy", "coins", "care_streak", "mood_score",
+	"age_hours", "age_days", "interactions", "games_won", "adventures", "dialogues",
+	"feed_count", "feed_regularity", "day_interactions", "night_interactions",
+	"generation", "hour", "minute",
+	"acc_happiness", "acc_health", "acc_playful",
+}
+
+// exprVars returns the variables the pack's expressions may read: the
+// built-in ones and the accumulators the pack declares in custom_acc or
+// writes through effects and scripts. It returns nil, accepting any name,
+// when a script computes the attribute names it changes.
+func exprVars(pack *SpeciesPack) map[string]bool {
+	vars := make(map[string]bool)
+	for _, name := range ExprVariables {
+		vars[name] = true
+	}
+	add := func(m map[string]int) {
+		for name := range m {
+			vars[strings.ToLower(name)] = true
+		}
+	}
+	for _, evo := range pack.Evolutions {
+		add(evo.Condition.CustomAcc)
+	}
+	for _, adv := range pack.Adventures {
+		for _, choice := range adv.Choices {
+			for _, outcome := range choice.Outcomes {
+				add(outcome.Effects)
+			}
+		}
+	}
+	for _, c := range pack.Crises {
+		add(c.OnResolve.Effects)
+		add(c.OnFail.Effects)
+	}
+	for _, item := range pack.Items {
+		add(item.Effects)
+	}
+	for _, prog := range pack.Scripts.Programs {
+		attrs, complete := prog.Writes()
+		if !complete {
+			return nil
+		}
+		for _, name := range attrs {
+			vars[name] = true
+		}
+	}
+	return vars
+}
+
+// validateExpr checks an optional expression of the wanted type. With vars
+// set, every variable it reads must be one of them, since unknown names
+// silently read as 0. The error quotes the expression with a caret under
+// each offending column.
+func validateExpr(src string, want expr.Type, vars map[string]bool) error {
+	if src == "" {
+		return nil
+	}
+	parse := expr.ParseBool
+	if want == expr.Number {
+		parse = expr.ParseNumber
+	}
+	e, err := parse(src)
+	var perr *expr.Error
+	if errors.As(err, &perr) {
+		return fmt.Errorf("%s\n      %s\n      %s^", perr.Error(), src, strings.Repeat(" ", perr.Col-1))
+	}
+	if err != nil || vars == nil {
+		return err
+	}
+
+	var msgs []string
+	carets := []byte(strings.Repeat(" ", utf8.RuneCountInString(src))) // columns count runes
+	for _, id := range e.Idents() {
+		if vars[id.Name] {
+			continue
+		}
+		msgs = append(msgs, (&expr.Error{Col: id.Col, Msg: fmt.Sprintf("unknown variable %q", id.Name)}).Error())
+		carets[id.Col-1] = '^'
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s (not a built-in variable or an accumulator the pack declares or writes)\n      %s\n      %s",
+		strings.Join(msgs, "; "), src, strings.TrimRight(string(carets), " "))
+}
+
+// validateScripts runs every compiled hook script once against a pet with
+// the pack's base stats, so runtime errors and limit violations surface at
+// load time instead of being silently ignored in play.
+func validateScripts(pack *SpeciesPack, eggStageID string) []ValidationError {
+	var errs []ValidationError
+	view := script.PetView{
+		Name:      "validate",
+		Species:   pack.Species.ID,
+		StageID:   eggStageID,
+		Phase:     PhaseEgg,
+		Alive:     true,
+		Hunger:    pack.Species.BaseStats.Hunger,
+		Happiness: pack.Species.BaseStats.Happiness,
+		Health:    pack.Species.BaseStats.Health,
+		Energy:    pack.Species.BaseStats.Energy,
+	}
+	for _, hook := range slices.Sorted(maps.Keys(pack.Scripts.Programs)) {
+		prog := pack.Scripts.Programs[hook]
+		var err error
+		switch hook {
+		case script.HookCustomMood:
+			_, err = prog.Mood(view)
+		case script.HookOnEvolve:
+			_, err = prog.Effects(view, map[string]any{"from": eggStageID, "to": eggStageID, "phase": PhaseEgg})
+		case script.HookOnAdventure:
+			_, err = prog.Effects(view, map[string]any{"text": "", "effects": map[string]int{}})
+		}
+		if err != nil {
+			errs = append(errs, ValidationError{"scripts." + hook, err.Error()})
+		}
+	}
 	return errs
 }
@@ -0,0 +1,74 @@
+package plugin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CompareVersions compares dotted numeric versions such as "3.1.0".
+// A leading "v" and any pre-release/build suffix ("-beta", "+meta") are
+// ignored; missing components count as 0. It returns -1, 0 or 1.
+func CompareVersions(a, b string) (int, error) {
+	pa, err := parseVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	pb, err := parseVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	for i := range pa {
+		switch {
+		case pa[i] < pb[i]:
+			return -1, nil
+		case pa[i] > pb[i]:
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+// VersionCompatible reports whether a pet created with species pack version
+// required can be used with the installed pack version: the major versions
+// must match and the installed pack must not be older.
+func VersionCompatible(installed, required string) (bool, error) {
+	pi, err := parseVersion(installed)
+	if err != nil {
+		return false, err
+	}
+	pr, err := parseVersion(required)
+	if err != nil {
+		return false, err
+	}
+	if pi[0] != pr[0] {
+		return false, nil
+	}
+	cmp, _ := CompareVersions(installed, required)
+	return cmp >= 0, nil
+}
+
+// parseVersion splits a version into major, minor and patch numbers.
+func parseVersion(v string) ([3]int, error) {
+	var parts [3]int
+	s := strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		s = s[:i]
+	}
+	if s == "" {
+		return parts, fmt.Errorf("invalid version %q", v)
+	}
+
+	fields := strings.Split(s, ".")
+	if len(fields) > 3 {
+		return parts, fmt.Errorf("invalid version %q", v)
+	}
+	for i, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil || n < 0 {
+			return parts, fmt.Errorf("invalid version %q", v)
+		}
+		parts[i] = n
+	}
+	return parts, nil
+}
@@ -0,0 +1,295 @@
+// Package script runs species pack hook scripts in a sandboxed Starlark
+// interpreter.
+//
+// A script file defines one function named after its hook:
+//
+//	def custom_mood(pet): return "happy" or None
+//	def on_evolve(pet, evolution): change("happiness", 10)
+//	def on_adventure(pet, outcome): change("fire_points", 1)
+//
+// Scripts see a frozen, read-only pet and can only request attribute
+// changes through the change(attr, delta) builtin. There is no load, file,
+// network or clock access, and every run is bounded by MaxSteps and Timeout.
+package script
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"go.starlark.net/syntax"
+)
+
+// Hook names, also the function each script must define.
+const (
+	HookCustomMood  = "custom_mood"
+	HookOnEvolve    = "on_evolve"
+	HookOnAdventure = "on_adventure"
+)
+
+// Execution limits for one script run (including top-level code at load).
+const (
+	MaxSteps = 100_000
+	Timeout  = 50 * time.Millisecond
+)
+
+// MaxDelta bounds a single change() call.
+const MaxDelta = 100
+
+// effectsKey is the thread-local slot that collects change() calls.
+const effectsKey = "clipet.effects"
+
+// fileOptions keeps the Starlark dialect minimal: no while loops,
+// recursion, sets or top-level control flow.
+var fileOptions = &syntax.FileOptions{}
+
+// predeclared are the names visible to every script besides Starlark's
+// universe (len, range, min, max, ...).
+var predeclared = starlark.StringDict{
+	"change": starlark.NewBuiltin("change", builtinChange),
+}
+
+// PetView is the read-only pet state handed to scripts as `pet`.
+type PetView struct {
+	Name         string
+	Species      string
+	StageID      string
+	Phase        string
+	Alive        bool
+	Hunger       int
+	Happiness    int
+	Health       int
+	Energy       int
+	MoodScore    int
+	AgeHours     float64
+	Hour         int // local hour of day (0-23)
+	Interactions int
+	GamesWon     int
+	Adventures   int
+	Dialogues    int
+	Custom       map[string]int // custom attributes and accumulators
+}
+
+// Effects are attribute deltas requested by a script, keyed by lowercase
+// attribute name (core or custom).
+type Effects map[string]int
+
+// Program is a compiled hook script. It is safe for concurrent use.
+type Program struct {
+	hook string
+	fn   starlark.Callable
+	file *syntax.File
+}
+
+// Compile parses and initializes a script for hook. Errors carry the
+// file:line:col position reported by Starlark.
+func Compile(filename, src, hook string) (*Program, error) {
+	file, prog, err := starlark.SourceProgramOptions(fileOptions, filename, src, predeclared.Has)
+	if err != nil {
+		return nil, err
+	}
+
+	thread, stop := newThread(filename, nil)
+	defer stop()
+	globals, err := prog.Init(thread, predeclared)
+	if err != nil {
+		return nil, withPosition(err)
+	}
+	globals.Freeze()
+
+	fn, ok := globals[hook].(starlark.Callable)
+	if !ok {
+		return nil, fmt.Errorf("%s: script must define function %s", filename, hook)
+	}
+	return &Program{hook: hook, fn: fn, file: file}, nil
+}
+
+// Hook returns the hook the program was compiled for.
+func (p *Program) Hook() string {
+	return p.hook
+}
+
+// Writes returns the attributes the script passes to change() as string
+// literals, lowercased. complete is false if change is used any other way,
+// e.g. with a computed name, so the script may write attributes not listed.
+func (p *Program) Writes() (attrs []string, complete bool) {
+	uses, literal := 0, 0
+	syntax.Walk(p.file, func(n syntax.Node) bool {
+		switch n := n.(type) {
+		case *syntax.Ident:
+			if n.Name == "change" {
+				uses++
+			}
+		case *syntax.CallExpr:
+			fn, ok := n.Fn.(*syntax.Ident)
+			if !ok || fn.Name != "change" || len(n.Args) == 0 {
+				break
+			}
+			if lit, ok := n.Args[0].(*syntax.Literal); ok && lit.Token == syntax.STRING {
+				attrs = append(attrs, strings.ToLower(strings.TrimSpace(lit.Value.(string))))
+				literal++
+			}
+		}
+		return true
+	})
+	return attrs, uses == literal
+}
+
+// Mood runs a custom_mood script. An empty result means the script
+// returned None and the default mood applies.
+func (p *Program) Mood(pet PetView) (string, error) {
+	v, err := p.call(pet, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	switch v := v.(type) {
+	case starlark.NoneType:
+		return "", nil
+	case starlark.String:
+		return strings.TrimSpace(string(v)), nil
+	}
+	return "", fmt.Errorf("%s: want string or None, got %s", p.hook, v.Type())
+}
+
+// Effects runs an on_evolve or on_adventure script with event as its
+// second argument and returns the requested changes.
+func (p *Program) Effects(pet PetView, event map[string]any) (Effects, error) {
+	effects := Effects{}
+	if _, err := p.call(pet, event, effects); err != nil {
+		return nil, err
+	}
+	return effects, nil
+}
+
+// call runs the hook function under the execution limits. change() calls
+// are collected into effects; with nil effects they fail.
+func (p *Program) call(pet PetView, event map[string]any, effects Effects) (starlark.Value, error) {
+	thread, stop := newThread(p.hook, effects)
+	defer stop()
+
+	args := starlark.Tuple{petValue(pet)}
+	if event != nil {
+		args = append(args, structValue("event", event))
+	}
+	v, err := starlark.Call(thread, p.fn, args, nil)
+	if err != nil {
+		return nil, withPosition(err)
+	}
+	return v, nil
+}
+
+// withPosition prefixes a Starlark runtime error with the script position
+// (file:line:col) where it happened.
+func withPosition(err error) error {
+	var evalErr *starlark.EvalError
+	if !errors.As(err, &evalErr) {
+		return err
+	}
+	for i := range evalErr.CallStack {
+		if pos := evalErr.CallStack.At(i).Pos; pos.IsValid() {
+			return fmt.Errorf("%s: %s", pos, evalErr.Msg)
+		}
+	}
+	return err
+}
+
+// newThread creates a sandboxed thread with step and time limits. The
+// returned func releases the timer.
+func newThread(name string, effects Effects) (*starlark.Thread, func()) {
+	thread := &starlark.Thread{
+		Name:  name,
+		Print: func(*starlark.Thread, string) {}, // never write to the terminal
+	}
+	thread.SetMaxExecutionSteps(MaxSteps)
+	if effects != nil {
+		thread.SetLocal(effectsKey, effects)
+	}
+	timer := time.AfterFunc(Timeout, func() { thread.Cancel("timeout") })
+	return thread, func() { timer.Stop() }
+}
+
+// builtinChange implements change(attr, delta).
+func builtinChange(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var attr string
+	var delta int
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 2, &attr, &delta); err != nil {
+		return nil, err
+	}
+	effects, ok := thread.Local(effectsKey).(Effects)
+	if !ok {
+		return nil, fmt.Errorf("%s: not allowed in this hook", b.Name())
+	}
+	attr = strings.ToLower(strings.TrimSpace(attr))
+	if attr == "" {
+		return nil, fmt.Errorf("%s: empty attribute name", b.Name())
+	}
+	if delta < -MaxDelta || delta > MaxDelta {
+		return nil, fmt.Errorf("%s: delta %d out of range [-%d, %d]", b.Name(), delta, MaxDelta, MaxDelta)
+	}
+	effects[attr] += delta
+	return starlark.None, nil
+}
+
+// petValue converts the view into a frozen struct.
+func petValue(p PetView) starlark.Value {
+	custom := starlark.NewDict(len(p.Custom))
+	for k, v := range p.Custom {
+		_ = custom.SetKey(starlark.String(k), starlark.MakeInt(v))
+	}
+	s := starlarkstruct.FromStringDict(starlark.String("pet"), starlark.StringDict{
+		"name":         starlark.String(p.Name),
+		"species":      starlark.String(p.Species),
+		"stage_id":     starlark.String(p.StageID),
+		"phase":        starlark.String(p.Phase),
+		"alive":        starlark.Bool(p.Alive),
+		"hunger":       starlark.MakeInt(p.Hunger),
+		"happiness":    starlark.MakeInt(p.Happiness),
+		"health":       starlark.MakeInt(p.Health),
+		"energy":       starlark.MakeInt(p.Energy),
+		"mood_score":   starlark.MakeInt(p.MoodScore),
+		"age_hours":    starlark.Float(p.AgeHours),
+		"hour":         starlark.MakeInt(p.Hour),
+		"interactions": starlark.MakeInt(p.Interactions),
+		"games_won":    starlark.MakeInt(p.GamesWon),
+		"adventures":   starlark.MakeInt(p.Adventures),
+		"dialogues":    starlark.MakeInt(p.Dialogues),
+		"custom":       custom,
+	})
+	s.Freeze()
+	return s
+}
+
+// structValue converts event data into a frozen struct.
+func structValue(name string, m map[string]any) starlark.Value {
+	fields := make(starlark.StringDict, len(m))
+	for k, v := range m {
+		fields[k] = toValue(v)
+	}
+	s := starlarkstruct.FromStringDict(starlark.String(name), fields)
+	s.Freeze()
+	return s
+}
+
+// toValue converts the Go values used in events to Starlark.
+func toValue(v any) starlark.Value {
+	switch v := v.(type) {
+	case string:
+		return starlark.String(v)
+	case int:
+		return starlark.MakeInt(v)
+	case float64:
+		return starlark.Float(v)
+	case bool:
+		return starlark.Bool(v)
+	case map[string]int:
+		d := starlark.NewDict(len(v))
+		for k, n := range v {
+			_ = d.SetKey(starlark.String(k), starlark.MakeInt(n))
+		}
+		return d
+	}
+	return starlark.None
+}
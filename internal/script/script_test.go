@@ -0,0 +1,134 @@
+package script
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCompile_ReportsPositions(t *testing.T) {
+	_, err := Compile("mood.star", "def custom_mood(pet):\n    return pet.hunger +\n", HookCustomMood)
+	if err == nil || !strings.Contains(err.Error(), "mood.star:2:") {
+		t.Errorf("syntax error = %v, want position mood.star:2:...", err)
+	}
+
+	_, err = Compile("mood.star", "def other(pet):\n    return None\n", HookCustomMood)
+	if err == nil || !strings.Contains(err.Error(), "custom_mood") {
+		t.Errorf("missing function error = %v", err)
+	}
+
+	_, err = Compile("evo.star", `load("os.star", "os")`+"\ndef on_evolve(pet, evolution):\n    pass\n", HookOnEvolve)
+	if err == nil {
+		t.Error("load should not be available to scripts")
+	}
+}
+
+func TestProgram_Mood(t *testing.T) {
+	prog, err := Compile("mood.star", `
+def custom_mood(pet):
+    if pet.custom.get("fire_points", 0) > pet.hunger:
+        return "fiery"
+    return None
+`, HookCustomMood)
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+
+	mood, err := prog.Mood(PetView{Hunger: 10, Custom: map[string]int{"fire_points": 20}})
+	if err != nil || mood != "fiery" {
+		t.Errorf("Mood = %q, %v; want fiery", mood, err)
+	}
+	mood, err = prog.Mood(PetView{Hunger: 50})
+	if err != nil || mood != "" {
+		t.Errorf("Mood = %q, %v; want empty for None", mood, err)
+	}
+}
+
+func TestProgram_Effects(t *testing.T) {
+	prog, err := Compile("evo.star", `
+def on_evolve(pet, evolution):
+    if evolution.phase == "adult":
+        change("happiness", 10)
+        change("Fire_Points", 2)
+        change("fire_points", 1)
+`, HookOnEvolve)
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+
+	effects, err := prog.Effects(PetView{}, map[string]any{"from": "child", "to": "adult_fire", "phase": "adult"})
+	if err != nil {
+		t.Fatalf("Effects: %v", err)
+	}
+	if effects["happiness"] != 10 || effects["fire_points"] != 3 || len(effects) != 2 {
+		t.Errorf("effects = %v", effects)
+	}
+}
+
+func TestProgram_Writes(t *testing.T) {
+	prog, err := Compile("adv.star", `
+def on_adventure(pet, outcome):
+    change("Fire_Points", 1)
+    if pet.hunger < 20:
+        change("happiness", -1)
+`, HookOnAdventure)
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+	attrs, complete := prog.Writes()
+	if !complete || len(attrs) != 2 || attrs[0] != "fire_points" || attrs[1] != "happiness" {
+		t.Errorf("Writes = %v, %v; want [fire_points happiness], true", attrs, complete)
+	}
+
+	prog, err = Compile("adv.star", `
+def on_adventure(pet, outcome):
+    for name in ["fire_points", "ice_points"]:
+        change(name, 1)
+`, HookOnAdventure)
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+	if _, complete := prog.Writes(); complete {
+		t.Error("Writes reported a computed attribute name as complete")
+	}
+}
+
+func TestProgram_Sandbox(t *testing.T) {
+	cases := map[string]string{
+		"step limit": `
+def on_adventure(pet, outcome):
+    for i in range(100000000):
+        pass
+`,
+		"read-only pet": `
+def on_adventure(pet, outcome):
+    pet.custom["x"] = 1
+`,
+		"delta limit": `
+def on_adventure(pet, outcome):
+    change("health", 1000)
+`,
+	}
+	for name, src := range cases {
+		prog, err := Compile("adv.star", src, HookOnAdventure)
+		if err != nil {
+			t.Fatalf("%s: Compile: %v", name, err)
+		}
+		if _, err := prog.Effects(PetView{Custom: map[string]int{}}, map[string]any{}); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestProgram_ChangeNotAllowedInMood(t *testing.T) {
+	prog, err := Compile("mood.star", `
+def custom_mood(pet):
+    change("health", 1)
+    return "happy"
+`, HookCustomMood)
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+	if _, err := prog.Mood(PetView{}); err == nil {
+		t.Error("custom_mood must not be able to change attributes")
+	}
+}
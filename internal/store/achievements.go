@@ -0,0 +1,180 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"time"
+
+	"clipet/internal/game"
+	"clipet/internal/plugin"
+)
+
+// achievementsFileName is the achievement progress inside a profile directory.
+const achievementsFileName = "achievements.json"
+
+// AchievementBook keeps the achievement progress of a profile: what its
+// household has unlocked, when and by which pet.
+type AchievementBook struct {
+	path string
+}
+
+// NewAchievementBook returns the achievement book for the profile directory dir.
+func NewAchievementBook(dir string) *AchievementBook {
+	return &AchievementBook{path: filepath.Join(dir, achievementsFileName)}
+}
+
+// Achievements returns the achievement book of the named profile, shared by
+// all pets of its household.
+func (m *ProfileManager) Achievements(profile string) *AchievementBook {
+	return NewAchievementBook(m.Dir(profile))
+}
+
+// AchievementsFor returns the achievement book of the profile st's pet
+// belongs to. Household pets live in {profile}/pets/{slot}/.
+func AchievementsFor(st Store) *AchievementBook {
+	dir := filepath.Dir(st.Path())
+	if parent := filepath.Dir(dir); filepath.Base(parent) == petsDirName {
+		dir = filepath.Dir(parent)
+	}
+	return NewAchievementBook(dir)
+}
+
+// Path returns the achievement file path.
+func (b *AchievementBook) Path() string {
+	return b.path
+}
+
+// Load reads the achievement progress. A missing file yields empty progress.
+func (b *AchievementBook) Load() (*game.AchievementProgress, error) {
+	data, err := os.ReadFile(b.path)
+	if os.IsNotExist(err) {
+		return game.NewAchievementProgress(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read achievements: %w", err)
+	}
+	progress := game.NewAchievementProgress()
+	if err := json.Unmarshal(data, progress); err != nil {
+		return nil, fmt.Errorf("parse achievements: %w", err)
+	}
+	return progress, nil
+}
+
+// Observe records the events of pet in the profile's progress and returns
+// the achievements they unlocked (see game.AchievementProgress.Observe).
+// Other clipet processes are locked out while the progress is updated.
+func (b *AchievementBook) Observe(pet *game.Pet, at time.Time, events ...game.AchievementEvent) ([]plugin.Achievement, error) {
+	if pet.Registry() == nil {
+		return nil, nil // no achievements to check
+	}
+	lock, err := acquireLock(b.path + ".lock")
+	if err != nil {
+		return nil, err
+	}
+	defer lock.release()
+
+	progress, err := b.Load()
+	if err != nil {
+		return nil, err
+	}
+	unlocked := progress.Observe(pet, at, events...)
+	if len(unlocked) == 0 && len(events) == 0 {
+		return nil, nil
+	}
+	return unlocked, b.save(progress)
+}
+
+// Forget removes the unlocks credited to the named pet, so a pet that
+// replaces it in its slot does not inherit them. Unlocks stay recorded in
+// the replaced pet's journal.
+func (b *AchievementBook) Forget(pet string) error {
+	lock, err := acquireLock(b.path + ".lock")
+	if err != nil {
+		return err
+	}
+	defer lock.release()
+
+	progress, err := b.Load()
+	if err != nil {
+		return err
+	}
+	n := len(progress.Unlocked)
+	maps.DeleteFunc(progress.Unlocked, func(_ string, u game.AchievementUnlock) bool {
+		return u.Pet == pet
+	})
+	if len(progress.Unlocked) == n {
+		return nil
+	}
+	return b.save(progress)
+}
+
+// save replaces the achievement file atomically.
+func (b *AchievementBook) save(progress *game.AchievementProgress) error {
+	data, err := json.MarshalIndent(progress, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal achievements: %w", err)
+	}
+	tmp := b.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("write achievements: %w", err)
+	}
+	if err := os.Rename(tmp, b.path); err != nil {
+		return fmt.Errorf("rename achievements: %w", err)
+	}
+	return nil
+}
+
+// Recorder returns a HistoryRecorder that checks achievements after every
+// action, adventure, evolution and time advance, and writes an achievement
+// event to journal for each unlock, tagged with src.
+func (b *AchievementBook) Recorder(journal *Journal, src Source) HistoryRecorder {
+	return achievementRecorder{book: b, journal: journal, source: src}
+}
+
+// achievementRecorder adapts an AchievementBook to HistoryRecorder.
+type achievementRecorder struct {
+	book    *AchievementBook
+	journal *Journal
+	source  Source
+}
+
+func (r achievementRecorder) RecordAction(at time.Time, pet *game.Pet, action string, res game.ActionResult) error {
+	var events []game.AchievementEvent
+	if res.OK {
+		events = append(events, game.AchievementEvent{Type: plugin.AchievementEventAction, Subject: action})
+	}
+	for _, c := range res.Crises {
+		if c.Phase == game.CrisisResolved {
+			events = append(events, game.AchievementEvent{Type: plugin.AchievementEventCrisis, Subject: c.ID})
+		}
+	}
+	return r.observe(at, pet, events...)
+}
+
+func (r achievementRecorder) RecordAdventure(at time.Time, pet *game.Pet, res game.AdventureResult) error {
+	return r.observe(at, pet, game.AchievementEvent{Type: plugin.AchievementEventAdventure, Subject: res.Adventure.ID})
+}
+
+func (r achievementRecorder) RecordEvolution(at time.Time, pet *game.Pet, fromStage, toStage string) error {
+	return r.observe(at, pet, game.AchievementEvent{Type: plugin.AchievementEventEvolution, Subject: toStage})
+}
+
+func (r achievementRecorder) RecordDecay(at time.Time, pet *game.Pet, rounds []game.DecayRoundResult) error {
+	return r.observe(at, pet)
+}
+
+func (r achievementRecorder) observe(at time.Time, pet *game.Pet, events ...game.AchievementEvent) error {
+	unlocked, err := r.book.Observe(pet, at, events...)
+	for _, a := range unlocked {
+		e := NewEvent(at, EventAchievement, r.source, pet)
+		e.Subject = a.Key()
+		e.Detail = a.Name
+		if jerr := r.journal.Append(e); jerr != nil && err == nil {
+			err = jerr
+		}
+	}
+	return err
+}
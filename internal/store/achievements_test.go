@@ -0,0 +1,91 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"clipet/internal/game"
+	"clipet/internal/plugin"
+)
+
+func TestHistory_Achievements(t *testing.T) {
+	pm, err := NewProfileManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewProfileManager failed: %v", err)
+	}
+	reg := plugin.NewRegistry()
+	reg.SetBuiltinAchievements([]plugin.Achievement{
+		{ID: "gourmet", Name: "Gourmet", Event: plugin.AchievementEventAction, Subject: "feed", Count: 2},
+		{ID: "old_friend", Name: "Old Friend", Condition: "age_days >= 30"},
+	})
+
+	// Both pets of the household count toward the profile's achievements
+	var pets []*game.Pet
+	var stores []Store
+	for _, slot := range []string{"", "pet2"} {
+		st, err := pm.OpenPet(DefaultProfile, slot, BackendJSON)
+		if err != nil {
+			t.Fatalf("OpenPet(%q) failed: %v", slot, err)
+		}
+		if AchievementsFor(st).Path() != pm.Achievements(DefaultProfile).Path() {
+			t.Fatalf("AchievementsFor(%q) = %s, want the profile's book", slot, AchievementsFor(st).Path())
+		}
+		pet := game.NewPet("Pet"+slot, "cat", "egg", 50, 50, 50, 50, reg)
+		pets, stores = append(pets, pet), append(stores, st)
+	}
+
+	at := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	feed := game.ActionResult{OK: true}
+	if err := History(stores[0], SourceCLI).RecordAction(at, pets[0], "feed", feed); err != nil {
+		t.Fatalf("RecordAction: %v", err)
+	}
+	// Failed actions do not count
+	if err := History(stores[1], SourceTUI).RecordAction(at, pets[1], "feed", game.ActionResult{}); err != nil {
+		t.Fatalf("RecordAction: %v", err)
+	}
+	if len(pets[1].TakeAchievements()) != 0 {
+		t.Fatal("gourmet unlocked by a failed feed")
+	}
+	if err := History(stores[1], SourceTUI).RecordAction(at, pets[1], "feed", feed); err != nil {
+		t.Fatalf("RecordAction: %v", err)
+	}
+	if got := pets[1].TakeAchievements(); len(got) != 1 || got[0].ID != "gourmet" {
+		t.Fatalf("unlocked %v, want gourmet", got)
+	}
+
+	// Time advances check conditions
+	pets[0].Birthday = at.Add(-31 * 24 * time.Hour)
+	if err := History(stores[0], SourceDaemon).RecordDecay(at, pets[0], nil); err != nil {
+		t.Fatalf("RecordDecay: %v", err)
+	}
+
+	progress, err := pm.Achievements(DefaultProfile).Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if u := progress.Unlocked["gourmet"]; u.Pet != "Petpet2" || !u.At.Equal(at) {
+		t.Errorf("gourmet unlock = %+v", u)
+	}
+	if _, ok := progress.Unlocked["old_friend"]; !ok {
+		t.Errorf("old_friend not unlocked: %+v", progress.Unlocked)
+	}
+
+	// Each unlock is written to the journal of the pet that unlocked it
+	events, _ := JournalFor(stores[1]).Events(EventFilter{Types: []EventType{EventAchievement}})
+	if len(events) != 1 || events[0].Subject != "gourmet" || events[0].Detail != "Gourmet" || events[0].Source != SourceTUI {
+		t.Errorf("achievement events = %+v", events)
+	}
+
+	// A replaced pet's unlocks are forgotten, its housemates' kept
+	book := pm.Achievements(DefaultProfile)
+	if err := book.Forget("Petpet2"); err != nil {
+		t.Fatalf("Forget: %v", err)
+	}
+	progress, _ = book.Load()
+	if _, ok := progress.Unlocked["gourmet"]; ok {
+		t.Error("Forget kept the unlock of the replaced pet")
+	}
+	if _, ok := progress.Unlocked["old_friend"]; !ok {
+		t.Error("Forget dropped a housemate's unlock")
+	}
+}
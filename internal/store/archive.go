@@ -0,0 +1,177 @@
+package store
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"clipet/internal/game"
+)
+
+// ArchiveFormat identifies clipet pet archives in their manifest.
+const ArchiveFormat = "clipet-pet"
+
+// ArchiveFormatVersion is the archive layout written by this build.
+const ArchiveFormatVersion = 1
+
+// Archive member names.
+const (
+	archiveManifestName = "manifest.json"
+	archivePetName      = "pet.json"
+)
+
+// ArchiveManifest describes the pet in an archive and what it depends on.
+// It duplicates a few pet fields so an archive can be inspected (and its
+// species checked) before the pet itself is decoded.
+type ArchiveManifest struct {
+	Format        string    `json:"format"`
+	FormatVersion int       `json:"format_version"`
+	ExportedAt    time.Time `json:"exported_at"`
+	SchemaVersion int       `json:"schema_version"`
+
+	PetName        string `json:"pet_name"`
+	StageID        string `json:"stage_id"`
+	SpeciesID      string `json:"species_id"`
+	SpeciesVersion string `json:"species_version"`
+
+	AccumulatedOfflineDuration time.Duration  `json:"accumulated_offline_duration"`
+	CustomAttributes           map[string]int `json:"custom_attributes,omitempty"`
+}
+
+// Archive is a decoded pet archive.
+type Archive struct {
+	Manifest ArchiveManifest
+	Pet      *game.Pet
+}
+
+// WriteArchive writes pet to a zip archive at path. speciesVersion is the
+// version of the species pack the pet was created with.
+// An existing file is only replaced if overwrite is set.
+func WriteArchive(path string, pet *game.Pet, speciesVersion string, overwrite bool) error {
+	flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if !overwrite {
+		flags |= os.O_EXCL
+	}
+	f, err := os.OpenFile(path, flags, 0o644)
+	if err != nil {
+		return fmt.Errorf("create archive: %w", err)
+	}
+
+	if err := writeArchive(f, pet, speciesVersion); err != nil {
+		f.Close()
+		os.Remove(path)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close archive: %w", err)
+	}
+	return nil
+}
+
+func writeArchive(w io.Writer, pet *game.Pet, speciesVersion string) error {
+	now := time.Now()
+	manifest := ArchiveManifest{
+		Format:                     ArchiveFormat,
+		FormatVersion:              ArchiveFormatVersion,
+		ExportedAt:                 now,
+		SchemaVersion:              CurrentSchemaVersion,
+		PetName:                    pet.Name,
+		StageID:                    pet.StageID,
+		SpeciesID:                  pet.Species,
+		SpeciesVersion:             speciesVersion,
+		AccumulatedOfflineDuration: pet.AccumulatedOfflineDuration,
+		CustomAttributes:           pet.CustomAttributes,
+	}
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal manifest: %w", err)
+	}
+	petData, err := encodeSave(pet, 0, true)
+	if err != nil {
+		return err
+	}
+
+	zw := zip.NewWriter(w)
+	for _, member := range []struct {
+		name string
+		data []byte
+	}{
+		{archiveManifestName, manifestData},
+		{archivePetName, petData},
+	} {
+		mw, err := zw.CreateHeader(&zip.FileHeader{Name: member.name, Method: zip.Deflate, Modified: now})
+		if err != nil {
+			return fmt.Errorf("write archive: %w", err)
+		}
+		if _, err := mw.Write(member.data); err != nil {
+			return fmt.Errorf("write archive: %w", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("write archive: %w", err)
+	}
+	return nil
+}
+
+// ReadArchive opens and decodes a pet archive. The pet document is checked
+// against its checksum and migrated to the current schema.
+func ReadArchive(path string) (*Archive, error) {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, fmt.Errorf("open archive: %w", err)
+	}
+	defer zr.Close()
+
+	manifestData, err := readArchiveMember(&zr.Reader, archiveManifestName)
+	if err != nil {
+		return nil, err
+	}
+	var manifest ArchiveManifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		return nil, fmt.Errorf("parse archive manifest: %w", err)
+	}
+	if manifest.Format != ArchiveFormat {
+		return nil, fmt.Errorf("not a clipet pet archive: %s", path)
+	}
+	if manifest.FormatVersion > ArchiveFormatVersion {
+		return nil, fmt.Errorf("archive format version %d is newer than supported version %d",
+			manifest.FormatVersion, ArchiveFormatVersion)
+	}
+
+	petData, err := readArchiveMember(&zr.Reader, archivePetName)
+	if err != nil {
+		return nil, err
+	}
+	_, pet, err := readSave(petData, true)
+	if err != nil {
+		return nil, fmt.Errorf("archive pet: %w", err)
+	}
+	if pet.Species != manifest.SpeciesID {
+		return nil, fmt.Errorf("%w: archive manifest species %q does not match pet species %q",
+			ErrCorruptSave, manifest.SpeciesID, pet.Species)
+	}
+
+	return &Archive{Manifest: manifest, Pet: pet}, nil
+}
+
+// readArchiveMember returns the contents of the named archive member.
+func readArchiveMember(zr *zip.Reader, name string) ([]byte, error) {
+	f, err := zr.Open(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("archive is missing %s", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read archive: %w", err)
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("read archive %s: %w", name, err)
+	}
+	return data, nil
+}
@@ -0,0 +1,45 @@
+package store
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"clipet/internal/game"
+)
+
+func TestArchive_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mochi.clipet")
+	pet := &game.Pet{
+		Name:                       "Mochi",
+		Species:                    "cat",
+		StageID:                    "baby",
+		Hunger:                     70,
+		Alive:                      true,
+		AccumulatedOfflineDuration: 3 * time.Hour,
+		CustomAttributes:           map[string]int{game.AccPlayful: 12},
+	}
+
+	if err := WriteArchive(path, pet, "3.1.0", false); err != nil {
+		t.Fatalf("WriteArchive: %v", err)
+	}
+	if err := WriteArchive(path, pet, "3.1.0", false); err == nil {
+		t.Error("WriteArchive should not overwrite without the flag")
+	}
+
+	archive, err := ReadArchive(path)
+	if err != nil {
+		t.Fatalf("ReadArchive: %v", err)
+	}
+	m := archive.Manifest
+	if m.SpeciesID != "cat" || m.SpeciesVersion != "3.1.0" || m.AccumulatedOfflineDuration != 3*time.Hour {
+		t.Errorf("unexpected manifest: %+v", m)
+	}
+	got := archive.Pet
+	if got.Name != "Mochi" || got.Hunger != 70 || got.CustomAttributes[game.AccPlayful] != 12 {
+		t.Errorf("unexpected pet: %+v", got)
+	}
+	if got.AccumulatedOfflineDuration != 3*time.Hour {
+		t.Errorf("offline duration = %v, want 3h", got.AccumulatedOfflineDuration)
+	}
+}
@@ -0,0 +1,63 @@
+package store
+
+import (
+	"time"
+
+	"clipet/internal/game"
+)
+
+// HistoryRecorder records what happened to the pet. It is implemented by the
+// event journal and by stores that keep their own history tables.
+// Use History to get a recorder for any Store.
+type HistoryRecorder interface {
+	// RecordAction logs a care action (feed, play, ...), successful or not.
+	RecordAction(at time.Time, pet *game.Pet, action string, res game.ActionResult) error
+	// RecordAdventure logs a completed adventure.
+	RecordAdventure(at time.Time, pet *game.Pet, res game.AdventureResult) error
+	// RecordEvolution logs a stage change.
+	RecordEvolution(at time.Time, pet *game.Pet, fromStage, toStage string) error
+	// RecordDecay logs the rounds of an offline settlement.
+	RecordDecay(at time.Time, pet *game.Pet, rounds []game.DecayRoundResult) error
+}
+
+// History returns a recorder that writes to the event journal next to st,
+// tagged with src, checks the achievements of st's profile and writes to
+// st's own history tables if it keeps any.
+func History(st Store, src Source) HistoryRecorder {
+	journal := JournalFor(st)
+	recorders := multiHistory{journal.Recorder(src), AchievementsFor(st).Recorder(journal, src)}
+	if rec, ok := st.(HistoryRecorder); ok {
+		recorders = append(recorders, rec)
+	}
+	return recorders
+}
+
+// multiHistory fans entries out to several recorders.
+// Every recorder is called; the first error is returned.
+type multiHistory []HistoryRecorder
+
+func (m multiHistory) RecordAction(at time.Time, pet *game.Pet, action string, res game.ActionResult) error {
+	return m.each(func(r HistoryRecorder) error { return r.RecordAction(at, pet, action, res) })
+}
+
+func (m multiHistory) RecordAdventure(at time.Time, pet *game.Pet, res game.AdventureResult) error {
+	return m.each(func(r HistoryRecorder) error { return r.RecordAdventure(at, pet, res) })
+}
+
+func (m multiHistory) RecordEvolution(at time.Time, pet *game.Pet, fromStage, toStage string) error {
+	return m.each(func(r HistoryRecorder) error { return r.RecordEvolution(at, pet, fromStage, toStage) })
+}
+
+func (m multiHistory) RecordDecay(at time.Time, pet *game.Pet, rounds []game.DecayRoundResult) error {
+	return m.each(func(r HistoryRecorder) error { return r.RecordDecay(at, pet, rounds) })
+}
+
+func (m multiHistory) each(fn func(HistoryRecorder) error) error {
+	var first error
+	for _, r := range m {
+		if err := fn(r); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
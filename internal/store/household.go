@@ -0,0 +1,116 @@
+package store
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// petsDirName is the subdirectory of a profile holding the household's
+// other pets, one directory per pet slot.
+const petsDirName = "pets"
+
+// petSlotRe restricts pet slot names to safe directory names.
+var petSlotRe = regexp.MustCompile(`^[a-z0-9_-]{1,32}$`)
+
+// PetInfo summarizes one pet of a profile's household.
+type PetInfo struct {
+	Slot    string // "" for the profile's own save, else its directory under pets/
+	Name    string
+	Species string
+	StageID string
+	Alive   bool
+	Err     error // set when the slot has a save that cannot be read
+}
+
+// PetDir returns the directory of a pet slot. The empty slot is the
+// profile directory itself, which holds the profile's first pet; further
+// pets live in {profile}/pets/{slot}/ with their own save, journal,
+// snapshots and daemon socket.
+func (m *ProfileManager) PetDir(profile, slot string) string {
+	if slot == "" {
+		return m.Dir(profile)
+	}
+	return filepath.Join(m.Dir(profile), petsDirName, slot)
+}
+
+// Pets lists the pets of the named profile: the profile's own pet first,
+// then the other slots by name. Slots without a save are skipped; a slot
+// whose save cannot be read is listed with Err set, so it stays occupied
+// and can still be picked for recovery.
+func (m *ProfileManager) Pets(profile string) ([]PetInfo, error) {
+	slots := []string{""}
+	entries, err := os.ReadDir(filepath.Join(m.Dir(profile), petsDirName))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("read pets dir: %w", err)
+	}
+	var others []string
+	for _, entry := range entries {
+		if entry.IsDir() && petSlotRe.MatchString(entry.Name()) {
+			others = append(others, entry.Name())
+		}
+	}
+	sort.Strings(others)
+	slots = append(slots, others...)
+
+	var list []PetInfo
+	for _, slot := range slots {
+		_, pet, err := peek(m.PetDir(profile, slot))
+		if err != nil {
+			list = append(list, PetInfo{Slot: slot, Err: err})
+			continue
+		}
+		if pet == nil {
+			continue
+		}
+		list = append(list, PetInfo{
+			Slot:    slot,
+			Name:    pet.Name,
+			Species: pet.Species,
+			StageID: pet.StageID,
+			Alive:   pet.Alive,
+		})
+	}
+	return list, nil
+}
+
+// FindPet returns the pet of the profile whose name (case-insensitive) or
+// slot matches ref.
+func (m *ProfileManager) FindPet(profile, ref string) (PetInfo, error) {
+	pets, err := m.Pets(profile)
+	if err != nil {
+		return PetInfo{}, err
+	}
+	for _, p := range pets {
+		if strings.EqualFold(p.Name, ref) || (p.Slot != "" && p.Slot == ref) {
+			return p, nil
+		}
+	}
+	return PetInfo{}, fmt.Errorf("no pet %q in profile %q", ref, profile)
+}
+
+// NewPetSlot picks the slot for a new pet of the profile: the profile's
+// own slot while it is free, else the first unused "petN".
+func (m *ProfileManager) NewPetSlot(profile string) (string, error) {
+	pets, err := m.Pets(profile)
+	if err != nil {
+		return "", err
+	}
+	used := make(map[string]bool, len(pets))
+	for _, p := range pets {
+		used[p.Slot] = true
+	}
+	if !used[""] {
+		return "", nil
+	}
+	for n := 2; ; n++ {
+		slot := "pet" + strconv.Itoa(n)
+		if _, err := os.Stat(m.PetDir(profile, slot)); !used[slot] && os.IsNotExist(err) {
+			return slot, nil
+		}
+	}
+}
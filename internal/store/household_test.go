@@ -0,0 +1,98 @@
+package store
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"clipet/internal/game"
+)
+
+func TestProfileManager_Household(t *testing.T) {
+	dataDir := t.TempDir()
+	pm, err := NewProfileManager(dataDir)
+	if err != nil {
+		t.Fatalf("NewProfileManager failed: %v", err)
+	}
+
+	// The first pet takes the profile's own slot, the next ones petN
+	for _, name := range []string{"Mochi", "Kiki", "Tofu"} {
+		slot, err := pm.NewPetSlot(DefaultProfile)
+		if err != nil {
+			t.Fatalf("NewPetSlot failed: %v", err)
+		}
+		st, err := pm.OpenPet(DefaultProfile, slot, BackendJSON)
+		if err != nil {
+			t.Fatalf("OpenPet(%q) failed: %v", slot, err)
+		}
+		pet := &game.Pet{Name: name, Species: "cat", StageID: "baby", Stage: game.StageBaby, Alive: true}
+		if err := st.Save(pet); err != nil {
+			t.Fatalf("Save %s failed: %v", name, err)
+		}
+	}
+
+	pets, err := pm.Pets(DefaultProfile)
+	if err != nil {
+		t.Fatalf("Pets failed: %v", err)
+	}
+	var got []string
+	for _, p := range pets {
+		got = append(got, p.Slot+"="+p.Name)
+	}
+	if len(got) != 3 || got[0] != "=Mochi" || got[1] != "pet2=Kiki" || got[2] != "pet3=Tofu" {
+		t.Fatalf("Pets = %v, want Mochi, Kiki in pet2, Tofu in pet3", got)
+	}
+
+	if p, err := pm.FindPet(DefaultProfile, "kiki"); err != nil || p.Slot != "pet2" {
+		t.Errorf("FindPet(kiki) = %+v, %v; want slot pet2", p, err)
+	}
+	if p, err := pm.FindPet(DefaultProfile, "pet3"); err != nil || p.Name != "Tofu" {
+		t.Errorf("FindPet(pet3) = %+v, %v; want Tofu", p, err)
+	}
+	if _, err := pm.FindPet(DefaultProfile, "Nobody"); err == nil {
+		t.Error("FindPet found a pet that does not exist")
+	}
+
+	// Prompts find a pet's status cache without loading saves
+	dir, err := PetStatusDir(dataDir, DefaultProfile, "Tofu")
+	if err != nil || dir != pm.PetDir(DefaultProfile, "pet3") {
+		t.Errorf("PetStatusDir(Tofu) = %q, %v; want %q", dir, err, pm.PetDir(DefaultProfile, "pet3"))
+	}
+
+	if _, err := pm.OpenPet(DefaultProfile, "../escape", BackendJSON); err == nil {
+		t.Error("OpenPet accepted a slot outside the profile")
+	}
+}
+
+func TestProfileManager_DamagedPetSlot(t *testing.T) {
+	pm, err := NewProfileManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewProfileManager failed: %v", err)
+	}
+	for _, slot := range []string{"", "pet2"} {
+		st, err := pm.OpenPet(DefaultProfile, slot, BackendJSON)
+		if err != nil {
+			t.Fatalf("OpenPet(%q) failed: %v", slot, err)
+		}
+		if err := st.Save(&game.Pet{Name: "Pet" + slot, Species: "cat", Alive: true}); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+	}
+	save := filepath.Join(pm.PetDir(DefaultProfile, ""), saveFileName)
+	if err := os.WriteFile(save, []byte(`{"schema_version": 2, "pet": {"na`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The damaged slot stays first and occupied
+	pets, err := pm.Pets(DefaultProfile)
+	if err != nil {
+		t.Fatalf("Pets failed: %v", err)
+	}
+	if len(pets) != 2 || pets[0].Slot != "" || pets[0].Err == nil || pets[1].Name != "Petpet2" {
+		t.Fatalf("Pets = %+v, want the damaged slot first with Err set", pets)
+	}
+	slot, err := pm.NewPetSlot(DefaultProfile)
+	if err != nil || slot != "pet3" {
+		t.Errorf("NewPetSlot = %q, %v; want pet3, not the damaged slot", slot, err)
+	}
+}
@@ -0,0 +1,79 @@
+package store
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"clipet/internal/game"
+)
+
+func TestJSONStore_ChecksumDetectsEdits(t *testing.T) {
+	st, err := NewJSONStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewJSONStore: %v", err)
+	}
+	if err := st.Save(&game.Pet{Name: "Mochi", Hunger: 50, Alive: true}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if err := st.Verify(); err != nil {
+		t.Fatalf("Verify fresh save: %v", err)
+	}
+
+	data, _ := os.ReadFile(st.Path())
+	edited := strings.Replace(string(data), `"hunger": 50`, `"hunger": 9999`, 1)
+	if err := os.WriteFile(st.Path(), []byte(edited), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := st.Verify(); !errors.Is(err, ErrChecksumMismatch) {
+		t.Fatalf("Verify edited save: got %v, want ErrChecksumMismatch", err)
+	}
+	pet, err := st.LoadUnverified()
+	if err != nil || pet.Hunger != 9999 {
+		t.Fatalf("LoadUnverified: %v, %+v", err, pet)
+	}
+}
+
+func TestJSONStore_LoadFallsBackToSnapshot(t *testing.T) {
+	st, err := NewJSONStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewJSONStore: %v", err)
+	}
+	if err := st.Save(&game.Pet{Name: "Mochi", Hunger: 42, Alive: true}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	// Truncate the save mid-document.
+	data, _ := os.ReadFile(st.Path())
+	if err := os.WriteFile(st.Path(), data[:len(data)/2], 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	pet, err := st.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if pet.Hunger != 42 {
+		t.Errorf("recovered hunger = %d, want 42", pet.Hunger)
+	}
+	if st.RecoveredFrom() == "" {
+		t.Error("RecoveredFrom should name the snapshot used")
+	}
+	if _, err := os.Stat(st.Path() + ".corrupt"); err != nil {
+		t.Errorf("damaged save not kept: %v", err)
+	}
+	if err := st.Verify(); err != nil {
+		t.Errorf("recovered save should verify: %v", err)
+	}
+}
+
+func TestReadSave_AcceptsUnsignedSaves(t *testing.T) {
+	if _, pet, err := readSave([]byte(`{"schema_version": 2, "name": "Old"}`), true); err != nil || pet.Name != "Old" {
+		t.Fatalf("readSave unsigned: %v, %+v", err, pet)
+	}
+	if _, _, err := readSave([]byte(`{"name": `), true); !errors.Is(err, ErrCorruptSave) {
+		t.Fatalf("readSave truncated: got %v, want ErrCorruptSave", err)
+	}
+}
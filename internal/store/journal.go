@@ -0,0 +1,257 @@
+package store
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"clipet/internal/game"
+)
+
+// journalFileName is the event journal inside a profile directory.
+const journalFileName = "journal.jsonl"
+
+// EventType classifies journal events.
+type EventType string
+
+const (
+	EventBirth       EventType = "birth"       // Subject: species, Detail: pet name
+	EventAction      EventType = "action"      // Subject: action ID, Detail: error type when !OK
+	EventAdventure   EventType = "adventure"   // Subject: adventure ID, Detail: outcome text
+	EventEvolution   EventType = "evolution"   // Subject: new stage ID, Detail: old stage ID
+	EventDecay       EventType = "decay"       // Detail: offline duration, Changes: start -> end attrs
+	EventDeath       EventType = "death"       // Detail: ending type
+	EventCrisis      EventType = "crisis"      // Subject: crisis ID, Detail: phase (started, resolved, failed)
+	EventAchievement EventType = "achievement" // Subject: achievement key, Detail: achievement name
+	EventEdit        EventType = "edit"        // Subject: field, Detail: "old -> new" (dev tools)
+)
+
+// EventTypes lists all known event types in display order.
+var EventTypes = []EventType{
+	EventBirth, EventAction, EventAdventure, EventEvolution, EventDecay, EventCrisis, EventAchievement, EventDeath, EventEdit,
+}
+
+// Source identifies which front end produced an event.
+type Source string
+
+const (
+	SourceCLI    Source = "cli"
+	SourceTUI    Source = "tui"
+	SourceDev    Source = "dev"
+	SourceDaemon Source = "daemon"
+)
+
+// Event is one entry of the pet's life journal.
+type Event struct {
+	Time    time.Time         `json:"time"`
+	Type    EventType         `json:"type"`
+	Source  Source            `json:"source"`
+	Pet     string            `json:"pet"`
+	StageID string            `json:"stage_id"`
+	Subject string            `json:"subject,omitempty"`
+	OK      bool              `json:"ok"`
+	Detail  string            `json:"detail,omitempty"`
+	Changes map[string][2]int `json:"changes,omitempty"`
+}
+
+// EventFilter selects journal events. Zero values match everything.
+type EventFilter struct {
+	Types []EventType
+	Since time.Time
+	Until time.Time
+	Limit int // keep only the newest Limit events (0 = no limit)
+}
+
+// Match reports whether e passes the filter (ignoring Limit).
+func (f EventFilter) Match(e Event) bool {
+	if len(f.Types) > 0 && !slices.Contains(f.Types, e.Type) {
+		return false
+	}
+	if !f.Since.IsZero() && e.Time.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && e.Time.After(f.Until) {
+		return false
+	}
+	return true
+}
+
+// Journal is an append-only JSON Lines event log stored next to a save.
+type Journal struct {
+	path string
+}
+
+// NewJournal returns the journal for the profile directory dir.
+func NewJournal(dir string) *Journal {
+	return &Journal{path: filepath.Join(dir, journalFileName)}
+}
+
+// JournalFor returns the journal stored next to st's save file.
+func JournalFor(st Store) *Journal {
+	return NewJournal(filepath.Dir(st.Path()))
+}
+
+// Path returns the journal file path.
+func (j *Journal) Path() string {
+	return j.path
+}
+
+// Append writes one event to the end of the journal.
+func (j *Journal) Append(e Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	f, err := os.OpenFile(j.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("open journal: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("write journal: %w", err)
+	}
+	return nil
+}
+
+// Archive moves the journal aside as journal.<tag>.jsonl, so the slot's
+// next pet starts with an empty journal while the old one stays readable.
+// It returns the new path, or "" if there was no journal.
+func (j *Journal) Archive(tag string) (string, error) {
+	archived := strings.TrimSuffix(j.path, ".jsonl") + "." + tag + ".jsonl"
+	if err := os.Rename(j.path, archived); err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("archive journal: %w", err)
+	}
+	return archived, nil
+}
+
+// Events reads the journal oldest-first and returns the events matching filter.
+// A missing journal yields no events. Malformed lines are skipped.
+func (j *Journal) Events(filter EventFilter) ([]Event, error) {
+	f, err := os.Open(j.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open journal: %w", err)
+	}
+	defer f.Close()
+
+	var events []Event
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var e Event
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		if filter.Match(e) {
+			events = append(events, e)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read journal: %w", err)
+	}
+
+	if filter.Limit > 0 && len(events) > filter.Limit {
+		events = events[len(events)-filter.Limit:]
+	}
+	return events, nil
+}
+
+// Recorder returns a HistoryRecorder that appends to this journal,
+// tagging every event with src.
+func (j *Journal) Recorder(src Source) HistoryRecorder {
+	return journalRecorder{journal: j, source: src}
+}
+
+// NewEvent returns an event with the fields shared by all events filled in.
+func NewEvent(at time.Time, typ EventType, src Source, pet *game.Pet) Event {
+	return Event{
+		Time:    at,
+		Type:    typ,
+		Source:  src,
+		Pet:     pet.Name,
+		StageID: pet.StageID,
+		OK:      true,
+	}
+}
+
+// AppendCrises writes one crisis event per entry of crises.
+func (j *Journal) AppendCrises(src Source, pet *game.Pet, crises []game.CrisisEvent) error {
+	for _, c := range crises {
+		e := NewEvent(c.At, EventCrisis, src, pet)
+		e.Subject = c.ID
+		e.Detail = c.Phase
+		e.OK = c.Phase != game.CrisisFailed
+		e.Changes = c.Changes
+		if err := j.Append(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// journalRecorder adapts a Journal to HistoryRecorder.
+type journalRecorder struct {
+	journal *Journal
+	source  Source
+}
+
+func (r journalRecorder) RecordAction(at time.Time, pet *game.Pet, action string, res game.ActionResult) error {
+	e := NewEvent(at, EventAction, r.source, pet)
+	e.Subject = action
+	e.OK = res.OK
+	e.Detail = res.ErrorType
+	e.Changes = res.Changes
+	if err := r.journal.Append(e); err != nil {
+		return err
+	}
+	return r.journal.AppendCrises(r.source, pet, res.Crises)
+}
+
+func (r journalRecorder) RecordAdventure(at time.Time, pet *game.Pet, res game.AdventureResult) error {
+	e := NewEvent(at, EventAdventure, r.source, pet)
+	e.Subject = res.Adventure.ID
+	e.Detail = res.Outcome.Text
+	e.Changes = res.Changes
+	return r.journal.Append(e)
+}
+
+func (r journalRecorder) RecordEvolution(at time.Time, pet *game.Pet, fromStage, toStage string) error {
+	e := NewEvent(at, EventEvolution, r.source, pet)
+	e.Subject = toStage
+	e.Detail = fromStage
+	return r.journal.Append(e)
+}
+
+func (r journalRecorder) RecordDecay(at time.Time, pet *game.Pet, rounds []game.DecayRoundResult) error {
+	if len(rounds) == 0 {
+		return nil
+	}
+
+	var total time.Duration
+	for _, round := range rounds {
+		total += round.Duration
+	}
+	first, last := rounds[0].StartAttrs, rounds[len(rounds)-1].EndAttrs
+
+	e := NewEvent(at, EventDecay, r.source, pet)
+	e.Detail = total.Round(time.Minute).String()
+	e.Changes = make(map[string][2]int)
+	for i, name := range []string{"hunger", "happiness", "health", "energy"} {
+		if first[i] != last[i] {
+			e.Changes[name] = [2]int{first[i], last[i]}
+		}
+	}
+	return r.journal.Append(e)
+}
@@ -0,0 +1,79 @@
+package store
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"clipet/internal/game"
+)
+
+func TestJournal_AppendAndFilter(t *testing.T) {
+	j := NewJournal(t.TempDir())
+	pet := &game.Pet{Name: "Mochi", StageID: "baby"}
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	if events, err := j.Events(EventFilter{}); err != nil || events != nil {
+		t.Fatalf("missing journal: got %v, %v", events, err)
+	}
+
+	rec := j.Recorder(SourceTUI)
+	for i, action := range []string{"feed", "play", "rest"} {
+		res := game.ActionResult{OK: true, Changes: map[string][2]int{"hunger": {50, 60}}}
+		if err := rec.RecordAction(base.Add(time.Duration(i)*time.Hour), pet, action, res); err != nil {
+			t.Fatalf("RecordAction: %v", err)
+		}
+	}
+	if err := rec.RecordEvolution(base.Add(4*time.Hour), pet, "egg", "baby"); err != nil {
+		t.Fatalf("RecordEvolution: %v", err)
+	}
+
+	all, err := j.Events(EventFilter{})
+	if err != nil {
+		t.Fatalf("Events: %v", err)
+	}
+	if len(all) != 4 || all[0].Subject != "feed" || all[0].Source != SourceTUI {
+		t.Fatalf("unexpected events: %+v", all)
+	}
+	if all[0].Changes["hunger"] != [2]int{50, 60} {
+		t.Errorf("changes not round-tripped: %v", all[0].Changes)
+	}
+
+	actions, _ := j.Events(EventFilter{Types: []EventType{EventAction}})
+	if len(actions) != 3 {
+		t.Errorf("type filter: got %d events, want 3", len(actions))
+	}
+
+	ranged, _ := j.Events(EventFilter{Since: base.Add(time.Hour), Until: base.Add(2 * time.Hour)})
+	if len(ranged) != 2 || ranged[0].Subject != "play" {
+		t.Errorf("time filter: got %+v", ranged)
+	}
+
+	newest, _ := j.Events(EventFilter{Limit: 2})
+	if len(newest) != 2 || newest[1].Type != EventEvolution {
+		t.Errorf("limit should keep the newest events: got %+v", newest)
+	}
+}
+
+func TestJournal_Archive(t *testing.T) {
+	j := NewJournal(t.TempDir())
+	if path, err := j.Archive("import"); err != nil || path != "" {
+		t.Fatalf("Archive without a journal = %q, %v", path, err)
+	}
+
+	if err := j.Append(NewEvent(time.Now(), EventBirth, SourceCLI, &game.Pet{Name: "Mochi"})); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+	path, err := j.Archive("import")
+	if err != nil {
+		t.Fatalf("Archive: %v", err)
+	}
+	if events, _ := NewJournal(filepath.Dir(path)).Events(EventFilter{}); len(events) != 0 {
+		t.Errorf("journal after Archive = %+v, want empty", events)
+	}
+	if data, err := os.ReadFile(path); err != nil || !strings.Contains(string(data), "Mochi") {
+		t.Errorf("archived journal %s = %q, %v", path, data, err)
+	}
+}
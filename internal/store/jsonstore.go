@@ -2,16 +2,39 @@ package store
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"clipet/internal/game"
 )
 
-// JSONStore implements Store using a JSON file.
+// JSONStore implements Store, Snapshotter and Syncer using a JSON file.
+// Every save is also copied into a snapshot ring next to it.
+//
+// Operations hold an advisory lock on save.json.lock, and every save bumps
+// a revision counter so concurrent clipet processes cannot silently
+// overwrite each other's changes.
 type JSONStore struct {
-	path string
+	path          string
+	snapshots     snapshotRing
+	recoveredFrom string // set when the last Load fell back to another file
+
+	revision int64     // revision last loaded or written
+	synced   bool      // revision is known (after Load or Save)
+	modTime  time.Time // save file mtime when revision was last checked
+	size     int64     // save file size when revision was last checked
+}
+
+// saveFile is the on-disk layout: the pet fields plus a header holding the
+// schema version, the revision counter and a checksum of everything else.
+type saveFile struct {
+	SchemaVersion int    `json:"schema_version"`
+	Revision      int64  `json:"revision,omitempty"`
+	Checksum      string `json:"checksum,omitempty"`
+	*game.Pet
 }
 
 // NewJSONStore creates a new JSONStore.
@@ -30,18 +53,282 @@ func NewJSONStore(dir string) (*JSONStore, error) {
 	}
 
 	return &JSONStore{
-		path: filepath.Join(dir, "save.json"),
+		path:      filepath.Join(dir, "save.json"),
+		snapshots: snapshotRing{dir: filepath.Join(dir, snapshotsDirName), policy: DefaultSnapshotPolicy},
 	}, nil
 }
 
 // Save writes the pet state to a JSON file atomically.
+// It returns ErrConflict if another process saved since this store last
+// loaded or saved the pet.
 func (s *JSONStore) Save(pet *game.Pet) error {
-	data, err := json.MarshalIndent(pet, "", "  ")
+	lock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer lock.release()
+	return s.save(pet)
+}
+
+// save writes pet with the next revision. The caller holds the lock.
+// Saving a pet that did not change since the last save writes nothing, so
+// no-op saves neither bump the revision nor push snapshots out of the ring.
+func (s *JSONStore) save(pet *game.Pet) error {
+	saved, _ := os.ReadFile(s.path)
+	current := saveRevision(saved)
+	if s.synced && current != s.revision {
+		return ErrConflict
+	}
+
+	data, err := encodeSave(pet, current+1, true)
+	if err != nil {
+		return err
+	}
+	if samePet(saved, data) {
+		s.remember(current)
+		return nil
+	}
+	if err := s.writeFile(data); err != nil {
+		return err
+	}
+	s.remember(current + 1)
+	// The status cache only feeds shell prompts; a stale one is harmless.
+	_ = writeStatus(filepath.Dir(s.path), pet)
+	if s.snapshots.dir != "" {
+		return s.snapshots.record(data, time.Now())
+	}
+	return nil
+}
+
+// Load reads the pet state from the JSON file.
+// Saves written with an older schema are migrated in memory; the original
+// file is backed up first so the next Save can safely overwrite it.
+//
+// If the save is damaged or fails its checksum, Load falls back to the last
+// good copy: a leftover temp file, the newest valid snapshot, then schema
+// backups. The recovered copy replaces the save and the damaged file is kept
+// as save.json.corrupt.
+func (s *JSONStore) Load() (*game.Pet, error) {
+	lock, err := s.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer lock.release()
+
+	s.recoveredFrom = ""
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("read save file: %w", err)
+	}
+
+	pet, err := s.decode(data, true)
+	if err == nil {
+		s.remember(saveRevision(data))
+		return pet, nil
+	}
+	if !errors.Is(err, ErrCorruptSave) {
+		return nil, err
+	}
+
+	for _, candidate := range s.fallbackPaths() {
+		good, rerr := os.ReadFile(candidate)
+		if rerr != nil {
+			continue
+		}
+		_, pet, rerr := readSave(good, true)
+		if rerr != nil {
+			continue
+		}
+		if rerr := s.replaceCorrupt(data, good); rerr != nil {
+			return nil, rerr
+		}
+		s.recoveredFrom = candidate
+		s.remember(saveRevision(good))
+		return pet, nil
+	}
+	return nil, err
+}
+
+// LoadUnverified implements Verifier.
+func (s *JSONStore) LoadUnverified() (*game.Pet, error) {
+	lock, err := s.lock()
 	if err != nil {
-		return fmt.Errorf("marshal pet: %w", err)
+		return nil, err
 	}
+	defer lock.release()
 
-	// Atomic write: write to temp file then rename
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("read save file: %w", err)
+	}
+	pet, err := s.decode(data, false)
+	if err != nil {
+		return nil, err
+	}
+	s.remember(saveRevision(data))
+	return pet, nil
+}
+
+// Verify implements Verifier.
+func (s *JSONStore) Verify() error {
+	lock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer lock.release()
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("read save file: %w", err)
+	}
+	_, _, err = readSave(data, true)
+	return err
+}
+
+// RecoveredFrom implements Recoverer.
+func (s *JSONStore) RecoveredFrom() string {
+	return s.recoveredFrom
+}
+
+// Revision implements Syncer.
+func (s *JSONStore) Revision() int64 {
+	return s.revision
+}
+
+// Changed implements Syncer. It only re-reads the save when its
+// modification time moved, so it is cheap enough to poll.
+func (s *JSONStore) Changed() (bool, error) {
+	if !s.synced {
+		return false, nil
+	}
+	info, err := os.Stat(s.path)
+	if os.IsNotExist(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("stat save file: %w", err)
+	}
+	if info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return false, nil
+	}
+
+	if s.currentRevision() != s.revision {
+		return true, nil
+	}
+	s.modTime, s.size = info.ModTime(), info.Size()
+	return false, nil
+}
+
+// lock takes the advisory lock guarding the save file.
+func (s *JSONStore) lock() (*fileLock, error) {
+	return acquireLock(s.path + ".lock")
+}
+
+// currentRevision reads the revision of the save on disk (0 if missing).
+func (s *JSONStore) currentRevision() int64 {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return 0
+	}
+	return saveRevision(data)
+}
+
+// remember records rev as the revision this store last saw.
+func (s *JSONStore) remember(rev int64) {
+	s.revision = rev
+	s.synced = true
+	if info, err := os.Stat(s.path); err == nil {
+		s.modTime, s.size = info.ModTime(), info.Size()
+	}
+}
+
+// decode reads save data, backing up saves that need a schema upgrade.
+func (s *JSONStore) decode(data []byte, verify bool) (*game.Pet, error) {
+	res, pet, err := readSave(data, verify)
+	if err != nil {
+		return nil, err
+	}
+	if res.Upgraded() {
+		if err := s.backup(data, res.FromVersion); err != nil {
+			return nil, err
+		}
+	}
+	return pet, nil
+}
+
+// fallbackPaths lists the copies Load may recover from, best first.
+func (s *JSONStore) fallbackPaths() []string {
+	paths := []string{s.path + ".tmp"}
+	if list, err := s.snapshots.list(); err == nil {
+		for _, snap := range list {
+			paths = append(paths, snap.Path)
+		}
+	}
+	for v := CurrentSchemaVersion - 1; v >= 1; v-- {
+		paths = append(paths, s.BackupPath(v))
+	}
+	return paths
+}
+
+// replaceCorrupt keeps the damaged save as save.json.corrupt and writes the
+// recovered copy in its place.
+func (s *JSONStore) replaceCorrupt(damaged, good []byte) error {
+	if err := os.WriteFile(s.path+".corrupt", damaged, 0o644); err != nil {
+		return fmt.Errorf("keep damaged save: %w", err)
+	}
+	return s.writeFile(good)
+}
+
+// Migrate upgrades the save file on disk to CurrentSchemaVersion.
+// The original file is backed up before it is rewritten.
+// With dryRun set, the migration is computed but nothing is written.
+func (s *JSONStore) Migrate(dryRun bool) (*MigrationResult, error) {
+	lock, err := s.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer lock.release()
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("read save file: %w", err)
+	}
+
+	res, pet, err := readSave(data, true)
+	if err != nil || !res.Upgraded() || dryRun {
+		return res, err
+	}
+
+	if err := s.backup(data, res.FromVersion); err != nil {
+		return nil, err
+	}
+	s.remember(saveRevision(data))
+	if err := s.save(pet); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// BackupPath returns the path used to back up a save of the given schema version.
+func (s *JSONStore) BackupPath(version int) string {
+	return fmt.Sprintf("%s.v%d.bak", s.path, version)
+}
+
+// backup keeps a copy of raw save data before a schema upgrade.
+// An existing backup for the same version is never overwritten.
+func (s *JSONStore) backup(data []byte, version int) error {
+	path := s.BackupPath(version)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("backup save file: %w", err)
+	}
+	return nil
+}
+
+// writeFile replaces the save file atomically: write to temp file then rename.
+func (s *JSONStore) writeFile(data []byte) error {
 	tmpPath := s.path + ".tmp"
 	if err := os.WriteFile(tmpPath, data, 0o644); err != nil {
 		return fmt.Errorf("write temp file: %w", err)
@@ -54,18 +341,101 @@ func (s *JSONStore) Save(pet *game.Pet) error {
 	return nil
 }
 
-// Load reads the pet state from the JSON file.
-func (s *JSONStore) Load() (*game.Pet, error) {
+// Snapshot implements Snapshotter.
+func (s *JSONStore) Snapshot(reason string) (*Snapshot, error) {
+	lock, err := s.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer lock.release()
+	return s.snapshot(reason)
+}
+
+// snapshot copies the current save. The caller holds the lock.
+func (s *JSONStore) snapshot(reason string) (*Snapshot, error) {
 	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, fmt.Errorf("read save file: %w", err)
 	}
 
+	snap, err := s.snapshots.write(data, time.Now(), reason)
+	if err != nil {
+		return nil, err
+	}
+	return snap, s.snapshots.prune()
+}
+
+// Snapshots implements Snapshotter.
+func (s *JSONStore) Snapshots() ([]Snapshot, error) {
+	return s.snapshots.list()
+}
+
+// Restore implements Snapshotter. The restored save gets a new revision so
+// running clipet processes notice the change.
+func (s *JSONStore) Restore(id string) error {
+	lock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer lock.release()
+
+	snap, err := s.snapshots.find(id)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(snap.Path)
+	if err != nil {
+		return fmt.Errorf("read snapshot: %w", err)
+	}
+	_, pet, err := readSave(data, true)
+	if err != nil {
+		return fmt.Errorf("snapshot %s: %w", id, err)
+	}
+
+	if _, err := s.snapshot("restore"); err != nil {
+		return err
+	}
+	rev := s.currentRevision() + 1
+	data, err = encodeSave(pet, rev, true)
+	if err != nil {
+		return err
+	}
+	if err := s.writeFile(data); err != nil {
+		return err
+	}
+	s.remember(rev)
+	_ = writeStatus(filepath.Dir(s.path), pet)
+	return nil
+}
+
+// LoadSnapshot implements Snapshotter.
+func (s *JSONStore) LoadSnapshot(id string) (*game.Pet, error) {
+	snap, err := s.snapshots.find(id)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(snap.Path)
+	if err != nil {
+		return nil, fmt.Errorf("read snapshot: %w", err)
+	}
+	_, pet, err := readSave(data, true)
+	return pet, err
+}
+
+// decodePet converts a migrated save document into a Pet.
+func decodePet(doc map[string]any) (*game.Pet, error) {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("encode save document: %w", err)
+	}
+
 	var pet game.Pet
 	if err := json.Unmarshal(data, &pet); err != nil {
 		return nil, fmt.Errorf("unmarshal pet: %w", err)
 	}
-
 	return &pet, nil
 }
 
@@ -75,6 +445,22 @@ func (s *JSONStore) Exists() bool {
 	return err == nil
 }
 
+// Delete removes the save file.
+func (s *JSONStore) Delete() error {
+	lock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer lock.release()
+
+	if err := os.Remove(s.path); err != nil {
+		return fmt.Errorf("delete save file: %w", err)
+	}
+	removeStatus(filepath.Dir(s.path))
+	s.synced = false
+	return nil
+}
+
 // Path returns the save file path.
 func (s *JSONStore) Path() string {
 	return s.path
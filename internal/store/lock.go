@@ -0,0 +1,61 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// lockTimeout bounds how long a store operation waits for another
+// clipet process to release the save.
+const lockTimeout = 5 * time.Second
+
+// lockRetryInterval is the polling interval while waiting for a lock.
+const lockRetryInterval = 20 * time.Millisecond
+
+var (
+	// ErrLocked is returned when another process holds the save lock for
+	// longer than lockTimeout.
+	ErrLocked = errors.New("save is locked by another clipet process")
+	// ErrConflict is returned by Save when another process saved the pet
+	// after it was loaded. Reload and retry.
+	ErrConflict = errors.New("save was modified by another clipet process")
+)
+
+// fileLock is an advisory exclusive lock held on a companion lock file.
+// Locks are per open file, so a process must not nest them on the same path.
+type fileLock struct {
+	f *os.File
+}
+
+// acquireLock takes the exclusive lock on path, creating the file if needed.
+func acquireLock(path string) (*fileLock, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open lock file: %w", err)
+	}
+
+	deadline := time.Now().Add(lockTimeout)
+	for {
+		ok, err := tryLockFile(f)
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("lock save: %w", err)
+		}
+		if ok {
+			return &fileLock{f: f}, nil
+		}
+		if time.Now().After(deadline) {
+			f.Close()
+			return nil, ErrLocked
+		}
+		time.Sleep(lockRetryInterval)
+	}
+}
+
+// release drops the lock.
+func (l *fileLock) release() {
+	_ = unlockFile(l.f)
+	l.f.Close()
+}
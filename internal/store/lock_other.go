@@ -0,0 +1,15 @@
+//go:build !unix
+
+package store
+
+import "os"
+
+// tryLockFile is a no-op on platforms without flock; the revision check in
+// Save still detects concurrent writers.
+func tryLockFile(f *os.File) (bool, error) {
+	return true, nil
+}
+
+func unlockFile(f *os.File) error {
+	return nil
+}
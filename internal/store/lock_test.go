@@ -0,0 +1,100 @@
+package store
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"clipet/internal/game"
+)
+
+func TestJSONStore_RevisionConflict(t *testing.T) {
+	dir := t.TempDir()
+	tui, _ := NewJSONStore(dir)
+	cli, _ := NewJSONStore(dir)
+
+	if err := tui.Save(&game.Pet{Name: "Mochi", Hunger: 50}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	pet, err := cli.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	pet.Hunger = 90
+	if err := cli.Save(pet); err != nil {
+		t.Fatalf("cli Save: %v", err)
+	}
+	if cli.Revision() != 2 {
+		t.Errorf("revision after two saves = %d, want 2", cli.Revision())
+	}
+
+	changed, err := tui.Changed()
+	if err != nil || !changed {
+		t.Fatalf("Changed = %v, %v; want true", changed, err)
+	}
+	if err := tui.Save(&game.Pet{Name: "Mochi", Hunger: 10}); !errors.Is(err, ErrConflict) {
+		t.Fatalf("stale Save: got %v, want ErrConflict", err)
+	}
+
+	reloaded, err := tui.Load()
+	if err != nil || reloaded.Hunger != 90 {
+		t.Fatalf("reload: %v, %+v", err, reloaded)
+	}
+	if changed, _ := tui.Changed(); changed {
+		t.Error("Changed should be false after reload")
+	}
+	if err := tui.Save(reloaded); err != nil {
+		t.Errorf("Save after reload: %v", err)
+	}
+}
+
+func TestSaveOrReload(t *testing.T) {
+	dir := t.TempDir()
+	tui, _ := NewJSONStore(dir)
+	cli, _ := NewJSONStore(dir)
+
+	pet := &game.Pet{Name: "Mochi", Hunger: 50}
+	if err := SaveOrReload(tui, pet); err != nil {
+		t.Fatalf("SaveOrReload: %v", err)
+	}
+	other, _ := cli.Load()
+	other.Hunger = 90
+	if err := cli.Save(other); err != nil {
+		t.Fatalf("cli Save: %v", err)
+	}
+
+	// The other process's save wins and replaces the stale state
+	pet.Hunger = 10
+	if err := SaveOrReload(tui, pet); !errors.Is(err, ErrConflict) {
+		t.Fatalf("stale SaveOrReload: got %v, want ErrConflict", err)
+	}
+	if pet.Hunger != 90 {
+		t.Errorf("hunger after conflict = %d, want the stored 90", pet.Hunger)
+	}
+	pet.Hunger = 60
+	if err := SaveOrReload(tui, pet); err != nil {
+		t.Errorf("SaveOrReload after reload: %v", err)
+	}
+}
+
+func TestJSONStore_SaveWaitsForLock(t *testing.T) {
+	st, _ := NewJSONStore(t.TempDir())
+	lock, err := acquireLock(st.Path() + ".lock")
+	if err != nil {
+		t.Fatalf("acquireLock: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- st.Save(&game.Pet{Name: "Mochi"}) }()
+
+	select {
+	case err := <-done:
+		t.Fatalf("Save finished while the lock was held: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	lock.release()
+	if err := <-done; err != nil {
+		t.Fatalf("Save after release: %v", err)
+	}
+}
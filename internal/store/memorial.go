@@ -0,0 +1,138 @@
+package store
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"clipet/internal/game"
+)
+
+// memorialFileName is the memorial hall inside a profile directory.
+const memorialFileName = "memorial.jsonl"
+
+// MemorialEntry remembers a pet that has passed away.
+type MemorialEntry struct {
+	Name          string         `json:"name"`
+	Species       string         `json:"species"`
+	Generation    int            `json:"generation"`
+	StageID       string         `json:"stage_id"` // final evolution node
+	Stage         string         `json:"stage"`    // final life phase
+	Born          time.Time      `json:"born"`
+	Died          time.Time      `json:"died"`
+	EndingType    string         `json:"ending_type,omitempty"`
+	EndingMessage string         `json:"ending_message,omitempty"`
+	Traits        []string       `json:"traits,omitempty"`
+	Stats         map[string]int `json:"stats"`
+	Successor     string         `json:"successor,omitempty"`
+}
+
+// NewMemorialEntry records the dead pet for the memorial hall. Pets saved
+// before deaths were timestamped count as dying when they were last
+// checked.
+func NewMemorialEntry(pet *game.Pet, successor string) MemorialEntry {
+	died := pet.DiedAt
+	if died.IsZero() {
+		died = pet.LastCheckedAt
+	}
+	return MemorialEntry{
+		Name:          pet.Name,
+		Species:       pet.Species,
+		Generation:    pet.GenerationNumber(),
+		StageID:       pet.StageID,
+		Stage:         string(pet.Stage),
+		Born:          pet.Birthday,
+		Died:          died,
+		EndingType:    pet.EndingType,
+		EndingMessage: pet.EndingMessage,
+		Traits:        pet.TraitIDs(),
+		Stats: map[string]int{
+			"hunger":       pet.Hunger,
+			"happiness":    pet.Happiness,
+			"health":       pet.Health,
+			"energy":       pet.Energy,
+			"interactions": pet.TotalInteractions,
+			"adventures":   pet.AdventuresCompleted,
+			"games_won":    pet.GamesWon,
+			"dialogues":    pet.DialogueCount,
+			"coins":        pet.Coins,
+		},
+		Successor: successor,
+	}
+}
+
+// Lifespan returns how long the pet lived.
+func (e MemorialEntry) Lifespan() time.Duration {
+	return max(e.Died.Sub(e.Born), 0)
+}
+
+// Memorial is the append-only memorial hall of a profile, in JSON Lines.
+type Memorial struct {
+	path string
+}
+
+// NewMemorial returns the memorial hall for the profile directory dir.
+func NewMemorial(dir string) *Memorial {
+	return &Memorial{path: filepath.Join(dir, memorialFileName)}
+}
+
+// Memorial returns the memorial hall of the named profile, shared by all
+// pets of its household.
+func (m *ProfileManager) Memorial(profile string) *Memorial {
+	return NewMemorial(m.Dir(profile))
+}
+
+// Path returns the memorial file path.
+func (m *Memorial) Path() string {
+	return m.path
+}
+
+// Add writes one entry to the end of the memorial hall.
+func (m *Memorial) Add(e MemorialEntry) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("marshal memorial entry: %w", err)
+	}
+
+	f, err := os.OpenFile(m.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("open memorial: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("write memorial: %w", err)
+	}
+	return nil
+}
+
+// Entries reads the memorial hall oldest-first. A missing hall yields no
+// entries. Malformed lines are skipped.
+func (m *Memorial) Entries() ([]MemorialEntry, error) {
+	f, err := os.Open(m.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open memorial: %w", err)
+	}
+	defer f.Close()
+
+	var entries []MemorialEntry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var e MemorialEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read memorial: %w", err)
+	}
+	return entries, nil
+}
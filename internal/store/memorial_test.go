@@ -0,0 +1,58 @@
+package store
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"clipet/internal/game"
+)
+
+func TestMemorial_AddAndEntries(t *testing.T) {
+	m := NewMemorial(t.TempDir())
+	if entries, err := m.Entries(); err != nil || entries != nil {
+		t.Fatalf("missing memorial: got %v, %v", entries, err)
+	}
+
+	born := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	pet := &game.Pet{
+		Name: "Mochi", Species: "cat", Stage: game.StageAdult, StageID: "adult_shadow",
+		Birthday: born, DiedAt: born.Add(72 * time.Hour), EndingType: "peaceful_rest",
+		Health: 0, TotalInteractions: 120, Traits: []string{"night_owl"},
+	}
+	if err := m.Add(NewMemorialEntry(pet, "Mochi II")); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	// Pets that died before deaths were timestamped fall back to their last check
+	old := &game.Pet{Name: "Old", Birthday: born, LastCheckedAt: born.Add(time.Hour), Generation: 2}
+	if err := m.Add(NewMemorialEntry(old, "")); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	// Malformed lines are skipped
+	f, err := os.OpenFile(m.Path(), os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString("{broken\n")
+	f.Close()
+
+	entries, err := m.Entries()
+	if err != nil {
+		t.Fatalf("Entries: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(entries))
+	}
+	e := entries[0]
+	if e.Name != "Mochi" || e.Successor != "Mochi II" || e.Generation != 1 || e.Stage != "adult" {
+		t.Errorf("entry = %+v", e)
+	}
+	if e.Lifespan() != 72*time.Hour || e.Stats["interactions"] != 120 {
+		t.Errorf("lifespan = %v, stats = %v", e.Lifespan(), e.Stats)
+	}
+	if entries[1].Lifespan() != time.Hour || entries[1].Generation != 2 {
+		t.Errorf("old entry = %+v", entries[1])
+	}
+}
@@ -0,0 +1,230 @@
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// CurrentSchemaVersion is the save schema written by this build.
+// Saves without a schema_version field are treated as version 1.
+const CurrentSchemaVersion = 2
+
+// schemaVersionKey is the top-level save field holding the schema version.
+const schemaVersionKey = "schema_version"
+
+// Migration upgrades a raw save document by exactly one schema version.
+type Migration struct {
+	From        int    // version the migration upgrades from (to From+1)
+	Description string // short human-readable summary
+	Apply       func(doc map[string]any) error
+}
+
+// migrations is the ordered list of schema upgrades.
+// Append new entries here when bumping CurrentSchemaVersion.
+var migrations = []Migration{
+	{
+		From:        1,
+		Description: "move legacy acc_happiness/acc_health/acc_playful into custom_attributes",
+		Apply:       migrateLegacyAccumulators,
+	},
+}
+
+// MigrationResult describes the outcome of migrating a save document.
+type MigrationResult struct {
+	FromVersion int
+	ToVersion   int
+	Applied     []Migration
+	Before      map[string]any // document as read from disk
+	After       map[string]any // document after all migrations
+}
+
+// Upgraded returns true if at least one migration was applied.
+func (r *MigrationResult) Upgraded() bool {
+	return len(r.Applied) > 0
+}
+
+// MigrateSave decodes raw save data and applies all pending migrations.
+// The input is not modified; the upgraded document is returned in After.
+func MigrateSave(data []byte) (*MigrationResult, error) {
+	before, err := decodeDocument(data)
+	if err != nil {
+		return nil, err
+	}
+	after, err := decodeDocument(data)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := documentVersion(after)
+	if err != nil {
+		return nil, err
+	}
+	if version > CurrentSchemaVersion {
+		return nil, fmt.Errorf("save schema version %d is newer than supported version %d", version, CurrentSchemaVersion)
+	}
+
+	res := &MigrationResult{
+		FromVersion: version,
+		ToVersion:   version,
+		Before:      before,
+		After:       after,
+	}
+
+	for _, m := range migrations {
+		if m.From < res.ToVersion {
+			continue
+		}
+		if m.From != res.ToVersion {
+			return nil, fmt.Errorf("no migration from schema version %d", res.ToVersion)
+		}
+		if err := m.Apply(after); err != nil {
+			return nil, fmt.Errorf("migrate v%d -> v%d: %w", m.From, m.From+1, err)
+		}
+		res.ToVersion = m.From + 1
+		res.Applied = append(res.Applied, m)
+	}
+	if res.ToVersion != CurrentSchemaVersion {
+		return nil, fmt.Errorf("no migration from schema version %d", res.ToVersion)
+	}
+
+	after[schemaVersionKey] = json.Number(fmt.Sprint(CurrentSchemaVersion))
+	return res, nil
+}
+
+// DiffEntry is a single changed key between two save documents.
+// Nested objects are flattened with dotted keys (custom_attributes.foo).
+type DiffEntry struct {
+	Key    string
+	Before any // nil if the key was added
+	After  any // nil if the key was removed
+}
+
+// Diff returns the key-level differences between Before and After, sorted by key.
+func (r *MigrationResult) Diff() []DiffEntry {
+	before := flatten("", r.Before, map[string]any{})
+	after := flatten("", r.After, map[string]any{})
+
+	keys := make(map[string]struct{})
+	for k := range before {
+		keys[k] = struct{}{}
+	}
+	for k := range after {
+		keys[k] = struct{}{}
+	}
+
+	var diff []DiffEntry
+	for k := range keys {
+		b, inBefore := before[k]
+		a, inAfter := after[k]
+		if inBefore && inAfter && fmt.Sprint(a) == fmt.Sprint(b) {
+			continue
+		}
+		diff = append(diff, DiffEntry{Key: k, Before: b, After: a})
+	}
+
+	sort.Slice(diff, func(i, j int) bool {
+		return diff[i].Key < diff[j].Key
+	})
+	return diff
+}
+
+// migrateLegacyAccumulators (v1 -> v2) moves the fixed evolution accumulators
+// out of top-level fields and into custom_attributes.
+func migrateLegacyAccumulators(doc map[string]any) error {
+	custom, _ := doc["custom_attributes"].(map[string]any)
+	if custom == nil {
+		custom = make(map[string]any)
+	}
+
+	for _, key := range []string{"acc_happiness", "acc_health", "acc_playful"} {
+		raw, ok := doc[key]
+		if !ok {
+			continue
+		}
+		delete(doc, key)
+
+		n, err := intValue(raw)
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		if n == 0 {
+			continue
+		}
+		existing, _ := intValue(custom[key])
+		custom[key] = json.Number(fmt.Sprint(existing + n))
+	}
+
+	if len(custom) > 0 {
+		doc["custom_attributes"] = custom
+	}
+	return nil
+}
+
+// decodeDocument parses save data into a generic map, keeping numbers exact.
+func decodeDocument(data []byte) (map[string]any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var doc map[string]any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("parse save file: %w", err)
+	}
+	if doc == nil {
+		return nil, fmt.Errorf("parse save file: not a JSON object")
+	}
+	return doc, nil
+}
+
+// documentVersion reads schema_version, defaulting to 1 for legacy saves.
+func documentVersion(doc map[string]any) (int, error) {
+	raw, ok := doc[schemaVersionKey]
+	if !ok {
+		return 1, nil
+	}
+	v, err := intValue(raw)
+	if err != nil || v < 1 {
+		return 0, fmt.Errorf("invalid %s: %v", schemaVersionKey, raw)
+	}
+	return v, nil
+}
+
+// intValue converts a decoded JSON number (or nil) to int.
+func intValue(v any) (int, error) {
+	switch n := v.(type) {
+	case nil:
+		return 0, nil
+	case json.Number:
+		i, err := n.Int64()
+		if err != nil {
+			f, ferr := n.Float64()
+			if ferr != nil {
+				return 0, err
+			}
+			return int(f), nil
+		}
+		return int(i), nil
+	case float64:
+		return int(n), nil
+	case int:
+		return n, nil
+	}
+	return 0, fmt.Errorf("not a number: %v", v)
+}
+
+// flatten writes leaf values of doc into out with dotted keys.
+func flatten(prefix string, doc map[string]any, out map[string]any) map[string]any {
+	for k, v := range doc {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		if nested, ok := v.(map[string]any); ok {
+			flatten(key, nested, out)
+			continue
+		}
+		out[key] = v
+	}
+	return out
+}
@@ -0,0 +1,118 @@
+package store
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"clipet/internal/game"
+)
+
+const legacySave = `{
+  "name": "Mochi",
+  "species": "cat",
+  "stage_id": "baby",
+  "alive": true,
+  "acc_happiness": 3,
+  "acc_health": 0,
+  "acc_playful": 5,
+  "accumulated_offline_duration": 9007199254740993,
+  "custom_attributes": {"acc_playful": 2, "fire_power": 10}
+}`
+
+func TestMigrateSave_LegacyAccumulators(t *testing.T) {
+	res, err := MigrateSave([]byte(legacySave))
+	if err != nil {
+		t.Fatalf("MigrateSave failed: %v", err)
+	}
+
+	if res.FromVersion != 1 || res.ToVersion != CurrentSchemaVersion {
+		t.Errorf("Expected v1 -> v%d, got v%d -> v%d", CurrentSchemaVersion, res.FromVersion, res.ToVersion)
+	}
+	if !res.Upgraded() {
+		t.Fatal("Expected legacy save to be upgraded")
+	}
+
+	for _, key := range []string{"acc_happiness", "acc_health", "acc_playful"} {
+		if _, ok := res.After[key]; ok {
+			t.Errorf("Expected top-level %s to be removed", key)
+		}
+	}
+
+	pet, err := decodePet(res.After)
+	if err != nil {
+		t.Fatalf("decodePet failed: %v", err)
+	}
+	if got := pet.GetCustomAcc(game.AccHappiness); got != 3 {
+		t.Errorf("Expected acc_happiness 3, got %d", got)
+	}
+	if got := pet.GetCustomAcc(game.AccPlayful); got != 7 {
+		t.Errorf("Expected acc_playful 7 (merged), got %d", got)
+	}
+	if _, ok := pet.CustomAttributes[game.AccHealth]; ok {
+		t.Error("Expected zero acc_health not to be copied")
+	}
+	if got := pet.GetCustomAcc("fire_power"); got != 10 {
+		t.Errorf("Expected fire_power preserved, got %d", got)
+	}
+	if pet.AccumulatedOfflineDuration != 9007199254740993 {
+		t.Errorf("Expected large integers to survive migration, got %d", pet.AccumulatedOfflineDuration)
+	}
+}
+
+func TestMigrateSave_CurrentAndFuture(t *testing.T) {
+	res, err := MigrateSave([]byte(`{"schema_version": 2, "name": "Mochi"}`))
+	if err != nil {
+		t.Fatalf("MigrateSave failed: %v", err)
+	}
+	if res.Upgraded() || len(res.Diff()) != 0 {
+		t.Errorf("Expected current save to be untouched, diff: %v", res.Diff())
+	}
+
+	if _, err := MigrateSave([]byte(`{"schema_version": 99}`)); err == nil {
+		t.Error("Expected error for save from a newer schema")
+	}
+}
+
+func TestJSONStore_LoadBacksUpLegacySave(t *testing.T) {
+	dir := t.TempDir()
+	st, err := NewJSONStore(dir)
+	if err != nil {
+		t.Fatalf("NewJSONStore failed: %v", err)
+	}
+	if err := os.WriteFile(st.Path(), []byte(legacySave), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	pet, err := st.Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	backup, err := os.ReadFile(st.BackupPath(1))
+	if err != nil {
+		t.Fatalf("Expected backup at %s: %v", st.BackupPath(1), err)
+	}
+	if string(backup) != legacySave {
+		t.Error("Backup does not match the original save")
+	}
+
+	if err := st.Save(pet); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "save.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc["schema_version"] != float64(CurrentSchemaVersion) {
+		t.Errorf("Expected schema_version %d, got %v", CurrentSchemaVersion, doc["schema_version"])
+	}
+	if doc["name"] != "Mochi" {
+		t.Errorf("Expected pet fields at top level, got name %v", doc["name"])
+	}
+}
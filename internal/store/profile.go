@@ -0,0 +1,291 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+
+	"clipet/internal/game"
+)
+
+// DefaultProfile is the profile used when none has been selected.
+const DefaultProfile = "default"
+
+// profilesDirName is the subdirectory of the data dir holding all profiles.
+const profilesDirName = "profiles"
+
+// saveFileName is the pet save file inside a profile directory.
+const saveFileName = "save.json"
+
+// profileNameRe restricts profile names to safe directory names.
+var profileNameRe = regexp.MustCompile(`^[A-Za-z0-9_-]{1,32}$`)
+
+// ProfileInfo summarizes one save slot.
+type ProfileInfo struct {
+	Name    string
+	HasPet  bool
+	PetName string
+	Species string
+	StageID string
+	Alive   bool
+	ModTime time.Time // last save time (zero if no pet)
+}
+
+// ProfileManager manages named save slots under the data directory.
+// Each profile is a directory: {dataDir}/profiles/{name}/save.json.
+type ProfileManager struct {
+	dataDir string
+}
+
+// DefaultDataDir returns ~/.local/share/clipet/.
+func DefaultDataDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home dir: %w", err)
+	}
+	return filepath.Join(home, ".local", "share", "clipet"), nil
+}
+
+// NewProfileManager creates a ProfileManager rooted at dataDir.
+// If dataDir is empty, it defaults to DefaultDataDir().
+// A legacy single-slot save ({dataDir}/save.json) is moved into the
+// default profile the first time the manager is created.
+func NewProfileManager(dataDir string) (*ProfileManager, error) {
+	if dataDir == "" {
+		var err error
+		dataDir, err = DefaultDataDir()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	m := &ProfileManager{dataDir: dataDir}
+	if err := os.MkdirAll(m.profilesDir(), 0o755); err != nil {
+		return nil, fmt.Errorf("create profiles dir: %w", err)
+	}
+	if err := m.migrateLegacySave(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ValidateProfileName checks that name is usable as a profile name.
+func ValidateProfileName(name string) error {
+	if !profileNameRe.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q: use 1-32 letters, digits, '-' or '_'", name)
+	}
+	return nil
+}
+
+// DataDir returns the root data directory.
+func (m *ProfileManager) DataDir() string {
+	return m.dataDir
+}
+
+// Dir returns the directory of the named profile.
+func (m *ProfileManager) Dir(name string) string {
+	return filepath.Join(m.profilesDir(), name)
+}
+
+// Exists returns true if the named profile directory exists.
+func (m *ProfileManager) Exists(name string) bool {
+	info, err := os.Stat(m.Dir(name))
+	return err == nil && info.IsDir()
+}
+
+// Create creates an empty profile. It fails if the profile already exists.
+func (m *ProfileManager) Create(name string) error {
+	if err := ValidateProfileName(name); err != nil {
+		return err
+	}
+	if m.Exists(name) {
+		return fmt.Errorf("profile %q already exists", name)
+	}
+	if err := os.MkdirAll(m.Dir(name), 0o755); err != nil {
+		return fmt.Errorf("create profile dir: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a profile and everything stored in it.
+func (m *ProfileManager) Delete(name string) error {
+	if err := ValidateProfileName(name); err != nil {
+		return err
+	}
+	if !m.Exists(name) {
+		return fmt.Errorf("profile %q does not exist", name)
+	}
+	if err := os.RemoveAll(m.Dir(name)); err != nil {
+		return fmt.Errorf("delete profile: %w", err)
+	}
+	return nil
+}
+
+// Open returns the store for the named profile using the given backend.
+// The default profile is created on demand; other profiles must exist.
+// Opening a SQLite profile that still has a save.json imports it once.
+func (m *ProfileManager) Open(name string, backend Backend) (Store, error) {
+	return m.OpenPet(name, "", backend)
+}
+
+// OpenPet returns the store for one pet slot of the named profile, see
+// PetDir. Profiles are handled as in Open.
+func (m *ProfileManager) OpenPet(name, slot string, backend Backend) (Store, error) {
+	if err := ValidateProfileName(name); err != nil {
+		return nil, err
+	}
+	if slot != "" && !petSlotRe.MatchString(slot) {
+		return nil, fmt.Errorf("invalid pet slot %q", slot)
+	}
+	if !m.Exists(name) {
+		if name != DefaultProfile {
+			return nil, fmt.Errorf("profile %q does not exist", name)
+		}
+		if err := m.Create(name); err != nil {
+			return nil, err
+		}
+	}
+	return openDir(m.PetDir(name, slot), backend)
+}
+
+// openDir returns the store of one pet directory.
+func openDir(dir string, backend Backend) (Store, error) {
+	switch backend {
+	case BackendJSON, "":
+		return NewJSONStore(dir)
+	case BackendSQLite:
+		st, err := NewSQLiteStore(dir)
+		if err != nil {
+			return nil, err
+		}
+		jsonPath := filepath.Join(dir, saveFileName)
+		if _, err := os.Stat(jsonPath); err == nil && !st.Exists() {
+			if err := st.ImportJSON(jsonPath); err != nil {
+				st.Close()
+				return nil, err
+			}
+		}
+		return st, nil
+	}
+	return nil, fmt.Errorf("unknown store backend %q", backend)
+}
+
+// List returns all profiles sorted by name.
+func (m *ProfileManager) List() ([]ProfileInfo, error) {
+	entries, err := os.ReadDir(m.profilesDir())
+	if err != nil {
+		return nil, fmt.Errorf("read profiles dir: %w", err)
+	}
+
+	var list []ProfileInfo
+	for _, entry := range entries {
+		if !entry.IsDir() || ValidateProfileName(entry.Name()) != nil {
+			continue
+		}
+		list = append(list, m.describe(entry.Name()))
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list, nil
+}
+
+// describe builds a ProfileInfo by peeking at the profile's save file.
+func (m *ProfileManager) describe(name string) ProfileInfo {
+	info := ProfileInfo{Name: name}
+
+	path, pet, _ := peek(m.Dir(name))
+	if pet == nil {
+		return info
+	}
+
+	info.HasPet = true
+	info.PetName = pet.Name
+	info.Species = pet.Species
+	info.StageID = pet.StageID
+	info.Alive = pet.Alive
+	if fi, err := os.Stat(path); err == nil {
+		info.ModTime = fi.ModTime()
+	}
+	return info
+}
+
+// peek reads the pet saved in dir without modifying any file.
+// It returns the save location and nil if dir has no save, or an error if
+// the save exists but cannot be read.
+func peek(dir string) (string, *game.Pet, error) {
+	path := filepath.Join(dir, saveFileName)
+	if data, err := os.ReadFile(path); err == nil {
+		// Migrate in memory only; listing profiles must not touch their files
+		res, err := MigrateSave(data)
+		if err != nil {
+			return path, nil, err
+		}
+		pet, err := decodePet(res.After)
+		if err != nil {
+			return path, nil, err
+		}
+		return path, pet, nil
+	} else if !os.IsNotExist(err) {
+		return path, nil, fmt.Errorf("read save file: %w", err)
+	}
+
+	path = filepath.Join(dir, sqliteFileName)
+	if _, err := os.Stat(path); err != nil {
+		return path, nil, nil
+	}
+	db, err := sql.Open("sqlite", path+"?mode=ro")
+	if err != nil {
+		return path, nil, fmt.Errorf("open database: %w", err)
+	}
+	defer db.Close()
+
+	var data string
+	if err := db.QueryRow(`SELECT data FROM pet WHERE id = 1`).Scan(&data); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return path, nil, nil
+		}
+		return path, nil, fmt.Errorf("read pet: %w", err)
+	}
+	res, err := MigrateSave([]byte(data))
+	if err != nil {
+		return path, nil, err
+	}
+	pet, err := decodePet(res.After)
+	if err != nil {
+		return path, nil, err
+	}
+	return path, pet, nil
+}
+
+func (m *ProfileManager) profilesDir() string {
+	return filepath.Join(m.dataDir, profilesDirName)
+}
+
+// migrateLegacySave moves a pre-profile save.json into the default profile.
+func (m *ProfileManager) migrateLegacySave() error {
+	legacy := filepath.Join(m.dataDir, saveFileName)
+	if _, err := os.Stat(legacy); err != nil {
+		return nil
+	}
+
+	target := filepath.Join(m.Dir(DefaultProfile), saveFileName)
+	if _, err := os.Stat(target); err == nil {
+		// Default profile already has a save; leave the legacy file alone
+		return nil
+	}
+
+	if err := os.MkdirAll(m.Dir(DefaultProfile), 0o755); err != nil {
+		return fmt.Errorf("create default profile: %w", err)
+	}
+	if err := os.Rename(legacy, target); err != nil {
+		return fmt.Errorf("migrate legacy save: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package store
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestProfileManager_MigratesLegacySave(t *testing.T) {
+	dataDir := t.TempDir()
+	legacy := filepath.Join(dataDir, saveFileName)
+	if err := os.WriteFile(legacy, []byte(`{"name":"Mochi"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	pm, err := NewProfileManager(dataDir)
+	if err != nil {
+		t.Fatalf("NewProfileManager failed: %v", err)
+	}
+	target := filepath.Join(pm.Dir(DefaultProfile), saveFileName)
+	if data, err := os.ReadFile(target); err != nil || string(data) != `{"name":"Mochi"}` {
+		t.Fatalf("default profile save = %q, %v; want the legacy save", data, err)
+	}
+	if _, err := os.Stat(legacy); !os.IsNotExist(err) {
+		t.Errorf("legacy save still present after migration: %v", err)
+	}
+
+	// A second manager finds nothing to migrate
+	if _, err := NewProfileManager(dataDir); err != nil {
+		t.Fatalf("second NewProfileManager failed: %v", err)
+	}
+	if data, err := os.ReadFile(target); err != nil || string(data) != `{"name":"Mochi"}` {
+		t.Errorf("default profile save after second open = %q, %v", data, err)
+	}
+
+	// A legacy save never overwrites the default profile's own save
+	if err := os.WriteFile(legacy, []byte(`{"name":"Kiki"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewProfileManager(dataDir); err != nil {
+		t.Fatalf("third NewProfileManager failed: %v", err)
+	}
+	if data, _ := os.ReadFile(target); string(data) != `{"name":"Mochi"}` {
+		t.Errorf("default profile save = %q, want it kept", data)
+	}
+	if _, err := os.Stat(legacy); err != nil {
+		t.Errorf("legacy save removed although it was not migrated: %v", err)
+	}
+}
+
+func TestProfileManager_CreateDeleteList(t *testing.T) {
+	pm, err := NewProfileManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewProfileManager failed: %v", err)
+	}
+
+	for _, name := range []string{"", "../up", "a b", "toolongtoolongtoolongtoolongtoolong"} {
+		if err := pm.Create(name); err == nil {
+			t.Errorf("Create(%q) accepted an invalid name", name)
+		}
+		if err := pm.Delete(name); err == nil {
+			t.Errorf("Delete(%q) accepted an invalid name", name)
+		}
+	}
+
+	if pm.Exists("work") {
+		t.Fatal("Exists(work) before Create")
+	}
+	if err := pm.Create("work"); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if !pm.Exists("work") {
+		t.Error("Exists(work) = false after Create")
+	}
+	if err := pm.Create("work"); err == nil {
+		t.Error("Create accepted an existing profile")
+	}
+	if err := pm.Create("home"); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	list, err := pm.List()
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(list) != 2 || list[0].Name != "home" || list[1].Name != "work" || list[0].HasPet {
+		t.Errorf("List = %+v, want empty home and work", list)
+	}
+
+	if err := pm.Delete("work"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if pm.Exists("work") {
+		t.Error("Exists(work) = true after Delete")
+	}
+	if err := pm.Delete("work"); err == nil {
+		t.Error("Delete accepted a missing profile")
+	}
+
+	// Only the default profile is created on demand
+	if _, err := pm.Open("work", BackendJSON); err == nil {
+		t.Error("Open created a missing profile")
+	}
+	if _, err := pm.Open(DefaultProfile, BackendJSON); err != nil {
+		t.Fatalf("Open(default) failed: %v", err)
+	}
+	if !pm.Exists(DefaultProfile) {
+		t.Error("Open(default) did not create the default profile")
+	}
+}
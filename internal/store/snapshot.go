@@ -0,0 +1,200 @@
+package store
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"clipet/internal/game"
+)
+
+// snapshotsDirName is the snapshot directory inside a profile directory.
+const snapshotsDirName = "snapshots"
+
+// snapshotStampLayout is the timestamp prefix of snapshot file names.
+const snapshotStampLayout = "20060102-150405.000"
+
+// Snapshot kinds. Any other kind is a manual snapshot named after its reason
+// (e.g. "reset", "restore").
+const (
+	SnapshotRecent = "recent" // taken on every save
+	SnapshotDaily  = "daily"  // first save of each calendar day
+)
+
+// SnapshotPolicy controls how many snapshots of each kind are kept.
+type SnapshotPolicy struct {
+	Recent int // last N saves
+	Daily  int // last N days
+	Manual int // last N manual snapshots (before reset/restore)
+}
+
+// DefaultSnapshotPolicy is used by JSONStore.
+var DefaultSnapshotPolicy = SnapshotPolicy{Recent: 10, Daily: 7, Manual: 10}
+
+// Snapshot describes one stored copy of the save file.
+type Snapshot struct {
+	ID   string    // file name without extension, e.g. "20260102-150405.000-recent"
+	Kind string    // SnapshotRecent, SnapshotDaily or a manual reason
+	Time time.Time // when the snapshot was taken
+	Path string
+}
+
+// Snapshotter is implemented by stores that keep restorable snapshots.
+type Snapshotter interface {
+	// Snapshot copies the current save as a manual snapshot tagged with reason.
+	// It returns nil if there is no save to copy.
+	Snapshot(reason string) (*Snapshot, error)
+	// Snapshots lists stored snapshots, newest first.
+	Snapshots() ([]Snapshot, error)
+	// Restore replaces the current save with the snapshot id.
+	// The current save is snapshotted first so a restore can be undone.
+	Restore(id string) error
+	// LoadSnapshot decodes the pet stored in snapshot id.
+	LoadSnapshot(id string) (*game.Pet, error)
+}
+
+// snapshotRing stores save copies as <stamp>-<kind>.json files in one
+// directory and prunes each kind according to its policy.
+type snapshotRing struct {
+	dir    string
+	policy SnapshotPolicy
+}
+
+// record stores data as a recent snapshot, unless the newest snapshot
+// already holds the same pet, and as today's daily snapshot if none exists
+// yet, then prunes old snapshots.
+func (r snapshotRing) record(data []byte, at time.Time) error {
+	list, err := r.list()
+	if err != nil {
+		return err
+	}
+	if len(list) == 0 || !r.holds(list[0], data) {
+		if _, err := r.write(data, at, SnapshotRecent); err != nil {
+			return err
+		}
+	}
+
+	today := at.Local().Format("20060102")
+	hasDaily := false
+	for _, s := range list {
+		if s.Kind == SnapshotDaily && s.Time.Local().Format("20060102") == today {
+			hasDaily = true
+			break
+		}
+	}
+	if !hasDaily {
+		if _, err := r.write(data, at, SnapshotDaily); err != nil {
+			return err
+		}
+	}
+
+	return r.prune()
+}
+
+// holds reports whether snapshot s stores the same pet as data.
+func (r snapshotRing) holds(s Snapshot, data []byte) bool {
+	stored, err := os.ReadFile(s.Path)
+	return err == nil && samePet(stored, data)
+}
+
+// write stores one snapshot file.
+func (r snapshotRing) write(data []byte, at time.Time, kind string) (*Snapshot, error) {
+	if err := os.MkdirAll(r.dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create snapshot dir: %w", err)
+	}
+
+	id := at.Local().Format(snapshotStampLayout) + "-" + kind
+	path := filepath.Join(r.dir, id+".json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return nil, fmt.Errorf("write snapshot: %w", err)
+	}
+	return &Snapshot{ID: id, Kind: kind, Time: at, Path: path}, nil
+}
+
+// list returns all snapshots, newest first. Unrecognised files are ignored.
+func (r snapshotRing) list() ([]Snapshot, error) {
+	entries, err := os.ReadDir(r.dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read snapshot dir: %w", err)
+	}
+
+	var list []Snapshot
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		id := strings.TrimSuffix(name, ".json")
+		if len(id) < len(snapshotStampLayout)+2 || id[len(snapshotStampLayout)] != '-' {
+			continue
+		}
+		at, err := time.ParseInLocation(snapshotStampLayout, id[:len(snapshotStampLayout)], time.Local)
+		if err != nil {
+			continue
+		}
+		list = append(list, Snapshot{
+			ID:   id,
+			Kind: id[len(snapshotStampLayout)+1:],
+			Time: at,
+			Path: filepath.Join(r.dir, name),
+		})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if !list[i].Time.Equal(list[j].Time) {
+			return list[i].Time.After(list[j].Time)
+		}
+		return list[i].ID > list[j].ID
+	})
+	return list, nil
+}
+
+// find returns the snapshot with the given id.
+func (r snapshotRing) find(id string) (*Snapshot, error) {
+	list, err := r.list()
+	if err != nil {
+		return nil, err
+	}
+	for i := range list {
+		if list[i].ID == id {
+			return &list[i], nil
+		}
+	}
+	return nil, fmt.Errorf("snapshot %q not found", id)
+}
+
+// prune removes snapshots beyond the policy limits, oldest first.
+func (r snapshotRing) prune() error {
+	list, err := r.list()
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]int)
+	for _, s := range list {
+		group := s.Kind
+		limit := r.policy.Manual
+		switch s.Kind {
+		case SnapshotRecent:
+			limit = r.policy.Recent
+		case SnapshotDaily:
+			limit = r.policy.Daily
+		default:
+			group = "manual"
+		}
+
+		seen[group]++
+		if seen[group] > limit {
+			if err := os.Remove(s.Path); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("prune snapshot: %w", err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package store
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"clipet/internal/game"
+)
+
+func TestSnapshotRing_Prune(t *testing.T) {
+	ring := snapshotRing{dir: t.TempDir(), policy: SnapshotPolicy{Recent: 3, Daily: 2, Manual: 1}}
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.Local)
+
+	// Two saves per day over four days.
+	for day := 0; day < 4; day++ {
+		for i := 0; i < 2; i++ {
+			at := base.AddDate(0, 0, day).Add(time.Duration(i) * time.Hour)
+			data := []byte(fmt.Sprintf(`{"hunger":%d}`, day*2+i))
+			if err := ring.record(data, at); err != nil {
+				t.Fatalf("record: %v", err)
+			}
+		}
+	}
+	for _, reason := range []string{"reset", "restore"} {
+		if _, err := ring.write([]byte(`{}`), base.AddDate(0, 0, 5), reason); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	if err := ring.prune(); err != nil {
+		t.Fatalf("prune: %v", err)
+	}
+
+	list, err := ring.list()
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	counts := map[string]int{}
+	for _, s := range list {
+		counts[s.Kind]++
+	}
+	if counts[SnapshotRecent] != 3 || counts[SnapshotDaily] != 2 || counts["reset"]+counts["restore"] != 1 {
+		t.Errorf("unexpected snapshot counts: %v", counts)
+	}
+	if list[0].Kind != "restore" {
+		t.Errorf("newest snapshot should be the restore snapshot, got %s", list[0].ID)
+	}
+}
+
+func TestJSONStore_UnchangedSaveKeepsSnapshots(t *testing.T) {
+	st, err := NewJSONStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewJSONStore: %v", err)
+	}
+
+	pet := &game.Pet{Name: "Mochi", StageID: "baby", Hunger: 80, Alive: true}
+	for range 3 {
+		if err := st.Save(pet); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+	}
+	if rev := st.Revision(); rev != 1 {
+		t.Errorf("revision after unchanged saves = %d, want 1", rev)
+	}
+
+	pet.Hunger = 70
+	if err := st.Save(pet); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	list, err := st.Snapshots()
+	if err != nil {
+		t.Fatalf("Snapshots: %v", err)
+	}
+	recent := 0
+	for _, s := range list {
+		if s.Kind == SnapshotRecent {
+			recent++
+		}
+	}
+	if recent != 2 || st.Revision() != 2 {
+		t.Errorf("recent snapshots = %d, revision = %d; want 2 and 2", recent, st.Revision())
+	}
+}
+
+func TestJSONStore_Restore(t *testing.T) {
+	st, err := NewJSONStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewJSONStore: %v", err)
+	}
+
+	pet := &game.Pet{Name: "Mochi", StageID: "baby", Hunger: 80, Alive: true}
+	if err := st.Save(pet); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	list, _ := st.Snapshots()
+	if len(list) == 0 {
+		t.Fatal("Save should record a snapshot")
+	}
+	good := list[0].ID
+
+	snap, err := st.Snapshot("reset")
+	if err != nil || snap == nil {
+		t.Fatalf("Snapshot: %v, %v", snap, err)
+	}
+	if err := st.Delete(); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	if err := st.Restore(good); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	loaded, err := st.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.Name != "Mochi" || loaded.Hunger != 80 {
+		t.Errorf("restored pet = %+v", loaded)
+	}
+
+	if err := st.Restore("missing"); err == nil {
+		t.Error("Restore of an unknown snapshot should fail")
+	}
+	if _, err := os.Stat(st.Path()); err != nil {
+		t.Errorf("save file missing after failed restore: %v", err)
+	}
+}
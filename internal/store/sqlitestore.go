@@ -0,0 +1,400 @@
+package store
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"clipet/internal/game"
+
+	_ "modernc.org/sqlite" // pure-Go SQLite driver
+)
+
+// sqliteFileName is the database file inside a profile directory.
+const sqliteFileName = "clipet.db"
+
+// sqliteSchema creates the snapshot table and the append-only history tables.
+// Timestamps are unix milliseconds; changes are JSON objects {"attr": [old, new]}.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS pet (
+	id         INTEGER PRIMARY KEY CHECK (id = 1),
+	data       TEXT    NOT NULL,
+	updated_at INTEGER NOT NULL
+);
+CREATE TABLE IF NOT EXISTS pet_backups (
+	id             INTEGER PRIMARY KEY AUTOINCREMENT,
+	schema_version INTEGER NOT NULL,
+	data           TEXT    NOT NULL,
+	created_at     INTEGER NOT NULL
+);
+CREATE TABLE IF NOT EXISTS actions (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	at         INTEGER NOT NULL,
+	stage_id   TEXT    NOT NULL,
+	action     TEXT    NOT NULL,
+	ok         INTEGER NOT NULL,
+	error_type TEXT    NOT NULL DEFAULT '',
+	changes    TEXT    NOT NULL DEFAULT '{}'
+);
+CREATE TABLE IF NOT EXISTS adventures (
+	id           INTEGER PRIMARY KEY AUTOINCREMENT,
+	at           INTEGER NOT NULL,
+	stage_id     TEXT    NOT NULL,
+	adventure_id TEXT    NOT NULL,
+	choice       TEXT    NOT NULL,
+	outcome      TEXT    NOT NULL,
+	changes      TEXT    NOT NULL DEFAULT '{}'
+);
+CREATE TABLE IF NOT EXISTS evolutions (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	at         INTEGER NOT NULL,
+	from_stage TEXT    NOT NULL,
+	to_stage   TEXT    NOT NULL
+);
+CREATE TABLE IF NOT EXISTS decay_rounds (
+	id          INTEGER PRIMARY KEY AUTOINCREMENT,
+	at          INTEGER NOT NULL,
+	stage_id    TEXT    NOT NULL,
+	round       INTEGER NOT NULL,
+	duration_ms INTEGER NOT NULL,
+	start_attrs TEXT    NOT NULL,
+	end_attrs   TEXT    NOT NULL,
+	effects     TEXT    NOT NULL DEFAULT '[]',
+	critical    INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_actions_at ON actions(at);
+CREATE INDEX IF NOT EXISTS idx_adventures_at ON adventures(at);
+CREATE INDEX IF NOT EXISTS idx_decay_rounds_at ON decay_rounds(at);
+`
+
+// SQLiteStore implements Store and HistoryRecorder using an embedded SQLite database.
+// The pet snapshot is kept as a JSON document so save migrations apply unchanged.
+type SQLiteStore struct {
+	path string
+	db   *sql.DB
+
+	revision int64 // revision last loaded or written
+	synced   bool  // revision is known (after Load or Save)
+}
+
+// NewSQLiteStore opens (or creates) the database in dir.
+// If dir is empty, it defaults to DefaultDataDir().
+func NewSQLiteStore(dir string) (*SQLiteStore, error) {
+	if dir == "" {
+		var err error
+		dir, err = DefaultDataDir()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create data dir: %w", err)
+	}
+
+	path := filepath.Join(dir, sqliteFileName)
+	db, err := sql.Open("sqlite", path+"?_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, fmt.Errorf("open database: %w", err)
+	}
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("init database schema: %w", err)
+	}
+
+	return &SQLiteStore{path: path, db: db}, nil
+}
+
+// Save writes the pet snapshot.
+// It returns ErrConflict if another process saved since this store last
+// loaded or saved the pet; the revision check and write are one statement.
+func (s *SQLiteStore) Save(pet *game.Pet) error {
+	current, err := s.currentRevision()
+	if err != nil {
+		return err
+	}
+	if s.synced && current != s.revision {
+		return ErrConflict
+	}
+
+	data, err := encodeSave(pet, current+1, false)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.db.Exec(
+		`INSERT INTO pet (id, data, updated_at) VALUES (1, ?, ?)
+		 ON CONFLICT(id) DO UPDATE SET data = excluded.data, updated_at = excluded.updated_at
+		 WHERE COALESCE(json_extract(pet.data, '$.revision'), 0) = ?`,
+		string(data), time.Now().UnixMilli(), current)
+	if err != nil {
+		return fmt.Errorf("save pet: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrConflict
+	}
+	s.revision, s.synced = current+1, true
+	// The status cache only feeds shell prompts; a stale one is harmless.
+	_ = writeStatus(filepath.Dir(s.path), pet)
+	return nil
+}
+
+// Revision implements Syncer.
+func (s *SQLiteStore) Revision() int64 {
+	return s.revision
+}
+
+// Changed implements Syncer.
+func (s *SQLiteStore) Changed() (bool, error) {
+	if !s.synced {
+		return false, nil
+	}
+	if !s.Exists() {
+		return true, nil
+	}
+	current, err := s.currentRevision()
+	if err != nil {
+		return false, err
+	}
+	return current != s.revision, nil
+}
+
+// currentRevision reads the stored snapshot's revision (0 if there is none).
+func (s *SQLiteStore) currentRevision() (int64, error) {
+	var rev int64
+	err := s.db.QueryRow(`SELECT COALESCE(json_extract(data, '$.revision'), 0) FROM pet WHERE id = 1`).Scan(&rev)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("read revision: %w", err)
+	}
+	return rev, nil
+}
+
+// Load reads the pet snapshot, migrating older schemas in memory.
+// The pre-migration document is kept in pet_backups.
+func (s *SQLiteStore) Load() (*game.Pet, error) {
+	return s.load(true)
+}
+
+// LoadUnverified implements Verifier.
+func (s *SQLiteStore) LoadUnverified() (*game.Pet, error) {
+	return s.load(false)
+}
+
+// Verify implements Verifier.
+func (s *SQLiteStore) Verify() error {
+	data, err := s.snapshot()
+	if err != nil {
+		return err
+	}
+	_, _, err = readSave([]byte(data), true)
+	return err
+}
+
+func (s *SQLiteStore) load(verify bool) (*game.Pet, error) {
+	data, err := s.snapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	res, pet, err := readSave([]byte(data), verify)
+	if err != nil {
+		return nil, err
+	}
+	if res.Upgraded() {
+		_, err := s.db.Exec(
+			`INSERT INTO pet_backups (schema_version, data, created_at) VALUES (?, ?, ?)`,
+			res.FromVersion, data, time.Now().UnixMilli())
+		if err != nil {
+			return nil, fmt.Errorf("backup save: %w", err)
+		}
+	}
+
+	s.revision, s.synced = saveRevision([]byte(data)), true
+	return pet, nil
+}
+
+// Migrate upgrades the stored snapshot to CurrentSchemaVersion.
+// The original document is kept in pet_backups.
+// With dryRun set, the migration is computed but nothing is written.
+func (s *SQLiteStore) Migrate(dryRun bool) (*MigrationResult, error) {
+	data, err := s.snapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := MigrateSave([]byte(data))
+	if err != nil || !res.Upgraded() || dryRun {
+		return res, err
+	}
+
+	pet, err := s.Load()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Save(pet); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// snapshot returns the raw pet document.
+func (s *SQLiteStore) snapshot() (string, error) {
+	var data string
+	err := s.db.QueryRow(`SELECT data FROM pet WHERE id = 1`).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("read save: no pet in %s", s.path)
+	}
+	if err != nil {
+		return "", fmt.Errorf("read save: %w", err)
+	}
+	return data, nil
+}
+
+// Exists returns true if a pet snapshot has been saved.
+func (s *SQLiteStore) Exists() bool {
+	var n int
+	if err := s.db.QueryRow(`SELECT COUNT(*) FROM pet`).Scan(&n); err != nil {
+		return false
+	}
+	return n > 0
+}
+
+// Path returns the database file path.
+func (s *SQLiteStore) Path() string {
+	return s.path
+}
+
+// Delete removes the pet snapshot and all of its history.
+func (s *SQLiteStore) Delete() error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("delete save: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, table := range []string{"pet", "pet_backups", "actions", "adventures", "evolutions", "decay_rounds"} {
+		if _, err := tx.Exec("DELETE FROM " + table); err != nil {
+			return fmt.Errorf("delete save: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("delete save: %w", err)
+	}
+	removeStatus(filepath.Dir(s.path))
+	s.synced = false
+	return nil
+}
+
+// Close closes the database.
+func (s *SQLiteStore) Close() error {
+	return s.db.Close()
+}
+
+// DB returns the underlying database for read-only history queries.
+func (s *SQLiteStore) DB() *sql.DB {
+	return s.db
+}
+
+// ImportJSON copies a save.json into the database, replacing any snapshot.
+// The JSON file is renamed to <path>.imported so the import happens once.
+func (s *SQLiteStore) ImportJSON(jsonPath string) error {
+	src := &JSONStore{path: jsonPath}
+	pet, err := src.Load()
+	if err != nil {
+		return fmt.Errorf("import %s: %w", jsonPath, err)
+	}
+	if err := s.Save(pet); err != nil {
+		return fmt.Errorf("import %s: %w", jsonPath, err)
+	}
+	if err := os.Rename(jsonPath, jsonPath+".imported"); err != nil {
+		return fmt.Errorf("mark %s as imported: %w", jsonPath, err)
+	}
+	return nil
+}
+
+// RecordAction implements HistoryRecorder.
+func (s *SQLiteStore) RecordAction(at time.Time, pet *game.Pet, action string, res game.ActionResult) error {
+	changes, err := marshalChanges(res.Changes)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Exec(
+		`INSERT INTO actions (at, stage_id, action, ok, error_type, changes) VALUES (?, ?, ?, ?, ?, ?)`,
+		at.UnixMilli(), pet.StageID, action, res.OK, res.ErrorType, changes)
+	if err != nil {
+		return fmt.Errorf("record action: %w", err)
+	}
+	return nil
+}
+
+// RecordAdventure implements HistoryRecorder.
+func (s *SQLiteStore) RecordAdventure(at time.Time, pet *game.Pet, res game.AdventureResult) error {
+	changes, err := marshalChanges(res.Changes)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Exec(
+		`INSERT INTO adventures (at, stage_id, adventure_id, choice, outcome, changes) VALUES (?, ?, ?, ?, ?, ?)`,
+		at.UnixMilli(), pet.StageID, res.Adventure.ID, res.Choice.Text, res.Outcome.Text, changes)
+	if err != nil {
+		return fmt.Errorf("record adventure: %w", err)
+	}
+	return nil
+}
+
+// RecordEvolution implements HistoryRecorder.
+func (s *SQLiteStore) RecordEvolution(at time.Time, pet *game.Pet, fromStage, toStage string) error {
+	_, err := s.db.Exec(
+		`INSERT INTO evolutions (at, from_stage, to_stage) VALUES (?, ?, ?)`,
+		at.UnixMilli(), fromStage, toStage)
+	if err != nil {
+		return fmt.Errorf("record evolution: %w", err)
+	}
+	return nil
+}
+
+// RecordDecay implements HistoryRecorder.
+func (s *SQLiteStore) RecordDecay(at time.Time, pet *game.Pet, rounds []game.DecayRoundResult) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("record decay: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, r := range rounds {
+		start, _ := json.Marshal(r.StartAttrs)
+		end, _ := json.Marshal(r.EndAttrs)
+		effects, _ := json.Marshal(r.Effects)
+		_, err := tx.Exec(
+			`INSERT INTO decay_rounds (at, stage_id, round, duration_ms, start_attrs, end_attrs, effects, critical)
+			 VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			at.UnixMilli(), pet.StageID, r.Round, r.Duration.Milliseconds(),
+			string(start), string(end), string(effects), r.CriticalState)
+		if err != nil {
+			return fmt.Errorf("record decay: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("record decay: %w", err)
+	}
+	return nil
+}
+
+// marshalChanges encodes an attribute change map as JSON.
+func marshalChanges(changes map[string][2]int) (string, error) {
+	if changes == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return "", fmt.Errorf("marshal changes: %w", err)
+	}
+	return string(data), nil
+}
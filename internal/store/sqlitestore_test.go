@@ -0,0 +1,138 @@
+package store
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"clipet/internal/game"
+)
+
+func TestSQLiteStore_SaveLoadAndHistory(t *testing.T) {
+	st, err := NewSQLiteStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewSQLiteStore failed: %v", err)
+	}
+	defer st.Close()
+
+	if st.Exists() {
+		t.Fatal("Expected empty database")
+	}
+
+	pet := game.NewPet("Mochi", "cat", "egg", 50, 50, 100, 100, nil)
+	pet.AddCustomAcc(game.AccPlayful, 2)
+	if err := st.Save(pet); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	loaded, err := st.Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if loaded.Name != "Mochi" || loaded.GetCustomAcc(game.AccPlayful) != 2 {
+		t.Errorf("Loaded pet mismatch: %+v", loaded)
+	}
+
+	now := time.Now()
+	res := game.ActionResult{OK: true, Changes: map[string][2]int{"hunger": {50, 70}}}
+	if err := History(st, SourceCLI).RecordAction(now, pet, "feed", res); err != nil {
+		t.Fatalf("RecordAction failed: %v", err)
+	}
+	if err := History(st, SourceCLI).RecordEvolution(now, pet, "egg", "baby"); err != nil {
+		t.Fatalf("RecordEvolution failed: %v", err)
+	}
+	rounds := []game.DecayRoundResult{{Round: 1, Duration: 6 * time.Hour}, {Round: 2, Duration: time.Hour}}
+	if err := History(st, SourceCLI).RecordDecay(now, pet, rounds); err != nil {
+		t.Fatalf("RecordDecay failed: %v", err)
+	}
+
+	for table, want := range map[string]int{"actions": 1, "evolutions": 1, "decay_rounds": 2} {
+		var n int
+		if err := st.DB().QueryRow("SELECT COUNT(*) FROM " + table).Scan(&n); err != nil {
+			t.Fatalf("count %s: %v", table, err)
+		}
+		if n != want {
+			t.Errorf("Expected %d rows in %s, got %d", want, table, n)
+		}
+	}
+
+	if err := st.Delete(); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if st.Exists() {
+		t.Error("Expected no pet after Delete")
+	}
+}
+
+func TestProfileManager_OpenSQLiteImportsJSON(t *testing.T) {
+	pm, err := NewProfileManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewProfileManager failed: %v", err)
+	}
+	jsonPath := filepath.Join(pm.Dir(DefaultProfile), "save.json")
+	if err := os.MkdirAll(filepath.Dir(jsonPath), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(jsonPath, []byte(legacySave), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	st, err := pm.Open(DefaultProfile, BackendSQLite)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer st.(*SQLiteStore).Close()
+
+	pet, err := st.Load()
+	if err != nil {
+		t.Fatalf("Load after import failed: %v", err)
+	}
+	if pet.Name != "Mochi" || pet.GetCustomAcc(game.AccHappiness) != 3 {
+		t.Errorf("Imported pet mismatch: %+v", pet)
+	}
+	if _, err := os.Stat(jsonPath + ".imported"); err != nil {
+		t.Errorf("Expected save.json to be renamed after import: %v", err)
+	}
+
+	list, err := pm.List()
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(list) != 1 || !list[0].HasPet || list[0].PetName != "Mochi" {
+		t.Errorf("Expected SQLite profile to be listed with its pet, got %+v", list)
+	}
+}
+
+func TestSQLiteStore_RevisionConflict(t *testing.T) {
+	dir := t.TempDir()
+	a, err := NewSQLiteStore(dir)
+	if err != nil {
+		t.Fatalf("NewSQLiteStore: %v", err)
+	}
+	defer a.Close()
+	b, err := NewSQLiteStore(dir)
+	if err != nil {
+		t.Fatalf("NewSQLiteStore: %v", err)
+	}
+	defer b.Close()
+
+	if err := a.Save(&game.Pet{Name: "Mochi"}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	pet, err := b.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if err := b.Save(pet); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	if changed, _ := a.Changed(); !changed {
+		t.Error("Changed should report the other store's save")
+	}
+	if err := a.Save(pet); !errors.Is(err, ErrConflict) {
+		t.Errorf("stale Save: got %v, want ErrConflict", err)
+	}
+}
@@ -0,0 +1,133 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"clipet/internal/game"
+)
+
+// statusFileName is the prompt status cache inside a profile directory.
+const statusFileName = "status.json"
+
+// PetStatus is a small summary of the pet written next to the save on every
+// Save. Shell prompts read it instead of the save, so they need neither the
+// plugin registry nor checksum verification. It is never read back by the
+// stores and may lag behind offline decay.
+type PetStatus struct {
+	Name      string    `json:"name"`
+	Species   string    `json:"species"`
+	Emoji     string    `json:"emoji,omitempty"` // species emoji, if the pack sets one
+	StageID   string    `json:"stage_id"`
+	Phase     string    `json:"phase"`
+	Alive     bool      `json:"alive"`
+	Mood      string    `json:"mood"` // game.Pet.MoodName
+	Hunger    int       `json:"hunger"`
+	Happiness int       `json:"happiness"`
+	Health    int       `json:"health"`
+	Energy    int       `json:"energy"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewPetStatus summarizes pet as of at.
+func NewPetStatus(pet *game.Pet, at time.Time) PetStatus {
+	st := PetStatus{
+		Name:      pet.Name,
+		Species:   pet.Species,
+		StageID:   pet.StageID,
+		Phase:     string(pet.Stage),
+		Alive:     pet.Alive,
+		Mood:      pet.MoodName(),
+		Hunger:    pet.Hunger,
+		Happiness: pet.Happiness,
+		Health:    pet.Health,
+		Energy:    pet.Energy,
+		UpdatedAt: at,
+	}
+	if reg := pet.Registry(); reg != nil {
+		if pack := reg.GetSpecies(pet.Species); pack != nil {
+			st.Emoji = pack.Species.Emoji
+		}
+	}
+	return st
+}
+
+// ReadStatus reads the status cache of the profile directory dir.
+func ReadStatus(dir string) (*PetStatus, error) {
+	data, err := os.ReadFile(filepath.Join(dir, statusFileName))
+	if err != nil {
+		return nil, err
+	}
+	var st PetStatus
+	if err := json.Unmarshal(data, &st); err != nil {
+		return nil, fmt.Errorf("parse status cache: %w", err)
+	}
+	return &st, nil
+}
+
+// ProfileStatusDir returns the directory holding the status cache of the
+// named profile, without creating or migrating anything.
+// If dataDir is empty, it defaults to DefaultDataDir().
+func ProfileStatusDir(dataDir, profile string) (string, error) {
+	if dataDir == "" {
+		var err error
+		if dataDir, err = DefaultDataDir(); err != nil {
+			return "", err
+		}
+	}
+	return filepath.Join(dataDir, profilesDirName, profile), nil
+}
+
+// PetStatusDir returns the directory holding the status cache of one pet
+// of the named profile, matched by name (case-insensitive) or slot like
+// ProfileManager.FindPet. Only status caches are read. An empty pet is the
+// profile's own pet, as in ProfileStatusDir.
+func PetStatusDir(dataDir, profile, pet string) (string, error) {
+	dir, err := ProfileStatusDir(dataDir, profile)
+	if err != nil || pet == "" {
+		return dir, err
+	}
+	candidates := []string{dir}
+	entries, _ := os.ReadDir(filepath.Join(dir, petsDirName))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if entry.Name() == pet {
+			return filepath.Join(dir, petsDirName, pet), nil
+		}
+		candidates = append(candidates, filepath.Join(dir, petsDirName, entry.Name()))
+	}
+	for _, c := range candidates {
+		if st, err := ReadStatus(c); err == nil && strings.EqualFold(st.Name, pet) {
+			return c, nil
+		}
+	}
+	return "", fmt.Errorf("no pet %q in profile %q", pet, profile)
+}
+
+// writeStatus replaces the status cache in dir atomically.
+func writeStatus(dir string, pet *game.Pet) error {
+	data, err := json.Marshal(NewPetStatus(pet, time.Now()))
+	if err != nil {
+		return fmt.Errorf("marshal status: %w", err)
+	}
+	path := filepath.Join(dir, statusFileName)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("write status cache: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("rename status cache: %w", err)
+	}
+	return nil
+}
+
+// removeStatus deletes the status cache in dir, if any.
+func removeStatus(dir string) {
+	_ = os.Remove(filepath.Join(dir, statusFileName))
+}
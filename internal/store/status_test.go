@@ -0,0 +1,49 @@
+package store
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"clipet/internal/game"
+	"clipet/internal/plugin"
+)
+
+func TestJSONStore_WritesStatusCache(t *testing.T) {
+	dataDir := t.TempDir()
+	dir, err := ProfileStatusDir(dataDir, DefaultProfile)
+	if err != nil {
+		t.Fatalf("ProfileStatusDir: %v", err)
+	}
+	st, err := NewJSONStore(dir)
+	if err != nil {
+		t.Fatalf("NewJSONStore: %v", err)
+	}
+
+	reg := plugin.NewRegistry()
+	reg.Register(&plugin.SpeciesPack{Species: plugin.SpeciesConfig{ID: "cat", Emoji: "🐱"}})
+	pet := &game.Pet{Name: "Mochi", Species: "cat", StageID: "baby", Stage: game.StageBaby,
+		Alive: true, Hunger: 15, Happiness: 90, Health: 90, Energy: 90}
+	pet.SetRegistry(reg)
+
+	if err := st.Save(pet); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	status, err := ReadStatus(dir)
+	if err != nil {
+		t.Fatalf("ReadStatus: %v", err)
+	}
+	if status.Name != "Mochi" || status.Emoji != "🐱" || status.Hunger != 15 || status.Mood != pet.MoodName() {
+		t.Errorf("status = %+v", status)
+	}
+	if status.Phase != string(game.StageBaby) || !status.Alive {
+		t.Errorf("status phase/alive = %q/%v", status.Phase, status.Alive)
+	}
+
+	if err := st.Delete(); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, statusFileName)); !os.IsNotExist(err) {
+		t.Errorf("status cache should be removed with the save, stat err = %v", err)
+	}
+}
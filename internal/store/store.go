@@ -1,7 +1,12 @@
 // Package store provides persistence for pet state.
 package store
 
-import "clipet/internal/game"
+import (
+	"errors"
+	"fmt"
+
+	"clipet/internal/game"
+)
 
 // Store defines the interface for pet state persistence.
 type Store interface {
@@ -11,4 +16,49 @@ type Store interface {
 	Load() (*game.Pet, error)
 	// Exists returns true if a save file exists.
 	Exists() bool
+	// Delete removes the saved pet.
+	Delete() error
+	// Path returns the location of the underlying save file.
+	Path() string
+}
+
+// Migrator is implemented by stores that can upgrade their save in place.
+type Migrator interface {
+	// Migrate upgrades the stored pet to CurrentSchemaVersion, backing up
+	// the original first. With dryRun set, nothing is written.
+	Migrate(dryRun bool) (*MigrationResult, error)
+}
+
+// Syncer is implemented by stores that detect saves made by other processes.
+// Every save increments a revision counter; Save fails with ErrConflict if
+// the stored revision moved since this store last loaded or saved.
+type Syncer interface {
+	// Revision returns the revision last loaded or written by this store.
+	Revision() int64
+	// Changed reports whether another process has saved since then.
+	Changed() (bool, error)
 }
+
+// SaveOrReload saves pet to st. If another clipet process saved first, its
+// save wins: pet is replaced by the stored state and the ErrConflict is
+// returned, so the caller can tell the user the change was not kept.
+func SaveOrReload(st Store, pet *game.Pet) error {
+	err := st.Save(pet)
+	if !errors.Is(err, ErrConflict) {
+		return err
+	}
+	latest, lerr := st.Load()
+	if lerr != nil {
+		return fmt.Errorf("%w; reload: %v", err, lerr)
+	}
+	pet.ReplaceState(latest)
+	return err
+}
+
+// Backend names a Store implementation, selected via config.
+type Backend string
+
+const (
+	BackendJSON   Backend = "json"   // save.json per profile (default)
+	BackendSQLite Backend = "sqlite" // clipet.db per profile, with history tables
+)
@@ -9,10 +9,11 @@ import (
 	"clipet/internal/tui/keys"
 	"clipet/internal/tui/screens"
 	"clipet/internal/tui/styles"
+	"errors"
 	"time"
 
-	tea "charm.land/bubbletea/v2"
 	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
 )
 
 // screen identifies which TUI screen is active.
@@ -23,6 +24,12 @@ const (
 	screenHome
 	screenEvolve
 	screenAdventure
+	screenDiary
+	screenInventory
+	screenShop
+	screenRoster
+	screenMemorial
+	screenAchievements
 )
 
 // tickMsg is sent on each animation/update tick.
@@ -34,53 +41,97 @@ func doTick() tea.Cmd {
 	})
 }
 
-// App is the top-level Bubble Tea model.
+// Member is one pet of the household with the results of its offline
+// settlement.
+type Member struct {
+	Pet     *game.Pet
+	Store   store.Store
+	Served  bool // a daemon serves the pet through Store and advances its time
+	Results []game.DecayRoundResult
+	Crises  []game.CrisisEvent
+	Neglect game.NeglectResult
+}
+
+// App is the top-level Bubble Tea model. pet and store are the focused
+// member of the household.
 type App struct {
+	members      []Member
+	focus        int
 	pet          *game.Pet
 	registry     *plugin.Registry
 	store        store.Store
+	hall         *store.Memorial // the profile's memorial hall
 	i18n         *i18n.Manager
 	petView      *components.PetView
 	theme        styles.Theme
 	globalKeyMap keys.GlobalKeyMap
 
 	offlineSettlement screens.OfflineSettlementModel
+	settlementQueue   []screens.OfflineSettlementModel // reports of the other pets, shown in turn
 	home              screens.HomeModel
 	evolve            screens.EvolveModel
 	adventure         screens.AdventureModel
+	diary             screens.DiaryModel
+	inventory         screens.InventoryModel
+	shop              screens.ShopModel
+	roster            screens.RosterModel
+	memorial          screens.MemorialModel
+	achievements      screens.AchievementsModel
 	active            screen
 
 	width        int
 	height       int
 	quitting     bool
-	decayApplied bool // whether offline decay has been applied
+	quitWarning  string    // why the last save on quit was not kept
+	unsaved      bool      // a save of the focused pet failed, its change is only in memory
+	crisisTick   time.Time // when the focused pet's crisis clock last moved
+	decayApplied bool      // whether offline decay has been applied
 }
 
-// NewApp creates the top-level TUI application model.
-func NewApp(pet *game.Pet, reg *plugin.Registry, st store.Store, i18nMgr *i18n.Manager, offlineResults []game.DecayRoundResult) App {
+// NewApp creates the top-level TUI application model for a household,
+// focused on members[focus], with the profile's memorial hall. Members with
+// offline results get a settlement report before the home screen.
+func NewApp(members []Member, focus int, reg *plugin.Registry, hall *store.Memorial, i18nMgr *i18n.Manager) App {
+	pet, st := members[focus].Pet, members[focus].Store
 	pv := components.NewPetView(pet, reg)
 	theme := styles.DefaultTheme()
 	home := screens.NewHomeModel(pet, reg, st, pv, theme, i18nMgr)
 
-	// Create offline settlement screen if there are results
+	// Create offline settlement screens for members with results
+	var settlements []screens.OfflineSettlementModel
+	for _, m := range members {
+		if len(m.Results) == 0 && len(m.Crises) == 0 && len(m.Neglect.Housemates) == 0 {
+			continue
+		}
+		report := screens.NewOfflineSettlementModel(m.Results, m.Crises, theme, i18nMgr)
+		if len(members) > 1 {
+			report = report.WithPet(m.Pet.Name, m.Neglect)
+		}
+		settlements = append(settlements, report)
+	}
 	var offlineSettlement screens.OfflineSettlementModel
 	activeScreen := screenHome
-	if len(offlineResults) > 0 {
-		offlineSettlement = screens.NewOfflineSettlementModel(offlineResults, theme, i18nMgr)
+	if len(settlements) > 0 {
+		offlineSettlement, settlements = settlements[0], settlements[1:]
 		activeScreen = screenOfflineSettlement
 	}
 
 	return App{
+		members:           members,
+		focus:             focus,
 		pet:               pet,
 		registry:          reg,
 		store:             st,
+		hall:              hall,
 		i18n:              i18nMgr,
 		petView:           pv,
 		theme:             theme,
 		globalKeyMap:      keys.NewGlobalKeyMap(i18nMgr),
 		offlineSettlement: offlineSettlement,
+		settlementQueue:   settlements,
 		home:              home,
 		active:            activeScreen,
+		crisisTick:        time.Now(),
 	}
 }
 
@@ -99,6 +150,15 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.home = a.home.SetSize(msg.Width, msg.Height)
 		a.evolve = a.evolve.SetSize(msg.Width, msg.Height)
 		a.adventure = a.adventure.SetSize(msg.Width, msg.Height)
+		a.diary = a.diary.SetSize(msg.Width, msg.Height)
+		a.inventory = a.inventory.SetSize(msg.Width, msg.Height)
+		a.shop = a.shop.SetSize(msg.Width, msg.Height)
+		a.roster = a.roster.SetSize(msg.Width, msg.Height)
+		a.memorial = a.memorial.SetSize(msg.Width, msg.Height)
+		a.achievements = a.achievements.SetSize(msg.Width, msg.Height)
+		for i := range a.settlementQueue {
+			a.settlementQueue[i] = a.settlementQueue[i].SetSize(msg.Width, msg.Height)
+		}
 		return a, nil
 
 	case tea.KeyPressMsg:
@@ -108,7 +168,9 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if msg.String() == "ctrl+c" || a.active == screenHome {
 				a.quitting = true
 				a.pet.MarkAsChecked() // Mark as checked before saving
-				_ = a.store.Save(a.pet)
+				if err := a.save(a.pet, a.store); err != nil {
+					a.quitWarning = a.saveWarning(err, a.pet.Name)
+				}
 				return a, tea.Quit
 			}
 		}
@@ -126,10 +188,7 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if a.active == screenEvolve {
 			a.evolve = a.evolve.Tick()
 			if a.evolve.IsDone() {
-				a.pet.MarkAsChecked() // Mark as checked before saving
-				_ = a.store.Save(a.pet)
-				a.active = screenHome
-				a.home = a.home.UpdatePet(a.pet)
+				a.finishEvolve()
 			}
 			return a, doTick()
 		}
@@ -137,19 +196,20 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if a.active == screenAdventure {
 			a.adventure = a.adventure.Tick()
 			if a.adventure.IsDone() {
-				a.pet.MarkAsChecked() // Mark as checked before saving
-				_ = a.store.Save(a.pet)
-				a.active = screenHome
-				a.home = a.home.UpdatePet(a.pet)
+				a.finishAdventure()
 			}
 			return a, doTick()
 		}
 
-		// On home screen: update pet, tick game/dialogue
+		// On home screen: pick up saves from other clipet processes,
+		// move the crisis clock, then update pet, tick game/dialogue
+		a.syncExternal()
+		a.tickCrises(time.Time(msg))
 		a.home = a.home.UpdatePet(a.pet)
 		a.home = a.home.TickGame()
 		a.home = a.home.TickAutoDialogue()
 		a.home = a.home.TickSuccessAnimation()
+		a.home = a.home.TickAchievements()
 		return a, doTick()
 	}
 
@@ -159,6 +219,10 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		var cmd tea.Cmd
 		a.offlineSettlement, cmd = a.offlineSettlement.Update(msg)
 		if a.offlineSettlement.IsDone() {
+			if len(a.settlementQueue) > 0 {
+				a.offlineSettlement, a.settlementQueue = a.settlementQueue[0], a.settlementQueue[1:]
+				return a, cmd
+			}
 			a.active = screenHome
 			a.home = a.home.UpdatePet(a.pet)
 		}
@@ -175,6 +239,64 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			a.active = screenAdventure
 			return a, cmd
 		}
+		if a.home.PendingDiary() {
+			a.home = a.home.ClearPendingDiary()
+			events, _ := store.JournalFor(a.store).Events(store.EventFilter{})
+			a.diary = screens.NewDiaryModel(events, a.theme, a.i18n)
+			a.diary = a.diary.SetSize(a.width, a.height)
+			a.active = screenDiary
+			return a, cmd
+		}
+		if a.home.PendingInventory() {
+			a.home = a.home.ClearPendingInventory()
+			a.inventory = screens.NewInventoryModel(a.pet, a.registry, a.theme, a.i18n)
+			a.inventory = a.inventory.SetSize(a.width, a.height)
+			a.active = screenInventory
+			return a, cmd
+		}
+		if a.home.PendingShop() {
+			a.home = a.home.ClearPendingShop()
+			a.shop = screens.NewShopModel(a.pet, a.theme, a.i18n)
+			a.shop = a.shop.SetSize(a.width, a.height)
+			a.active = screenShop
+			return a, cmd
+		}
+		if a.home.PendingRoster() {
+			a.home = a.home.ClearPendingRoster()
+			pets := make([]*game.Pet, len(a.members))
+			for i, m := range a.members {
+				pets[i] = m.Pet
+			}
+			a.roster = screens.NewRosterModel(pets, a.focus, a.registry, a.theme, a.i18n)
+			a.roster = a.roster.SetSize(a.width, a.height)
+			a.active = screenRoster
+			return a, cmd
+		}
+		if a.home.PendingMemorial() {
+			a.home = a.home.ClearPendingMemorial()
+			entries, _ := a.hall.Entries()
+			a.memorial = screens.NewMemorialModel(entries, a.registry, a.theme, a.i18n)
+			a.memorial = a.memorial.SetSize(a.width, a.height)
+			a.active = screenMemorial
+			return a, cmd
+		}
+		if a.home.PendingAchievements() {
+			a.home = a.home.ClearPendingAchievements()
+			progress, err := store.AchievementsFor(a.store).Load()
+			if err != nil {
+				a.home = a.home.ShowWarning(a.i18n.T("ui.achievements.load_failed", "error", err.Error()))
+				return a, cmd
+			}
+			a.achievements = screens.NewAchievementsModel(a.registry, progress, a.theme, a.i18n)
+			a.achievements = a.achievements.SetSize(a.width, a.height)
+			a.active = screenAchievements
+			return a, cmd
+		}
+		if a.home.PendingSuccessor() {
+			a.home = a.home.ClearPendingSuccessor()
+			a.hatchSuccessor()
+			return a, cmd
+		}
 		// Check evolution after user actions (not during games)
 		if !a.home.IsPlayingGame() {
 			a.checkEvolution()
@@ -185,10 +307,7 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		var cmd tea.Cmd
 		a.evolve, cmd = a.evolve.Update(msg)
 		if a.evolve.IsDone() {
-			a.pet.MarkAsChecked() // Mark as checked before saving
-			_ = a.store.Save(a.pet)
-			a.active = screenHome
-			a.home = a.home.UpdatePet(a.pet)
+			a.finishEvolve()
 		}
 		return a, cmd
 
@@ -196,8 +315,74 @@ func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		var cmd tea.Cmd
 		a.adventure, cmd = a.adventure.Update(msg)
 		if a.adventure.IsDone() {
-			a.pet.MarkAsChecked() // Mark as checked before saving
-			_ = a.store.Save(a.pet)
+			a.finishAdventure()
+		}
+		return a, cmd
+
+	case screenDiary:
+		var cmd tea.Cmd
+		a.diary, cmd = a.diary.Update(msg)
+		if a.diary.IsDone() {
+			a.active = screenHome
+			a.home = a.home.UpdatePet(a.pet)
+		}
+		return a, cmd
+
+	case screenInventory:
+		var cmd tea.Cmd
+		a.inventory, cmd = a.inventory.Update(msg)
+		if a.inventory.IsDone() {
+			a.active = screenHome
+			a.home = a.home.UpdatePet(a.pet)
+			if id := a.inventory.Selected(); id != "" {
+				a.home = a.home.UseItem(id)
+				a.checkEvolution()
+			}
+		}
+		return a, cmd
+
+	case screenShop:
+		var cmd tea.Cmd
+		a.shop, cmd = a.shop.Update(msg)
+		if a.shop.IsDone() {
+			a.active = screenHome
+			a.home = a.home.UpdatePet(a.pet)
+			if id := a.shop.Selected(); id != "" {
+				a.home = a.home.BuyItem(id)
+			}
+		}
+		return a, cmd
+
+	case screenRoster:
+		var cmd tea.Cmd
+		a.roster, cmd = a.roster.Update(msg)
+		if a.roster.IsDone() {
+			a.active = screenHome
+			a.home = a.home.UpdatePet(a.pet)
+			if i := a.roster.PlayWith(); i >= 0 {
+				partner := a.members[i]
+				a.home = a.home.PlayWith(partner.Pet, partner.Store)
+				_ = a.save(partner.Pet, partner.Store)
+				a.checkEvolution()
+			} else if i := a.roster.FocusTo(); i >= 0 {
+				a.switchFocus(i)
+			}
+		}
+		return a, cmd
+
+	case screenMemorial:
+		var cmd tea.Cmd
+		a.memorial, cmd = a.memorial.Update(msg)
+		if a.memorial.IsDone() {
+			a.active = screenHome
+			a.home = a.home.UpdatePet(a.pet)
+		}
+		return a, cmd
+
+	case screenAchievements:
+		var cmd tea.Cmd
+		a.achievements, cmd = a.achievements.Update(msg)
+		if a.achievements.IsDone() {
 			a.active = screenHome
 			a.home = a.home.UpdatePet(a.pet)
 		}
@@ -218,9 +403,168 @@ func (a *App) checkEvolution() {
 	a.active = screenEvolve
 }
 
+// finishEvolve saves and records a completed evolution, then returns home.
+func (a *App) finishEvolve() {
+	if res := a.evolve.Result(); res != nil && a.pet.StageID == res.ToStage.ID {
+		_ = store.History(a.store, store.SourceTUI).RecordEvolution(time.Now(), a.pet, res.Evolution.From, res.ToStage.ID)
+	}
+	a.returnHome()
+}
+
+// finishAdventure saves and records a completed adventure, then returns home.
+func (a *App) finishAdventure() {
+	if res := a.adventure.Result(); res != nil {
+		_ = store.History(a.store, store.SourceTUI).RecordAdventure(time.Now(), a.pet, *res)
+	}
+	a.returnHome()
+}
+
+// returnHome saves the pet and switches back to the home screen.
+func (a *App) returnHome() {
+	a.pet.MarkAsChecked() // Mark as checked before saving
+	_ = a.save(a.pet, a.store)
+	a.active = screenHome
+	a.home = a.home.UpdatePet(a.pet)
+}
+
+// switchFocus saves the focused pet and moves the focus to members[i].
+// A new home screen is built for the pet, which may then evolve.
+func (a *App) switchFocus(i int) {
+	a.pet.MarkAsChecked()
+	_ = a.save(a.pet, a.store)
+
+	a.focus = i
+	a.unsaved = false
+	a.crisisTick = time.Now()
+	a.pet, a.store = a.members[i].Pet, a.members[i].Store
+	a.petView.SetPet(a.pet)
+	a.home = screens.NewHomeModel(a.pet, a.registry, a.store, a.petView, a.theme, a.i18n)
+	a.home = a.home.SetSize(a.width, a.height)
+	a.home = a.home.ShowInfo(a.i18n.T("ui.roster.focused", "name", a.pet.Name))
+	a.checkEvolution()
+}
+
+// hatchSuccessor moves the focused pet, which has passed away, into the
+// memorial hall and replaces it with its successor, which gets a new home
+// screen.
+func (a *App) hatchSuccessor() {
+	if a.pet.Alive {
+		return
+	}
+	name := game.SuccessorName(a.pet)
+	for i, m := range a.members {
+		if i != a.focus && m.Pet.Name == name {
+			a.home = a.home.ShowWarning(a.i18n.T("ui.home.successor_name_taken", "name", name))
+			return
+		}
+	}
+
+	// The predecessor goes into the memorial hall only once the successor
+	// replaced it, so a save conflict followed by a retry records it once.
+	successor, _ := game.NewSuccessor(a.pet, name)
+	entry := store.NewMemorialEntry(a.pet, name)
+	predecessor := a.pet.Name
+	a.pet.ReplaceState(successor)
+	if err := a.save(a.pet, a.store); errors.Is(err, store.ErrConflict) {
+		return // the pet was reloaded from the other process's save
+	}
+	hallErr := a.hall.Add(entry)
+	birth := store.NewEvent(time.Now(), store.EventBirth, store.SourceTUI, a.pet)
+	birth.Subject = a.pet.Species
+	birth.Detail = a.pet.Name
+	_ = store.JournalFor(a.store).Append(birth)
+
+	a.petView.SetPet(a.pet)
+	a.home = screens.NewHomeModel(a.pet, a.registry, a.store, a.petView, a.theme, a.i18n)
+	a.home = a.home.SetSize(a.width, a.height)
+	if hallErr != nil {
+		a.home = a.home.ShowWarning(a.i18n.T("ui.home.save_failed"))
+		return
+	}
+	a.home = a.home.ShowInfo(a.i18n.T("ui.home.successor_hatched", "name", a.pet.Name, "predecessor", predecessor))
+}
+
+// save writes a pet of the household. If another clipet process saved it
+// first, that save wins and the pet is reloaded, as in the daemon; the home
+// screen warns that the change was not kept. Other failures leave the
+// change in memory to be saved later.
+func (a *App) save(pet *game.Pet, st store.Store) error {
+	err := store.SaveOrReload(st, pet)
+	if err != nil {
+		a.home = a.home.ShowWarning(a.saveWarning(err, pet.Name))
+	}
+	if st == a.store {
+		a.unsaved = err != nil && !errors.Is(err, store.ErrConflict)
+		a.home = a.home.ClearUnsaved()
+	}
+	return err
+}
+
+// saveWarning returns the warning shown when a save of the named pet failed.
+func (a *App) saveWarning(err error, name string) string {
+	if errors.Is(err, store.ErrConflict) {
+		return a.i18n.T("ui.home.save_conflict", "name", name)
+	}
+	return a.i18n.T("ui.home.save_failed")
+}
+
+// tickCrises moves the focused pet's crisis clock by the time since the
+// last tick. The TUI otherwise keeps the pet's time still while it is open
+// (see MarkAsChecked), so without this a crisis could never run out. Time
+// spent on other screens is caught up on the next home tick. Pets served by
+// a daemon get their time from it.
+func (a *App) tickCrises(now time.Time) {
+	elapsed := now.Sub(a.crisisTick)
+	a.crisisTick = now
+	if a.members[a.focus].Served {
+		return
+	}
+	a.pet.TickCrises(elapsed)
+	events := a.pet.TakeCrisisEvents()
+	if len(events) == 0 {
+		return
+	}
+	_ = store.JournalFor(a.store).AppendCrises(store.SourceTUI, a.pet, events)
+	a.pet.MarkAsChecked()
+	if err := a.save(a.pet, a.store); err != nil {
+		return // save warns about the failure
+	}
+	a.home = a.home.ShowCrises(events)
+}
+
+// syncExternal reloads the pet when another clipet process saved it.
+// It only runs while the home screen is idle so no flow works on stale state.
+// Changes whose save failed are lost to the reload, which is reported as a
+// conflict instead of a plain reload.
+func (a *App) syncExternal() {
+	syncer, ok := a.store.(store.Syncer)
+	if !ok || a.active != screenHome || a.home.IsPlayingGame() {
+		return
+	}
+	if changed, err := syncer.Changed(); err != nil || !changed || !a.store.Exists() {
+		return
+	}
+
+	pet, err := a.store.Load()
+	if err != nil {
+		return
+	}
+	a.pet.ReplaceState(pet)
+	if a.unsaved || a.home.Unsaved() {
+		a.unsaved = false
+		a.home = a.home.ClearUnsaved()
+		a.home = a.home.ShowWarning(a.i18n.T("ui.home.save_conflict", "name", a.pet.Name))
+		return
+	}
+	a.home = a.home.ShowInfo(a.i18n.T("ui.home.external_reload"))
+}
+
 // View implements tea.Model.
 func (a App) View() tea.View {
 	if a.quitting {
+		if a.quitWarning != "" {
+			return tea.NewView(a.quitWarning + "\n" + a.i18n.T("ui.common.quit") + "\n")
+		}
 		return tea.NewView(a.i18n.T("ui.common.quit") + "\n")
 	}
 
@@ -234,6 +578,18 @@ func (a App) View() tea.View {
 		content = a.evolve.View()
 	case screenAdventure:
 		content = a.adventure.View()
+	case screenDiary:
+		content = a.diary.View()
+	case screenInventory:
+		content = a.inventory.View()
+	case screenShop:
+		content = a.shop.View()
+	case screenRoster:
+		content = a.roster.View()
+	case screenMemorial:
+		content = a.memorial.View()
+	case screenAchievements:
+		content = a.achievements.View()
 	}
 
 	v := tea.NewView(content)
@@ -0,0 +1,81 @@
+package components
+
+import (
+	"clipet/internal/plugin"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Overlay draws accessory layers over ASCII art, in order. Each layer's art
+// is placed with its top-left corner at (X, Y) of the base art, measured in
+// display columns and lines; spaces in a layer are transparent. Negative
+// offsets or layers reaching past the art grow the canvas. A wide character
+// (CJK, emoji) that a layer only half covers is replaced with a space.
+func Overlay(art string, layers []plugin.AccessoryOverlay) string {
+	if len(layers) == 0 {
+		return art
+	}
+
+	// Shift the canvas so that every layer starts at a non-negative position
+	originX, originY := 0, 0
+	for _, l := range layers {
+		originX = max(originX, -l.X)
+		originY = max(originY, -l.Y)
+	}
+	lines := make([]string, originY, originY+strings.Count(art, "\n")+1)
+	for _, l := range strings.Split(art, "\n") {
+		lines = append(lines, strings.Repeat(" ", originX)+l)
+	}
+
+	for _, l := range layers {
+		for i, src := range strings.Split(strings.TrimRight(l.Art, "\n"), "\n") {
+			row := originY + l.Y + i
+			for len(lines) <= row {
+				lines = append(lines, "")
+			}
+			col := originX + l.X
+			for _, run := range opaqueRuns(src) {
+				lines[row] = placeAt(lines[row], col+run.col, run.text)
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// artRun is a run of non-space characters of a layer line.
+type artRun struct {
+	col  int // display column of the run within the line
+	text string
+}
+
+// opaqueRuns splits a layer line into its runs of non-space characters.
+func opaqueRuns(line string) []artRun {
+	var runs []artRun
+	col := 0
+	for _, field := range strings.SplitAfter(line, " ") {
+		text := strings.TrimRight(field, " ")
+		if text != "" {
+			runs = append(runs, artRun{col: col, text: text})
+		}
+		col += DisplayWidth(field)
+	}
+	return runs
+}
+
+// placeAt writes text over line starting at display column col, padding the
+// line with spaces as needed.
+func placeAt(line string, col int, text string) string {
+	width := DisplayWidth(line)
+	end := col + DisplayWidth(text)
+
+	left := ansi.Truncate(line, col, "")
+	left += strings.Repeat(" ", col-DisplayWidth(left))
+
+	right := ""
+	if width > end {
+		right = ansi.TruncateLeft(line, end, "")
+		right = strings.Repeat(" ", width-end-DisplayWidth(right)) + right
+	}
+	return left + text + right
+}
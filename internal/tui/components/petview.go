@@ -46,7 +46,11 @@ func (pv *PetView) Render() string {
 
 	idx := pv.frameIndex % len(frame.Frames)
 	raw := strings.TrimRight(frame.Frames[idx], "\n")
-	return NormalizeArt(raw, frame.Width)
+	art := NormalizeArt(raw, frame.Width)
+	if layers := pv.pet.EquippedOverlays(); len(layers) > 0 {
+		art = NormalizeArt(Overlay(art, layers), 0)
+	}
+	return art
 }
 
 func (pv *PetView) fallbackArt() string {
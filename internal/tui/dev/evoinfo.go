@@ -158,6 +158,15 @@ func printConditionChecks(pet *game.Pet, cond plugin.EvolutionCondition) bool {
 		}
 	}
 
+	// expr (free-form condition expression)
+	if cond.Expr != "" {
+		met := pet.EvalCondition(cond.Expr)
+		fmt.Printf("    %s 表达式: %s\n", CheckMark(met), cond.Expr)
+		if !met {
+			allMet = false
+		}
+	}
+
 	return allMet
 }
 
@@ -254,16 +263,11 @@ func CheckMark(met bool) string {
 }
 
 func getAccumulator(pet *game.Pet, bias string) int {
-	switch bias {
-	case "happiness":
-		return pet.AccHappiness
-	case "health":
-		return pet.AccHealth
-	case "playful":
-		return pet.AccPlayful
-	default:
+	acc := game.AttrBiasAccumulator(bias)
+	if acc == "" {
 		return 0
 	}
+	return pet.GetCustomAcc(acc)
 }
 
 // AttrName returns a human-readable attribute name
@@ -12,9 +12,9 @@ import (
 	"strings"
 	"time"
 
-	tea "charm.land/bubbletea/v2"
 	"charm.land/bubbles/v2/help"
 	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 )
 
@@ -24,6 +24,7 @@ type PreviewModel struct {
 	Tree     components.TreeList
 	Fps      int
 	FrameIdx int
+	Overlay  int // 1-based index into the pack's accessories with overlays; 0 = none
 	Width    int
 	Height   int
 	Quitting bool
@@ -179,6 +180,9 @@ func (m *PreviewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.Fps--
 			}
 			return m, nil
+		case key.Matches(msg, m.KeyMap.Overlay):
+			m.Overlay = (m.Overlay + 1) % (len(m.accessories()) + 1)
+			return m, nil
 		case key.Matches(msg, m.KeyMap.Global.ToggleHelp):
 			m.Help.ShowAll = !m.Help.ShowAll
 			return m, nil
@@ -285,11 +289,20 @@ func (m *PreviewModel) renderPreview(width int) string {
 		artStr = components.NormalizeArt(raw, frame.Width)
 	}
 
+	stats := fmt.Sprintf("帧 %d/%d  %d fps", m.FrameIdx%max(frameCount, 1)+1, max(frameCount, 1), m.Fps)
+	if accessories := m.accessories(); m.Overlay > 0 && m.Overlay <= len(accessories) {
+		acc := accessories[m.Overlay-1]
+		stats += "  饰品: " + acc.Name
+		stageID, _ := data["stageID"].(string)
+		if ov := acc.OverlayFor(stageID); ov != nil && frameCount > 0 {
+			artStr = components.NormalizeArt(components.Overlay(artStr, []plugin.AccessoryOverlay{*ov}), 0)
+		} else {
+			stats += " (本阶段无)"
+		}
+	}
+
 	frameInfo := previewInfoStyle.Render(fmt.Sprintf("▶ %s", frameKey))
-	frameStats := previewInfoStyle.Render(fmt.Sprintf(
-		"帧 %d/%d  %d fps",
-		m.FrameIdx%max(frameCount, 1)+1, max(frameCount, 1), m.Fps,
-	))
+	frameStats := previewInfoStyle.Render(stats)
 
 	content := lipgloss.JoinVertical(lipgloss.Left,
 		panelTitle,
@@ -307,6 +320,17 @@ func (m *PreviewModel) renderPreview(width int) string {
 		Render(content)
 }
 
+// accessories returns the pack's accessories that draw overlays.
+func (m *PreviewModel) accessories() []*plugin.Item {
+	var items []*plugin.Item
+	for i := range m.Pack.Items {
+		if item := &m.Pack.Items[i]; item.Kind == plugin.ItemAccessory && len(item.Overlays) > 0 {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 // renderTreePanel renders the right panel with tree list
 func (m *PreviewModel) renderTreePanel(width int) string {
 	title := previewTitleStyle.Render(" 帧列表 ")
@@ -68,11 +68,11 @@ func NewNavigationKeyMap(i18n *i18n.Manager) NavigationKeyMap {
 
 // HomeActionKeyMap contains action shortcut keys for the home screen.
 type HomeActionKeyMap struct {
-	Feed  key.Binding
-	Play  key.Binding
-	Rest  key.Binding
-	Heal  key.Binding
-	Talk  key.Binding
+	Feed key.Binding
+	Play key.Binding
+	Rest key.Binding
+	Heal key.Binding
+	Talk key.Binding
 }
 
 // NewHomeActionKeyMap creates an action keymap with i18n support.
@@ -106,6 +106,8 @@ type HomeKeyMap struct {
 	Global     GlobalKeyMap
 	Navigation NavigationKeyMap
 	Actions    HomeActionKeyMap
+	Successor  key.Binding // once the pet has passed away
+	Memorial   key.Binding // once the pet has passed away
 }
 
 // NewHomeKeyMap creates a complete home screen keymap.
@@ -114,9 +116,22 @@ func NewHomeKeyMap(i18n *i18n.Manager) HomeKeyMap {
 		Global:     NewGlobalKeyMap(i18n),
 		Navigation: NewNavigationKeyMap(i18n),
 		Actions:    NewHomeActionKeyMap(i18n),
+		Successor: key.NewBinding(
+			key.WithKeys("n"),
+			key.WithHelp("n", i18n.T("ui.keys.successor")),
+		),
+		Memorial: key.NewBinding(
+			key.WithKeys("m"),
+			key.WithHelp("m", i18n.T("ui.keys.memorial")),
+		),
 	}
 }
 
+// EndingHelp returns the keybindings left once the pet has passed away.
+func (k HomeKeyMap) EndingHelp() []key.Binding {
+	return []key.Binding{k.Successor, k.Memorial, k.Global.Quit}
+}
+
 // ShortHelp returns keybindings for the short help.
 func (k HomeKeyMap) ShortHelp() []key.Binding {
 	return []key.Binding{
@@ -314,10 +329,11 @@ func (k OfflineSettlementKeyMap) FullHelp() [][]key.Binding {
 
 // PreviewKeyMap contains keys for preview command (dev tool).
 type PreviewKeyMap struct {
-	Global    GlobalKeyMap
+	Global     GlobalKeyMap
 	Navigation NavigationKeyMap
-	SpeedUp   key.Binding
-	SlowDown  key.Binding
+	SpeedUp    key.Binding
+	SlowDown   key.Binding
+	Overlay    key.Binding
 }
 
 // NewPreviewKeyMap creates a preview keymap.
@@ -333,6 +349,10 @@ func NewPreviewKeyMap(i18n *i18n.Manager) PreviewKeyMap {
 			key.WithKeys("-", "_"),
 			key.WithHelp("-", i18n.T("ui.keys.slow_down")),
 		),
+		Overlay: key.NewBinding(
+			key.WithKeys("o"),
+			key.WithHelp("o", i18n.T("ui.keys.overlay")),
+		),
 	}
 }
 
@@ -343,6 +363,7 @@ func (k PreviewKeyMap) ShortHelp() []key.Binding {
 		k.Navigation.Down,
 		k.SpeedUp,
 		k.SlowDown,
+		k.Overlay,
 		k.Global.Quit,
 		k.Global.ToggleHelp,
 	}
@@ -352,7 +373,8 @@ func (k PreviewKeyMap) ShortHelp() []key.Binding {
 func (k PreviewKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.Navigation.Up, k.Navigation.Down, k.Navigation.Left, k.Navigation.Right},
-		{k.SpeedUp, k.SlowDown, k.Global.Quit, k.Global.ToggleHelp},
+		{k.SpeedUp, k.SlowDown, k.Overlay},
+		{k.Global.Quit, k.Global.ToggleHelp},
 	}
 }
 
@@ -423,3 +445,345 @@ func (k TimeskipKeyMap) FullHelp() [][]key.Binding {
 		{k.Global.Quit, k.Global.ToggleHelp},
 	}
 }
+
+// ProfilePickerKeyMap contains keys for the startup profile picker.
+type ProfilePickerKeyMap struct {
+	Global     GlobalKeyMap
+	Navigation NavigationKeyMap
+}
+
+// NewProfilePickerKeyMap creates a profile picker keymap with i18n support.
+func NewProfilePickerKeyMap(i18n *i18n.Manager) ProfilePickerKeyMap {
+	return ProfilePickerKeyMap{
+		Global:     NewGlobalKeyMap(i18n),
+		Navigation: NewNavigationKeyMap(i18n),
+	}
+}
+
+// ShortHelp returns keybindings for the short help.
+func (k ProfilePickerKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{
+		k.Navigation.Up,
+		k.Navigation.Down,
+		k.Navigation.Enter,
+		k.Global.Quit,
+	}
+}
+
+// FullHelp returns keybindings for the full help.
+func (k ProfilePickerKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Navigation.Up, k.Navigation.Down, k.Navigation.Enter},
+		{k.Global.Quit, k.Global.ToggleHelp},
+	}
+}
+
+// DiaryKeyMap contains keys for the diary (event journal) screen.
+type DiaryKeyMap struct {
+	Global GlobalKeyMap
+	Up     key.Binding
+	Down   key.Binding
+	Top    key.Binding
+	Bottom key.Binding
+	Filter key.Binding
+	Back   key.Binding
+}
+
+// NewDiaryKeyMap creates a diary keymap.
+func NewDiaryKeyMap(i18n *i18n.Manager) DiaryKeyMap {
+	return DiaryKeyMap{
+		Global: NewGlobalKeyMap(i18n),
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", i18n.T("ui.keys.up")),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", i18n.T("ui.keys.down")),
+		),
+		Top: key.NewBinding(
+			key.WithKeys("g", "home"),
+			key.WithHelp("g", i18n.T("ui.keys.top")),
+		),
+		Bottom: key.NewBinding(
+			key.WithKeys("G", "end"),
+			key.WithHelp("G", i18n.T("ui.keys.bottom")),
+		),
+		Filter: key.NewBinding(
+			key.WithKeys("tab", "f"),
+			key.WithHelp("tab/f", i18n.T("ui.keys.filter")),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("esc", "q"),
+			key.WithHelp("esc/q", i18n.T("ui.keys.back")),
+		),
+	}
+}
+
+// ShortHelp returns keybindings for the short help.
+func (k DiaryKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{
+		k.Up,
+		k.Down,
+		k.Filter,
+		k.Back,
+		k.Global.ToggleHelp,
+	}
+}
+
+// FullHelp returns keybindings for the full help.
+func (k DiaryKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Top, k.Bottom},
+		{k.Filter, k.Back},
+	}
+}
+
+// InventoryKeyMap contains keys for the inventory screen.
+type InventoryKeyMap struct {
+	Global GlobalKeyMap
+	Up     key.Binding
+	Down   key.Binding
+	Use    key.Binding
+	Back   key.Binding
+}
+
+// NewInventoryKeyMap creates an inventory keymap.
+func NewInventoryKeyMap(i18n *i18n.Manager) InventoryKeyMap {
+	return InventoryKeyMap{
+		Global: NewGlobalKeyMap(i18n),
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", i18n.T("ui.keys.up")),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", i18n.T("ui.keys.down")),
+		),
+		Use: key.NewBinding(
+			key.WithKeys("enter", " "),
+			key.WithHelp("↵", i18n.T("ui.keys.use")),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("esc", "q"),
+			key.WithHelp("esc/q", i18n.T("ui.keys.back")),
+		),
+	}
+}
+
+// ShortHelp returns keybindings for the short help.
+func (k InventoryKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{
+		k.Up,
+		k.Down,
+		k.Use,
+		k.Back,
+		k.Global.ToggleHelp,
+	}
+}
+
+// FullHelp returns keybindings for the full help.
+func (k InventoryKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down},
+		{k.Use, k.Back},
+	}
+}
+
+// ShopKeyMap contains keys for the shop screen.
+type ShopKeyMap struct {
+	Global GlobalKeyMap
+	Up     key.Binding
+	Down   key.Binding
+	Buy    key.Binding
+	Back   key.Binding
+}
+
+// NewShopKeyMap creates a shop keymap.
+func NewShopKeyMap(i18n *i18n.Manager) ShopKeyMap {
+	return ShopKeyMap{
+		Global: NewGlobalKeyMap(i18n),
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", i18n.T("ui.keys.up")),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", i18n.T("ui.keys.down")),
+		),
+		Buy: key.NewBinding(
+			key.WithKeys("enter", " "),
+			key.WithHelp("↵", i18n.T("ui.keys.buy")),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("esc", "q"),
+			key.WithHelp("esc/q", i18n.T("ui.keys.back")),
+		),
+	}
+}
+
+// ShortHelp returns keybindings for the short help.
+func (k ShopKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{
+		k.Up,
+		k.Down,
+		k.Buy,
+		k.Back,
+		k.Global.ToggleHelp,
+	}
+}
+
+// FullHelp returns keybindings for the full help.
+func (k ShopKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down},
+		{k.Buy, k.Back},
+	}
+}
+
+// RosterKeyMap contains keys for the household roster screen.
+type RosterKeyMap struct {
+	Global GlobalKeyMap
+	Up     key.Binding
+	Down   key.Binding
+	Focus  key.Binding
+	Play   key.Binding
+	Back   key.Binding
+}
+
+// NewRosterKeyMap creates a roster keymap.
+func NewRosterKeyMap(i18n *i18n.Manager) RosterKeyMap {
+	return RosterKeyMap{
+		Global: NewGlobalKeyMap(i18n),
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", i18n.T("ui.keys.up")),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", i18n.T("ui.keys.down")),
+		),
+		Focus: key.NewBinding(
+			key.WithKeys("enter", " "),
+			key.WithHelp("↵", i18n.T("ui.keys.focus")),
+		),
+		Play: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", i18n.T("ui.keys.play_together")),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("esc", "q"),
+			key.WithHelp("esc/q", i18n.T("ui.keys.back")),
+		),
+	}
+}
+
+// ShortHelp returns keybindings for the short help.
+func (k RosterKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{
+		k.Up,
+		k.Down,
+		k.Focus,
+		k.Play,
+		k.Back,
+		k.Global.ToggleHelp,
+	}
+}
+
+// FullHelp returns keybindings for the full help.
+func (k RosterKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down},
+		{k.Focus, k.Play, k.Back},
+	}
+}
+
+// MemorialKeyMap contains keys for the memorial hall screen.
+type MemorialKeyMap struct {
+	Global GlobalKeyMap
+	Up     key.Binding
+	Down   key.Binding
+	Back   key.Binding
+}
+
+// NewMemorialKeyMap creates a memorial hall keymap.
+func NewMemorialKeyMap(i18n *i18n.Manager) MemorialKeyMap {
+	return MemorialKeyMap{
+		Global: NewGlobalKeyMap(i18n),
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", i18n.T("ui.keys.up")),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", i18n.T("ui.keys.down")),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("esc", "q"),
+			key.WithHelp("esc/q", i18n.T("ui.keys.back")),
+		),
+	}
+}
+
+// ShortHelp returns keybindings for the short help.
+func (k MemorialKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{
+		k.Up,
+		k.Down,
+		k.Back,
+		k.Global.ToggleHelp,
+	}
+}
+
+// FullHelp returns keybindings for the full help.
+func (k MemorialKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down},
+		{k.Back},
+	}
+}
+
+// AchievementsKeyMap contains keys for the achievements screen.
+type AchievementsKeyMap struct {
+	Global GlobalKeyMap
+	Up     key.Binding
+	Down   key.Binding
+	Back   key.Binding
+}
+
+// NewAchievementsKeyMap creates an achievements keymap.
+func NewAchievementsKeyMap(i18n *i18n.Manager) AchievementsKeyMap {
+	return AchievementsKeyMap{
+		Global: NewGlobalKeyMap(i18n),
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", i18n.T("ui.keys.up")),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", i18n.T("ui.keys.down")),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("esc", "q"),
+			key.WithHelp("esc/q", i18n.T("ui.keys.back")),
+		),
+	}
+}
+
+// ShortHelp returns keybindings for the short help.
+func (k AchievementsKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{
+		k.Up,
+		k.Down,
+		k.Back,
+		k.Global.ToggleHelp,
+	}
+}
+
+// FullHelp returns keybindings for the full help.
+func (k AchievementsKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down},
+		{k.Back},
+	}
+}
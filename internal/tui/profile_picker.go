@@ -0,0 +1,137 @@
+package tui
+
+import (
+	"clipet/internal/i18n"
+	"clipet/internal/store"
+	"clipet/internal/tui/keys"
+	"clipet/internal/tui/styles"
+	"fmt"
+
+	"charm.land/bubbles/v2/help"
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// ProfilePicker is a standalone model for choosing a save profile on startup.
+type ProfilePicker struct {
+	profiles []store.ProfileInfo
+	active   string
+	cursor   int
+	theme    styles.Theme
+	i18n     *i18n.Manager
+	keyMap   keys.ProfilePickerKeyMap
+	help     help.Model
+
+	width  int
+	chosen string
+}
+
+// NewProfilePicker creates a picker with the cursor on the active profile.
+func NewProfilePicker(profiles []store.ProfileInfo, active string, i18nMgr *i18n.Manager) ProfilePicker {
+	cursor := 0
+	for i, p := range profiles {
+		if p.Name == active {
+			cursor = i
+			break
+		}
+	}
+	return ProfilePicker{
+		profiles: profiles,
+		active:   active,
+		cursor:   cursor,
+		theme:    styles.DefaultTheme(),
+		i18n:     i18nMgr,
+		keyMap:   keys.NewProfilePickerKeyMap(i18nMgr),
+		help:     help.New(),
+	}
+}
+
+// Chosen returns the selected profile name, or "" if the picker was cancelled.
+func (p ProfilePicker) Chosen() string {
+	return p.chosen
+}
+
+// Init implements tea.Model.
+func (p ProfilePicker) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model.
+func (p ProfilePicker) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		p.width = msg.Width
+		return p, nil
+
+	case tea.KeyPressMsg:
+		switch {
+		case key.Matches(msg, p.keyMap.Global.Quit), key.Matches(msg, p.keyMap.Navigation.Back):
+			return p, tea.Quit
+		case key.Matches(msg, p.keyMap.Global.ToggleHelp):
+			p.help.ShowAll = !p.help.ShowAll
+		case key.Matches(msg, p.keyMap.Navigation.Up):
+			if p.cursor > 0 {
+				p.cursor--
+			}
+		case key.Matches(msg, p.keyMap.Navigation.Down):
+			if p.cursor < len(p.profiles)-1 {
+				p.cursor++
+			}
+		case key.Matches(msg, p.keyMap.Navigation.Enter):
+			p.chosen = p.profiles[p.cursor].Name
+			return p, tea.Quit
+		}
+	}
+	return p, nil
+}
+
+// View implements tea.Model.
+func (p ProfilePicker) View() tea.View {
+	w := p.width
+	if w < 40 {
+		w = 40
+	}
+
+	title := p.theme.TitleBar.Render("🐾 " + p.i18n.T("ui.profile_picker.title"))
+
+	var rows []string
+	for i, info := range p.profiles {
+		label := p.entryLabel(info)
+		if i == p.cursor {
+			rows = append(rows, p.theme.ActionCellSelected.Width(w-6).Render("▸ "+label))
+		} else {
+			rows = append(rows, p.theme.ActionCell.Width(w-6).Render("  "+label))
+		}
+	}
+
+	helpBar := p.theme.HelpBar.Render(p.help.View(p.keyMap))
+
+	v := tea.NewView(lipgloss.JoinVertical(lipgloss.Left,
+		title,
+		"",
+		lipgloss.JoinVertical(lipgloss.Left, rows...),
+		"",
+		helpBar,
+	))
+	v.AltScreen = true
+	return v
+}
+
+// entryLabel formats one profile row.
+func (p ProfilePicker) entryLabel(info store.ProfileInfo) string {
+	label := info.Name
+	if info.Name == p.active {
+		label += " " + p.i18n.T("ui.profile_picker.active")
+	}
+
+	if !info.HasPet {
+		return label + "  " + p.i18n.T("ui.profile_picker.empty")
+	}
+
+	detail := fmt.Sprintf("%s · %s/%s", info.PetName, info.Species, info.StageID)
+	if !info.Alive {
+		detail += " · " + p.i18n.T("ui.profile_picker.deceased")
+	}
+	return label + "  " + lipgloss.NewStyle().Foreground(styles.DimColor()).Render(detail)
+}
@@ -0,0 +1,174 @@
+package screens
+
+import (
+	"clipet/internal/game"
+	"clipet/internal/i18n"
+	"clipet/internal/plugin"
+	"clipet/internal/tui/keys"
+	"clipet/internal/tui/styles"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/help"
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+)
+
+// AchievementsModel lists the achievements of the profile, unlocked ones
+// with their date and pet, locked ones with their progress. Hidden
+// achievements stay secret until unlocked.
+type AchievementsModel struct {
+	achievements []plugin.Achievement
+	progress     *game.AchievementProgress
+	registry     *plugin.Registry
+	theme        styles.Theme
+	i18n         *i18n.Manager
+	keyMap       keys.AchievementsKeyMap
+	help         help.Model
+
+	cursor int
+	width  int
+	height int
+	done   bool
+}
+
+// NewAchievementsModel creates an achievements screen for the registry's
+// achievements and the profile's progress.
+func NewAchievementsModel(reg *plugin.Registry, progress *game.AchievementProgress, theme styles.Theme, i18nMgr *i18n.Manager) AchievementsModel {
+	return AchievementsModel{
+		achievements: reg.ListAchievements(),
+		progress:     progress,
+		registry:     reg,
+		theme:        theme,
+		i18n:         i18nMgr,
+		keyMap:       keys.NewAchievementsKeyMap(i18nMgr),
+		help:         help.New(),
+	}
+}
+
+// SetSize updates terminal dimensions.
+func (m AchievementsModel) SetSize(w, h int) AchievementsModel {
+	m.width = w
+	m.height = h
+	return m
+}
+
+// IsDone returns true when the user leaves the achievements screen.
+func (m AchievementsModel) IsDone() bool {
+	return m.done
+}
+
+// Update handles key input.
+func (m AchievementsModel) Update(msg tea.Msg) (AchievementsModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyPressMsg:
+		switch {
+		case key.Matches(msg, m.keyMap.Global.ToggleHelp):
+			m.help.ShowAll = !m.help.ShowAll
+		case key.Matches(msg, m.keyMap.Back):
+			m.done = true
+		case key.Matches(msg, m.keyMap.Up):
+			m.cursor = clamp(m.cursor-1, 0, max(len(m.achievements)-1, 0))
+		case key.Matches(msg, m.keyMap.Down):
+			m.cursor = clamp(m.cursor+1, 0, max(len(m.achievements)-1, 0))
+		}
+	}
+	return m, nil
+}
+
+// View renders the achievements screen.
+func (m AchievementsModel) View() string {
+	var b strings.Builder
+
+	unlocked := 0
+	for _, a := range m.achievements {
+		if _, ok := m.progress.Unlock(a); ok {
+			unlocked++
+		}
+	}
+	b.WriteString(titleStyle.Render(m.i18n.T("ui.achievements.title")) + "  " +
+		mutedStyle.Render(m.i18n.T("ui.achievements.summary", "unlocked", unlocked, "total", len(m.achievements))) + "\n\n")
+
+	if len(m.achievements) == 0 {
+		b.WriteString("  " + textStyle.Render(m.i18n.T("ui.achievements.empty")) + "\n")
+	}
+
+	// Keep the selected achievement in view, leaving room for its details
+	visible := max(m.height-12, 3)
+	start := clamp(m.cursor-visible+1, 0, max(len(m.achievements)-visible, 0))
+	end := min(start+visible, len(m.achievements))
+	for i, a := range m.achievements[start:end] {
+		icon, name, _ := m.text(a)
+		_, ok := m.progress.Unlock(a)
+		mark := "·"
+		if ok {
+			mark = "✓"
+		}
+		line := fmt.Sprintf("%s %s %s", mark, icon, name)
+		switch {
+		case start+i == m.cursor:
+			b.WriteString("  " + successStyle.Render("▶ "+line) + "\n")
+		case ok:
+			b.WriteString("    " + textStyle.Render(line) + "\n")
+		default:
+			b.WriteString("    " + mutedStyle.Render(line) + "\n")
+		}
+	}
+
+	if len(m.achievements) > 0 {
+		b.WriteString("\n" + m.details(m.achievements[m.cursor]))
+	}
+
+	b.WriteString("\n")
+	b.WriteString(m.theme.HelpBar.Render(m.help.View(m.keyMap)) + "\n")
+
+	return b.String()
+}
+
+// details renders the selected achievement: description, species and
+// when it was unlocked or how far along it is.
+func (m AchievementsModel) details(a plugin.Achievement) string {
+	var b strings.Builder
+	icon, name, description := m.text(a)
+	b.WriteString("  " + textStyle.Render(icon+" "+name) + "\n")
+	b.WriteString("  " + mutedStyle.Render(description) + "\n")
+	if sp := m.registry.GetSpecies(a.Species); sp != nil {
+		b.WriteString("  " + mutedStyle.Render(m.i18n.T("ui.achievements.species", "species", sp.Species.Name)) + "\n")
+	}
+	if u, ok := m.progress.Unlock(a); ok {
+		b.WriteString("  " + successStyle.Render(m.i18n.T("ui.achievements.unlocked",
+			"date", u.At.Local().Format("2006-01-02 15:04"), "pet", u.Pet)) + "\n")
+	} else if a.Needed() > 1 && !a.Hidden {
+		b.WriteString("  " + textStyle.Render(m.i18n.T("ui.achievements.progress",
+			"count", m.progress.Count(a), "needed", a.Needed())) + "\n")
+	}
+	return b.String()
+}
+
+// text returns the icon, name and description to show for an achievement,
+// masked while a hidden one is locked.
+func (m AchievementsModel) text(a plugin.Achievement) (icon, name, description string) {
+	if _, ok := m.progress.Unlock(a); !ok && a.Hidden {
+		return "❔", m.i18n.T("ui.achievements.hidden_name"), m.i18n.T("ui.achievements.hidden_description")
+	}
+	name, description = achievementText(m.i18n, a)
+	return a.Icon, name, description
+}
+
+// achievementText returns the localized name and description of an
+// achievement. Built-in achievements are translated in the core locales,
+// species achievements by their pack.
+func achievementText(i18nMgr *i18n.Manager, a plugin.Achievement) (name, description string) {
+	name, description = a.Name, a.Description
+	if a.Species != "" {
+		return name, description
+	}
+	key := "game.achievements." + a.ID
+	if msg := i18nMgr.T(key + ".name"); !strings.HasPrefix(msg, key) {
+		name = msg
+	}
+	if msg := i18nMgr.T(key + ".description"); !strings.HasPrefix(msg, key) {
+		description = msg
+	}
+	return name, description
+}
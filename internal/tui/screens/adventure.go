@@ -8,12 +8,14 @@ import (
 	"clipet/internal/tui/keys"
 	"clipet/internal/tui/styles"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
-	tea "charm.land/bubbletea/v2"
 	"charm.land/bubbles/v2/help"
 	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 )
 
@@ -21,10 +23,10 @@ import (
 type AdventurePhase int
 
 const (
-	AdventureIntro    AdventurePhase = iota // show description
-	AdventureChoosing                       // player picks a choice
-	AdventureResolving                      // brief animation
-	AdventureResult                         // show outcome + effects
+	AdventureIntro     AdventurePhase = iota // show description
+	AdventureChoosing                        // player picks a choice
+	AdventureResolving                       // brief animation
+	AdventureResult                          // show outcome + effects
 )
 
 // AdventureModel is the adventure event screen.
@@ -75,6 +77,19 @@ func (a AdventureModel) IsDone() bool {
 	return a.done
 }
 
+// Result returns the adventure outcome once it has been resolved, or nil.
+func (a AdventureModel) Result() *game.AdventureResult {
+	if a.outcome == nil {
+		return nil
+	}
+	return &game.AdventureResult{
+		Adventure: a.adventure,
+		Choice:    a.adventure.Choices[a.choiceIdx],
+		Outcome:   *a.outcome,
+		Changes:   a.changes,
+	}
+}
+
 // Tick advances the resolving animation.
 func (a AdventureModel) Tick() AdventureModel {
 	if a.phase == AdventureResolving {
@@ -363,6 +378,16 @@ func (a AdventureModel) viewResult(w int) string {
 			Render(a.i18n.T("ui.adventure.no_changes"))
 	}
 
+	// Item rewards
+	for _, itemID := range slices.Sorted(maps.Keys(a.outcome.Items)) {
+		name := itemID
+		if item := a.registry.GetItem(a.pet.Species, itemID); item != nil {
+			name = item.Name
+		}
+		effectBlock += "\n" + lipgloss.NewStyle().Foreground(lipgloss.Color("#04B575")).Render(
+			"  "+a.i18n.T("ui.adventure.item_reward", "item", name, "count", a.outcome.Items[itemID]))
+	}
+
 	help := a.theme.HelpBar.Render("Enter " + a.i18n.T("ui.common.back"))
 
 	return lipgloss.JoinVertical(lipgloss.Left,
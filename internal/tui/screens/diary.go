@@ -0,0 +1,247 @@
+package screens
+
+import (
+	"clipet/internal/i18n"
+	"clipet/internal/store"
+	"clipet/internal/tui/keys"
+	"clipet/internal/tui/styles"
+	"fmt"
+	"sort"
+	"strings"
+
+	"charm.land/bubbles/v2/help"
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+)
+
+// eventIcons maps journal event types to diary icons.
+var eventIcons = map[store.EventType]string{
+	store.EventBirth:       "🥚",
+	store.EventAction:      "🐾",
+	store.EventAdventure:   "🗺️",
+	store.EventEvolution:   "✨",
+	store.EventDecay:       "🌙",
+	store.EventCrisis:      "🚨",
+	store.EventAchievement: "🏆",
+	store.EventDeath:       "🕯️",
+	store.EventEdit:        "🔧",
+}
+
+// DiaryModel is the scrollable pet diary built from the event journal.
+type DiaryModel struct {
+	events []store.Event // newest first
+	theme  styles.Theme
+	i18n   *i18n.Manager
+	keyMap keys.DiaryKeyMap
+	help   help.Model
+
+	filter       int // 0 = all, otherwise index+1 into store.EventTypes
+	scrollOffset int
+	maxVisible   int
+	width        int
+	height       int
+	done         bool
+}
+
+// NewDiaryModel creates a diary screen for events given oldest-first.
+func NewDiaryModel(events []store.Event, theme styles.Theme, i18nMgr *i18n.Manager) DiaryModel {
+	newest := make([]store.Event, len(events))
+	for i, e := range events {
+		newest[len(events)-1-i] = e
+	}
+	return DiaryModel{
+		events: newest,
+		theme:  theme,
+		i18n:   i18nMgr,
+		keyMap: keys.NewDiaryKeyMap(i18nMgr),
+		help:   help.New(),
+	}
+}
+
+// SetSize updates terminal dimensions.
+func (m DiaryModel) SetSize(w, h int) DiaryModel {
+	m.width = w
+	m.height = h
+	// Reserve space for header (3 lines) and footer (3 lines)
+	m.maxVisible = h - 6
+	if m.maxVisible < 5 {
+		m.maxVisible = 5
+	}
+	m.scrollOffset = clamp(m.scrollOffset, 0, m.maxScroll())
+	return m
+}
+
+// IsDone returns true when the user leaves the diary.
+func (m DiaryModel) IsDone() bool {
+	return m.done
+}
+
+// Update handles key input.
+func (m DiaryModel) Update(msg tea.Msg) (DiaryModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyPressMsg:
+		switch {
+		case key.Matches(msg, m.keyMap.Global.ToggleHelp):
+			m.help.ShowAll = !m.help.ShowAll
+		case key.Matches(msg, m.keyMap.Back):
+			m.done = true
+		case key.Matches(msg, m.keyMap.Filter):
+			m.filter = (m.filter + 1) % (len(store.EventTypes) + 1)
+			m.scrollOffset = 0
+		case key.Matches(msg, m.keyMap.Up):
+			m.scrollOffset = clamp(m.scrollOffset-1, 0, m.maxScroll())
+		case key.Matches(msg, m.keyMap.Down):
+			m.scrollOffset = clamp(m.scrollOffset+1, 0, m.maxScroll())
+		case key.Matches(msg, m.keyMap.Top):
+			m.scrollOffset = 0
+		case key.Matches(msg, m.keyMap.Bottom):
+			m.scrollOffset = m.maxScroll()
+		}
+	}
+	return m, nil
+}
+
+// View renders the diary.
+func (m DiaryModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(titleStyle.Render(m.i18n.T("ui.diary.title")) + "\n\n")
+
+	filterLabel := m.i18n.T("ui.diary.filter_all")
+	if t, ok := m.filterType(); ok {
+		filterLabel = m.i18n.T("ui.diary.types." + string(t))
+	}
+	b.WriteString("  " + mutedStyle.Render(m.i18n.T("ui.diary.filter", "filter", filterLabel)) + "\n\n")
+
+	lines := m.lines()
+	if len(lines) == 0 {
+		b.WriteString("  " + textStyle.Render(m.i18n.T("ui.diary.empty")) + "\n")
+	}
+
+	endIdx := m.scrollOffset + m.maxVisible
+	if endIdx > len(lines) {
+		endIdx = len(lines)
+	}
+	for _, line := range lines[m.scrollOffset:endIdx] {
+		b.WriteString(line + "\n")
+	}
+
+	b.WriteString("\n")
+	footer := m.help.View(m.keyMap)
+	if len(lines) > m.maxVisible {
+		footer += mutedStyle.Render(fmt.Sprintf(" [%d/%d]", m.scrollOffset+1, len(lines)))
+	}
+	b.WriteString(m.theme.HelpBar.Render(footer) + "\n")
+
+	return b.String()
+}
+
+// filterType returns the selected event type, if a filter is active.
+func (m DiaryModel) filterType() (store.EventType, bool) {
+	if m.filter == 0 {
+		return "", false
+	}
+	return store.EventTypes[m.filter-1], true
+}
+
+// lines renders the visible (filtered) events, one or two lines each.
+func (m DiaryModel) lines() []string {
+	t, filtered := m.filterType()
+
+	var lines []string
+	for _, e := range m.events {
+		if filtered && e.Type != t {
+			continue
+		}
+
+		header := fmt.Sprintf("%s %s  %s",
+			e.Time.Local().Format("01-02 15:04"),
+			eventIcons[e.Type],
+			m.describe(e))
+		switch {
+		case e.Type == store.EventDeath || !e.OK:
+			header = dangerStyle.Render(header)
+		case e.Type == store.EventEvolution || e.Type == store.EventBirth || e.Type == store.EventAchievement:
+			header = successStyle.Render(header)
+		default:
+			header = textStyle.Render(header)
+		}
+		lines = append(lines, "  "+header)
+
+		if len(e.Changes) > 0 {
+			lines = append(lines, "      "+mutedStyle.Render(m.formatChanges(e.Changes)))
+		}
+	}
+	return lines
+}
+
+// describe returns the one-line summary of an event.
+func (m DiaryModel) describe(e store.Event) string {
+	switch e.Type {
+	case store.EventBirth:
+		return m.i18n.T("ui.diary.birth", "name", e.Detail)
+	case store.EventAction:
+		label := m.i18n.T("ui.home.actions." + e.Subject)
+		if strings.HasPrefix(e.Subject, "skill:") || strings.HasPrefix(e.Subject, "game:") || strings.HasPrefix(e.Subject, "item:") ||
+			strings.HasPrefix(e.Subject, "buy:") || strings.HasPrefix(e.Subject, "equip:") || strings.HasPrefix(e.Subject, "unequip:") ||
+			strings.HasPrefix(e.Subject, "play:") || strings.HasPrefix(e.Subject, "breed:") {
+			label = e.Subject
+		}
+		if !e.OK {
+			return m.i18n.T("ui.diary.action_failed", "action", label)
+		}
+		return m.i18n.T("ui.diary.action", "action", label)
+	case store.EventAdventure:
+		if e.Detail != "" {
+			return m.i18n.T("ui.diary.adventure", "outcome", e.Detail)
+		}
+		return m.i18n.T("ui.diary.types.adventure")
+	case store.EventEvolution:
+		return m.i18n.T("ui.diary.evolution", "from", e.Detail, "to", e.Subject)
+	case store.EventDecay:
+		return m.i18n.T("ui.diary.decay", "duration", e.Detail)
+	case store.EventCrisis:
+		return m.i18n.T("ui.diary.crisis_"+e.Detail, "crisis", e.Subject)
+	case store.EventAchievement:
+		return m.i18n.T("ui.diary.achievement", "name", m.achievementName(e))
+	case store.EventDeath:
+		return m.i18n.T("ui.diary.death", "name", e.Pet)
+	case store.EventEdit:
+		return m.i18n.T("ui.diary.edit", "field", e.Subject, "change", e.Detail)
+	}
+	return string(e.Type)
+}
+
+// achievementName returns the localized name of an unlocked achievement.
+// Built-in achievements are translated in the core locales; species
+// achievements are recorded with their pack's name.
+func (m DiaryModel) achievementName(e store.Event) string {
+	if !strings.Contains(e.Subject, "/") {
+		key := "game.achievements." + e.Subject + ".name"
+		if name := m.i18n.T(key); name != key {
+			return name
+		}
+	}
+	return e.Detail
+}
+
+// formatChanges renders attribute changes as "attr old→new" pairs, sorted by name.
+func (m DiaryModel) formatChanges(changes map[string][2]int) string {
+	names := make([]string, 0, len(changes))
+	for name := range changes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		ch := changes[name]
+		parts = append(parts, fmt.Sprintf("%s %d→%d", name, ch[0], ch[1]))
+	}
+	return strings.Join(parts, "  ")
+}
+
+// maxScroll returns the maximum scroll offset.
+func (m DiaryModel) maxScroll() int {
+	return max(len(m.lines())-m.maxVisible, 0)
+}
@@ -9,14 +9,17 @@ import (
 	"clipet/internal/tui/components"
 	"clipet/internal/tui/keys"
 	"clipet/internal/tui/styles"
+	"errors"
 	"fmt"
+	"maps"
 	"math/rand"
+	"slices"
 	"strings"
 	"time"
 
-	tea "charm.land/bubbletea/v2"
 	"charm.land/bubbles/v2/help"
 	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 )
 
@@ -40,11 +43,14 @@ var categories = []menuCategory{
 		{"🍖", "feed", "feed"},
 		{"💤", "rest", "rest"},
 		{"💊", "heal", "heal"},
+		{"🎒", "inventory", "inventory"},
+		{"🛒", "shop", "shop"},
 	}},
 	{"🎮", "interact", []actionItem{
 		{"🎮", "play", "play"},
 		{"💬", "talk", "talk"},
 		{"🗺️", "adventure", "adventure"},
+		{"👪", "roster", "roster"},
 	}},
 	{"🎯", "games", []actionItem{
 		{"⚡", "game_reaction", "game_reaction"},
@@ -53,6 +59,9 @@ var categories = []menuCategory{
 	{"📋", "view", []actionItem{
 		{"📋", "info", "info"},
 		{"✨", "extra_attrs", "extra_attrs"},
+		{"📖", "diary", "diary"},
+		{"🏆", "achievements", "achievements"},
+		{"🕯", "memorial", "memorial"},
 	}},
 }
 
@@ -100,13 +109,24 @@ type HomeModel struct {
 	msgIsInfo  bool   // true if message is info-type
 	msgIsWarn  bool   // true if message is a warning
 	lastTalkAt time.Time
+	unsaved    bool // the last save failed, the pet's change is only in memory
+
+	successMsg       string // success message with animation
+	successAnimFrame int    // animation frame counter
 
-	successMsg     string // success message with animation
-	successAnimFrame int   // animation frame counter
+	toasts     []string // achievement unlocks waiting to be shown, oldest first
+	toastFrame int      // ticks the first toast has been shown
 
 	activeGame games.MiniGame // non-nil when a game is in progress
 
-	pendingAdventure *plugin.Adventure // set when user triggers adventure
+	pendingAdventure    *plugin.Adventure // set when user triggers adventure
+	pendingDiary        bool              // set when user opens the diary
+	pendingInventory    bool              // set when user opens the inventory
+	pendingShop         bool              // set when user opens the shop
+	pendingRoster       bool              // set when user opens the household roster
+	pendingMemorial     bool              // set when user opens the memorial hall
+	pendingAchievements bool              // set when user opens the achievements
+	pendingSuccessor    bool              // set when user hatches the successor of a dead pet
 }
 
 // NewHomeModel creates a new home screen model.
@@ -181,6 +201,27 @@ func (h HomeModel) TickSuccessAnimation() HomeModel {
 	return h
 }
 
+// toastTicks is how many ticks an achievement toast stays (about 4 seconds).
+const toastTicks = 8
+
+// TickAchievements queues a toast for each achievement the pet unlocked
+// and retires the shown toast once its time is up.
+func (h HomeModel) TickAchievements() HomeModel {
+	for _, a := range h.pet.TakeAchievements() {
+		name, _ := achievementText(h.i18n, a)
+		h.toasts = append(h.toasts, h.i18n.T("ui.home.achievement_unlocked", "icon", a.Icon, "name", name))
+	}
+	if len(h.toasts) == 0 {
+		return h
+	}
+	h.toastFrame++
+	if h.toastFrame >= toastTicks {
+		h.toasts = h.toasts[1:]
+		h.toastFrame = 0
+	}
+	return h
+}
+
 // TickGame advances the active mini-game by one tick.
 func (h HomeModel) TickGame() HomeModel {
 	if h.activeGame != nil {
@@ -205,6 +246,170 @@ func (h HomeModel) ClearPendingAdventure() HomeModel {
 	return h
 }
 
+// PendingDiary reports whether the user asked to open the diary.
+func (h HomeModel) PendingDiary() bool {
+	return h.pendingDiary
+}
+
+// ClearPendingDiary clears the diary request.
+func (h HomeModel) ClearPendingDiary() HomeModel {
+	h.pendingDiary = false
+	return h
+}
+
+// PendingInventory reports whether the user asked to open the inventory.
+func (h HomeModel) PendingInventory() bool {
+	return h.pendingInventory
+}
+
+// ClearPendingInventory clears the inventory request.
+func (h HomeModel) ClearPendingInventory() HomeModel {
+	h.pendingInventory = false
+	return h
+}
+
+// PendingShop reports whether the user asked to open the shop.
+func (h HomeModel) PendingShop() bool {
+	return h.pendingShop
+}
+
+// ClearPendingShop clears the shop request.
+func (h HomeModel) ClearPendingShop() HomeModel {
+	h.pendingShop = false
+	return h
+}
+
+// PendingRoster reports whether the user asked to open the household roster.
+func (h HomeModel) PendingRoster() bool {
+	return h.pendingRoster
+}
+
+// ClearPendingRoster clears the roster request.
+func (h HomeModel) ClearPendingRoster() HomeModel {
+	h.pendingRoster = false
+	return h
+}
+
+// PendingMemorial reports whether the user asked to open the memorial hall.
+func (h HomeModel) PendingMemorial() bool {
+	return h.pendingMemorial
+}
+
+// ClearPendingMemorial clears the memorial hall request.
+func (h HomeModel) ClearPendingMemorial() HomeModel {
+	h.pendingMemorial = false
+	return h
+}
+
+// PendingAchievements reports whether the user asked to open the achievements.
+func (h HomeModel) PendingAchievements() bool {
+	return h.pendingAchievements
+}
+
+// ClearPendingAchievements clears the achievements request.
+func (h HomeModel) ClearPendingAchievements() HomeModel {
+	h.pendingAchievements = false
+	return h
+}
+
+// PendingSuccessor reports whether the user asked to hatch the successor of
+// the pet, which has passed away.
+func (h HomeModel) PendingSuccessor() bool {
+	return h.pendingSuccessor
+}
+
+// ClearPendingSuccessor clears the successor request.
+func (h HomeModel) ClearPendingSuccessor() HomeModel {
+	h.pendingSuccessor = false
+	return h
+}
+
+// PlayWith lets the pet play with a housemate chosen in the roster screen,
+// records the action in both pets' journals and shows the result. The
+// caller saves the partner.
+func (h HomeModel) PlayWith(partner *game.Pet, partnerStore store.Store) HomeModel {
+	res, partnerRes := game.PlayTogether(h.pet, partner)
+	h.recordAction("play:"+partner.Name, res)
+	_ = store.History(partnerStore, store.SourceTUI).RecordAction(time.Now(), partner, "play:"+h.pet.Name, partnerRes)
+	if res.ErrorType == game.ErrPartnerBusy {
+		return h.failMsg(h.i18n.T("ui.home.partner_busy", "partner", partner.Name, "reason", h.localizeGameError(partnerRes)))
+	}
+	if !res.OK {
+		return h.failMsg(h.localizeGameError(res))
+	}
+	ch := res.Changes["happiness"]
+	return h.applyActionResult(res, h.i18n.T("ui.home.play_together",
+		"partner", partner.Name, "oldHappiness", ch[0], "newHappiness", ch[1]))
+}
+
+// BuyItem buys an item chosen in the shop screen and shows the result.
+func (h HomeModel) BuyItem(itemID string) HomeModel {
+	res := h.pet.BuyItem(itemID)
+	h.recordAction("buy:"+itemID, res)
+	if !res.OK {
+		return h.failMsg(h.localizeGameError(res))
+	}
+	name := itemID
+	if item := h.registry.GetItem(h.pet.Species, itemID); item != nil {
+		name = item.Name
+	}
+	return h.okMsg(h.i18n.T("ui.home.buy_success", "item", name, "coins", h.pet.Coins))
+}
+
+// UseItem uses an item chosen in the inventory screen and shows the result.
+// Choosing an accessory puts it on or takes it off.
+func (h HomeModel) UseItem(itemID string) HomeModel {
+	if item := h.registry.GetItem(h.pet.Species, itemID); item != nil && item.Kind == plugin.ItemAccessory {
+		return h.toggleAccessory(item)
+	}
+	res := h.pet.UseItem(itemID)
+	h.recordAction("item:"+itemID, res)
+	if !res.OK {
+		return h.failMsg(h.localizeGameError(res))
+	}
+	name := itemID
+	if item := h.registry.GetItem(h.pet.Species, itemID); item != nil {
+		name = item.Name
+	}
+	return h.applyActionResult(res, h.i18n.T("ui.home.item_success", "item", name, "changes", h.formatChanges(res.Changes)))
+}
+
+// toggleAccessory puts on or takes off an accessory and shows the result.
+func (h HomeModel) toggleAccessory(item *plugin.Item) HomeModel {
+	action, key := "equip:", "ui.home.accessory_on"
+	var res game.ActionResult
+	if h.pet.IsEquipped(item.ID) {
+		action, key = "unequip:", "ui.home.accessory_off"
+		res = h.pet.UnequipAccessory(item.ID)
+	} else {
+		res = h.pet.EquipAccessory(item.ID)
+	}
+	h.recordAction(action+item.ID, res)
+	if !res.OK {
+		return h.failMsg(h.localizeGameError(res))
+	}
+	return h.okMsg(h.i18n.T(key, "item", item.Name))
+}
+
+// formatChanges renders attribute changes as "Name old→new" pairs, sorted by attribute.
+func (h HomeModel) formatChanges(changes map[string][2]int) string {
+	parts := make([]string, 0, len(changes))
+	for _, attr := range slices.Sorted(maps.Keys(changes)) {
+		name := attr
+		if isCoreStat(attr) || attr == "coins" {
+			name = h.i18n.T("game.stats." + attr)
+		}
+		ch := changes[attr]
+		parts = append(parts, fmt.Sprintf("%s %d→%d", name, ch[0], ch[1]))
+	}
+	return strings.Join(parts, "  ")
+}
+
+// isCoreStat reports whether attr is one of the four core attributes.
+func isCoreStat(attr string) bool {
+	return attr == "hunger" || attr == "happiness" || attr == "health" || attr == "energy"
+}
+
 // getCurrentActions returns the current category's actions, including dynamically added skills.
 func (h HomeModel) getCurrentActions() []actionItem {
 	translatedCats := h.getTranslatedCategories()
@@ -213,7 +418,7 @@ func (h HomeModel) getCurrentActions() []actionItem {
 
 	// Dynamically add skill actions to the "interact" category (index 1)
 	if h.catIdx == 1 && h.pet.CapabilitiesRegistry() != nil {
-		skills := h.pet.CapabilitiesRegistry().GetActiveTraits(h.pet.Species)
+		skills := h.pet.CapabilitiesRegistry().GetActiveTraits(h.pet.Species, h.pet.UnlockedTraitIDs())
 		for _, skill := range skills {
 			// Use localized skill name from plugin registry
 			skillName := h.registry.GetTraitName(h.pet.Species, skill.ID)
@@ -244,6 +449,21 @@ func (h HomeModel) Update(msg tea.Msg) (HomeModel, tea.Cmd) {
 		case key.Matches(msg, h.keyMap.Global.ToggleHelp):
 			h.help.ShowAll = !h.help.ShowAll
 			return h, nil
+		}
+
+		// Once the pet has passed away only its successor and the
+		// memorial hall remain
+		if h.hasEnding() {
+			switch {
+			case key.Matches(msg, h.keyMap.Successor):
+				h.pendingSuccessor = true
+			case key.Matches(msg, h.keyMap.Memorial):
+				h.pendingMemorial = true
+			}
+			return h, nil
+		}
+
+		switch {
 		case key.Matches(msg, h.keyMap.Actions.Feed):
 			return h.executeAction("feed"), nil
 		case key.Matches(msg, h.keyMap.Actions.Play):
@@ -368,6 +588,18 @@ func (h HomeModel) localizeGameError(res game.ActionResult) string {
 		i18nKey = "game.errors.skill_unknown"
 	case game.ErrSkillNotActive:
 		i18nKey = "game.errors.skill_not_active"
+	case game.ErrNoItem:
+		i18nKey = "game.errors.no_item"
+	case game.ErrNotForSale:
+		i18nKey = "game.errors.not_for_sale"
+	case game.ErrNoCoins:
+		i18nKey = "game.errors.not_enough_coins"
+	case game.ErrInventoryFull:
+		i18nKey = "game.errors.inventory_full"
+	case game.ErrNotAccessory:
+		i18nKey = "game.errors.not_accessory"
+	case game.ErrPartnerBusy:
+		i18nKey = "game.errors.partner_busy"
 	default:
 		// Unknown ErrorType, fallback to Message
 		return res.Message
@@ -388,6 +620,8 @@ func (h HomeModel) localizeAdventureError(check game.AdventureCheckResult) strin
 		i18nKey = "game.errors.energy_low"
 	case game.ErrDead:
 		i18nKey = "game.errors.dead"
+	case game.ErrAdventureLimit:
+		return h.i18n.T("ui.home.adventure_limit", "minutes", int(check.Wait.Minutes())+1)
 	default:
 		return check.Message
 	}
@@ -402,12 +636,24 @@ func (h HomeModel) okMsg(msg string) HomeModel {
 	h.message = "" // Clear normal message
 	h.msgIsInfo = false
 	h.msgIsWarn = false
-	if err := h.store.Save(h.pet); err != nil {
+	switch err := store.SaveOrReload(h.store, h.pet); {
+	case errors.Is(err, store.ErrConflict):
+		h.successMsg = ""
+		return h.failMsg(h.i18n.T("ui.home.save_conflict", "name", h.pet.Name))
+	case err != nil:
+		h.unsaved = true
 		h.successMsg = msg + " " + h.i18n.T("ui.home.save_failed")
+	default:
+		h.unsaved = false
 	}
 	return h
 }
 
+// recordAction appends an action to the store's history, if it keeps one.
+func (h HomeModel) recordAction(action string, res game.ActionResult) {
+	_ = store.History(h.store, store.SourceTUI).RecordAction(time.Now(), h.pet, action, res)
+}
+
 // applyActionResult applies animation and shows message from ActionResult.
 func (h HomeModel) applyActionResult(res game.ActionResult, msg string) HomeModel {
 	// Apply animation if specified
@@ -415,7 +661,56 @@ func (h HomeModel) applyActionResult(res game.ActionResult, msg string) HomeMode
 		h.pet.CurrentAnimation = res.Animation
 		h.pet.AnimationEndTime = time.Now().Add(res.AnimationDuration)
 	}
-	return h.okMsg(msg)
+	return h.okMsg(h.withCrises(msg, res))
+}
+
+// withCrises appends the crises an action resolved to its success message.
+func (h HomeModel) withCrises(msg string, res game.ActionResult) string {
+	for _, c := range res.Crises {
+		msg += "  " + h.i18n.T("ui.home.crisis_resolved", "name", c.Name, "text", c.Text)
+	}
+	return msg
+}
+
+// ShowInfo displays an informational message on the home screen.
+func (h HomeModel) ShowInfo(msg string) HomeModel {
+	return h.infoMsg(msg)
+}
+
+// ShowWarning displays a warning on the home screen.
+func (h HomeModel) ShowWarning(msg string) HomeModel {
+	return h.failMsg(msg)
+}
+
+// ShowCrises warns about crises that started or failed while the TUI was
+// open. Started crises also stay listed in the status panel.
+func (h HomeModel) ShowCrises(events []game.CrisisEvent) HomeModel {
+	var msgs []string
+	for _, c := range events {
+		switch c.Phase {
+		case game.CrisisStarted:
+			msgs = append(msgs, h.i18n.T("ui.home.crisis_started", "name", c.Name, "left", formatLeft(time.Until(c.Deadline))))
+		case game.CrisisFailed:
+			msgs = append(msgs, h.i18n.T("ui.home.crisis_failed", "name", c.Name, "text", c.Text))
+		}
+	}
+	if len(msgs) == 0 {
+		return h
+	}
+	return h.failMsg(strings.Join(msgs, "  "))
+}
+
+// Unsaved returns true if the last save of the pet failed, so its state
+// holds changes that are not on disk.
+func (h HomeModel) Unsaved() bool {
+	return h.unsaved
+}
+
+// ClearUnsaved forgets a failed save, after the pet was saved or reloaded
+// by someone else.
+func (h HomeModel) ClearUnsaved() HomeModel {
+	h.unsaved = false
+	return h
 }
 
 // infoMsg sets an informational message.
@@ -430,6 +725,7 @@ func (h HomeModel) executeAction(action string) HomeModel {
 	switch action {
 	case "feed":
 		res := h.pet.Feed()
+		h.recordAction("feed", res)
 		if !res.OK {
 			return h.failMsg(h.localizeGameError(res))
 		}
@@ -438,6 +734,7 @@ func (h HomeModel) executeAction(action string) HomeModel {
 
 	case "play":
 		res := h.pet.Play()
+		h.recordAction("play", res)
 		if !res.OK {
 			return h.failMsg(h.localizeGameError(res))
 		}
@@ -446,6 +743,7 @@ func (h HomeModel) executeAction(action string) HomeModel {
 
 	case "talk":
 		res := h.pet.Talk()
+		h.recordAction("talk", res)
 		if !res.OK {
 			return h.failMsg(h.localizeGameError(res))
 		}
@@ -455,10 +753,11 @@ func (h HomeModel) executeAction(action string) HomeModel {
 		}
 		h.bubble.UpdateText(line)
 		h.lastTalkAt = time.Now()
-		return h.okMsg(h.i18n.T("ui.home.talk_success"))
+		return h.okMsg(h.withCrises(h.i18n.T("ui.home.talk_success"), res))
 
 	case "rest":
 		res := h.pet.Rest()
+		h.recordAction("rest", res)
 		if !res.OK {
 			return h.failMsg(h.localizeGameError(res))
 		}
@@ -468,18 +767,19 @@ func (h HomeModel) executeAction(action string) HomeModel {
 
 	case "heal":
 		res := h.pet.Heal()
+		h.recordAction("heal", res)
 		if !res.OK {
 			return h.failMsg(h.localizeGameError(res))
 		}
 		chH := res.Changes["health"]
 		chE := res.Changes["energy"]
-		return h.okMsg(h.i18n.T("ui.home.heal_success", "oldHealth", chH[0], "newHealth", chH[1], "oldEnergy", chE[0], "newEnergy", chE[1]))
+		return h.okMsg(h.withCrises(h.i18n.T("ui.home.heal_success", "oldHealth", chH[0], "newHealth", chH[1], "oldEnergy", chE[0], "newEnergy", chE[1]), res))
 
 	case "info":
 		return h.infoMsg(h.i18n.T("ui.home.stats_interactions",
 			"interact", h.pet.TotalInteractions,
 			"feed", h.pet.FeedCount,
-			"play", h.pet.AccPlayful,
+			"play", h.pet.GetCustomAcc(game.AccPlayful),
 			"talk", h.pet.DialogueCount,
 			"adventure", h.pet.AdventuresCompleted,
 		))
@@ -495,6 +795,30 @@ func (h HomeModel) executeAction(action string) HomeModel {
 		}
 		return h.infoMsg(strings.Join(lines, "\n"))
 
+	case "diary":
+		h.pendingDiary = true
+		return h
+
+	case "inventory":
+		h.pendingInventory = true
+		return h
+
+	case "shop":
+		h.pendingShop = true
+		return h
+
+	case "roster":
+		h.pendingRoster = true
+		return h
+
+	case "memorial":
+		h.pendingMemorial = true
+		return h
+
+	case "achievements":
+		h.pendingAchievements = true
+		return h
+
 	case "game_reaction":
 		return h.startGame(games.GameReactionSpeed)
 
@@ -522,6 +846,7 @@ func (h HomeModel) executeAction(action string) HomeModel {
 		if strings.HasPrefix(action, "skill:") {
 			skillID := strings.TrimPrefix(action, "skill:")
 			res := h.pet.UseSkill(skillID)
+			h.recordAction(action, res)
 			if !res.OK {
 				return h.failMsg(h.localizeGameError(res))
 			}
@@ -563,10 +888,19 @@ func (h HomeModel) processGameResult() HomeModel {
 	result := h.activeGame.GetResult()
 	config := h.activeGame.GetConfig()
 
+	oldHp := h.pet.Happiness
+	changes := make(map[string][2]int)
 	if result.Won {
 		h.pet.Happiness = game.Clamp(h.pet.Happiness+config.WinHappiness, 0, 100)
 		h.pet.GamesWon++
 		h.message = h.i18n.T("ui.home.game_won", "message", result.Message, "happiness", config.WinHappiness)
+		if result.Coins > 0 {
+			h.pet.AddCoins(result.Coins, changes)
+			h.message += "  " + h.i18n.T("ui.home.game_coins", "coins", result.Coins)
+		}
+		if prize := h.pet.DrawPrize(); prize != nil {
+			h.message += "  " + h.i18n.T("ui.home.game_prize", "item", prize.Name)
+		}
 	} else {
 		h.pet.Happiness = game.Clamp(h.pet.Happiness+config.LoseHappiness, 0, 100)
 		h.message = h.i18n.T("ui.home.game_lost", "message", result.Message, "happiness", config.LoseHappiness)
@@ -574,9 +908,21 @@ func (h HomeModel) processGameResult() HomeModel {
 	h.pet.TotalInteractions++
 	h.msgIsWarn = false
 	h.msgIsInfo = false
-
-	if err := h.store.Save(h.pet); err != nil {
+	// For mini-games the recorded OK flag means "won"
+	changes["happiness"] = [2]int{oldHp, h.pet.Happiness}
+	h.recordAction("game:"+string(config.Type), game.ActionResult{
+		OK:      result.Won,
+		Changes: changes,
+	})
+
+	switch err := store.SaveOrReload(h.store, h.pet); {
+	case errors.Is(err, store.ErrConflict):
+		return h.failMsg(h.i18n.T("ui.home.save_conflict", "name", h.pet.Name))
+	case err != nil:
+		h.unsaved = true
 		h.message += " " + h.i18n.T("ui.home.save_failed")
+	default:
+		h.unsaved = false
 	}
 	return h
 }
@@ -628,8 +974,11 @@ func (h HomeModel) View() string {
 	statusPanel := h.renderStatusPanel(rightW)
 	mainArea := lipgloss.JoinHorizontal(lipgloss.Top, petArt, statusPanel)
 
-	// 3) Message area
+	// 3) Message area, with the current achievement toast below it
 	msgArea := h.renderMessageArea(totalInner)
+	if toast := h.renderToast(totalInner); toast != "" {
+		msgArea = lipgloss.JoinVertical(lipgloss.Left, msgArea, toast)
+	}
 
 	// 4) Action menu (category tabs + sub-actions)
 	// Hide menu if pet has died
@@ -643,7 +992,7 @@ func (h HomeModel) View() string {
 	// 5) Help bar
 	var helpText string
 	if h.hasEnding() {
-		helpText = "q " + h.i18n.T("ui.common.quit")
+		helpText = h.help.ShortHelpView(h.keyMap.EndingHelp())
 	} else if h.inSubmenu {
 		helpText = h.help.View(h.keyMap)
 	} else {
@@ -756,6 +1105,9 @@ func (h HomeModel) renderStatusPanel(width int) string {
 	p := h.pet
 
 	name := h.theme.StatusName.Render(p.Name)
+	if p.Generation > 1 {
+		name += " " + mutedStyle.Render(h.i18n.T("game.stats.generation", "generation", p.Generation))
+	}
 
 	stageName := p.StageID
 	if stage := h.registry.GetStage(p.Species, p.StageID); stage != nil {
@@ -770,6 +1122,8 @@ func (h HomeModel) renderStatusPanel(width int) string {
 	ageLine := h.theme.StatusLabel.Render(h.i18n.T("game.stats.age")) + " " +
 		h.theme.StatusValue.Render(h.i18n.T("game.pet.age_hours", "hours", fmt.Sprintf("%.1f", p.AgeHours())))
 
+	traitsLine := h.theme.StatusLabel.Render(h.i18n.T("game.stats.traits")) + " " + h.traitNames()
+
 	const contentW = 20
 	sep := lipgloss.NewStyle().
 		Foreground(styles.DimColor()).
@@ -784,20 +1138,26 @@ func (h HomeModel) renderStatusPanel(width int) string {
 	statsBlock := strings.Join(bars, "\n")
 
 	// Add more statistics
-	stats := fmt.Sprintf("🗣 %s %d  🗺 %s %d",
+	stats := fmt.Sprintf("🗣 %s %d  🗺 %s %d\n🪙 %s %d  🔥 %s %d",
 		h.i18n.T("game.stats.dialogue"), p.DialogueCount,
-		h.i18n.T("game.stats.adventure"), p.AdventuresCompleted)
+		h.i18n.T("game.stats.adventure"), p.AdventuresCompleted,
+		h.i18n.T("game.stats.coins"), p.Coins,
+		h.i18n.T("game.stats.streak"), p.CareStreak)
 
 	content := lipgloss.JoinVertical(lipgloss.Left,
 		name,
 		stageLine,
 		moodLine,
 		ageLine,
+		traitsLine,
 		sep,
 		statsBlock,
 		sep,
 		stats,
 	)
+	if crises := h.crisisLines(); len(crises) > 0 {
+		content = lipgloss.JoinVertical(lipgloss.Left, append([]string{content, sep}, crises...)...)
+	}
 
 	const minHeight = 10
 	innerW := width - 6
@@ -810,21 +1170,74 @@ func (h HomeModel) renderStatusPanel(width int) string {
 		Render(content)
 }
 
+// traitNames lists the pet's traits. Traits that unlock in a later phase
+// are dimmed and marked with a lock.
+func (h HomeModel) traitNames() string {
+	ids := h.pet.TraitIDs()
+	if len(ids) == 0 {
+		return mutedStyle.Render(h.i18n.T("game.stats.no_traits"))
+	}
+	unlocked := h.pet.UnlockedTraitIDs()
+	names := make([]string, len(ids))
+	for i, id := range ids {
+		name := h.registry.GetTraitName(h.pet.Species, id)
+		if slices.Contains(unlocked, id) {
+			names[i] = h.theme.StatusValue.Render(name)
+		} else {
+			names[i] = mutedStyle.Render("🔒" + name)
+		}
+	}
+	return strings.Join(names, mutedStyle.Render(", "))
+}
+
+// crisisLines describes the pet's active crises with the time left and the
+// actions that resolve them.
+func (h HomeModel) crisisLines() []string {
+	var lines []string
+	for _, active := range h.pet.ActiveCrises {
+		def := h.registry.GetCrisis(h.pet.Species, active.ID)
+		if def == nil {
+			continue
+		}
+		actions := make([]string, len(def.Resolve))
+		for i, action := range def.Resolve {
+			if skillID, ok := strings.CutPrefix(action, "skill:"); ok {
+				actions[i] = h.registry.GetTraitName(h.pet.Species, skillID)
+			} else {
+				actions[i] = h.i18n.T("ui.home.actions." + action)
+			}
+		}
+		lines = append(lines, warningStyle.Render(h.i18n.T("ui.home.crisis_active",
+			"name", def.Name, "left", formatLeft(active.Left()), "actions", strings.Join(actions, "/"))))
+	}
+	return lines
+}
+
+// formatLeft formats the time left to resolve a crisis, e.g. "1h05m".
+func formatLeft(left time.Duration) string {
+	left = max(left, 0)
+	return fmt.Sprintf("%dh%02dm", int(left.Hours()), int(left.Minutes())%60)
+}
+
 func (h HomeModel) moodDisplay() (string, lipgloss.Style) {
-	mood := h.pet.MoodName()
+	return moodDisplay(h.pet.MoodName(), h.theme, h.i18n)
+}
+
+// moodDisplay returns the localized label and style of a mood name.
+func moodDisplay(mood string, theme styles.Theme, i18nMgr *i18n.Manager) (string, lipgloss.Style) {
 	switch mood {
 	case "happy":
-		return h.i18n.T("game.mood.happy"), h.theme.MoodHappy
+		return i18nMgr.T("game.mood.happy"), theme.MoodHappy
 	case "normal":
-		return h.i18n.T("game.mood.normal"), h.theme.MoodNormal
+		return i18nMgr.T("game.mood.normal"), theme.MoodNormal
 	case "unhappy":
-		return h.i18n.T("game.mood.unhappy"), h.theme.MoodSad
+		return i18nMgr.T("game.mood.unhappy"), theme.MoodSad
 	case "sad":
-		return h.i18n.T("game.mood.sad"), h.theme.MoodSad
+		return i18nMgr.T("game.mood.sad"), theme.MoodSad
 	case "miserable":
-		return h.i18n.T("game.mood.miserable"), h.theme.MoodMiserable
+		return i18nMgr.T("game.mood.miserable"), theme.MoodMiserable
 	default:
-		return h.i18n.T("game.mood.unknown"), h.theme.MoodNormal
+		return i18nMgr.T("game.mood.unknown"), theme.MoodNormal
 	}
 }
 
@@ -930,38 +1343,19 @@ func (h HomeModel) statBar(icon, label string, value int) string {
 	return fmt.Sprintf("%s%s%s %3d", lab, fStr, eStr, value)
 }
 
-// getLocalizedEndingMessage returns the localized ending message.
-// Priority: 1) Plugin locale, 2) Core i18n, 3) Plugin-provided message
+// getLocalizedEndingMessage returns the localized ending message, see
+// localizedEnding. A pet that died without an ending has passed away.
 func (h HomeModel) getLocalizedEndingMessage() string {
-	if h.pet.EndingType == "" {
-		return ""
-	}
-
-	// 1. Try plugin locale first (if plugin provided custom ending)
-	if msg := h.registry.GetEndingMessage(h.pet.Species, h.pet.EndingType); msg != "" {
-		return msg
-	}
-
-	// 2. Try core i18n (for default endings: peaceful_rest, blissful_passing, heroic_tale)
-	if msg := h.i18n.T("game.endings." + h.pet.EndingType); msg != "" {
-		// Check if i18n returned the key itself (meaning not found)
-		if !strings.HasPrefix(msg, "game.endings.") {
-			return msg
-		}
-	}
-
-	// 3. Fallback to plugin-provided message (already localized by plugin)
-	if h.pet.EndingMessage != "" {
-		return h.pet.EndingMessage
+	if h.pet.EndingType == "" && h.pet.EndingMessage == "" {
+		return h.i18n.T("ui.home.passed_away", "name", h.pet.Name)
 	}
-
-	// 4. Ultimate fallback
-	return h.i18n.T("game.endings.peaceful_rest")
+	return localizedEnding(h.registry, h.i18n, h.pet.Species, h.pet.EndingType, h.pet.EndingMessage)
 }
 
-// hasEnding returns true if the pet has an ending (either type or message).
+// hasEnding returns true if the pet has passed away, with or without an
+// ending.
 func (h HomeModel) hasEnding() bool {
-	return !h.pet.Alive && (h.pet.EndingType != "" || h.pet.EndingMessage != "")
+	return !h.pet.Alive
 }
 
 // renderMessageArea renders the action feedback area.
@@ -971,8 +1365,8 @@ func (h HomeModel) renderMessageArea(width int) string {
 		innerW = 10
 	}
 
-	// Don't show messages if pet has died (ending is shown in title bar)
-	if h.hasEnding() {
+	// Don't show action feedback if pet has died (ending is shown in title bar)
+	if h.hasEnding() && h.message == "" {
 		return ""
 	}
 
@@ -1030,6 +1424,18 @@ func (h HomeModel) renderMessageArea(width int) string {
 		Render(h.i18n.T("ui.home.waiting"))
 }
 
+// renderToast renders the oldest achievement toast, if any.
+func (h HomeModel) renderToast(width int) string {
+	if len(h.toasts) == 0 {
+		return ""
+	}
+	return h.theme.MessageBox.Width(max(width-6, 10)).
+		BorderForeground(lipgloss.Color("#D4A017")).
+		Foreground(lipgloss.Color("#FFD700")).
+		Bold(true).
+		Render("🏆 " + h.toasts[0])
+}
+
 // renderActionMenu renders the two-level category tabs + sub-action menu.
 func (h HomeModel) renderActionMenu(totalWidth int) string {
 	translatedCats := h.getTranslatedCategories()
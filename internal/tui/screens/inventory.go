@@ -0,0 +1,160 @@
+package screens
+
+import (
+	"clipet/internal/game"
+	"clipet/internal/i18n"
+	"clipet/internal/plugin"
+	"clipet/internal/tui/keys"
+	"clipet/internal/tui/styles"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/help"
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+)
+
+// itemIcons maps item kinds to inventory icons.
+var itemIcons = map[string]string{
+	plugin.ItemFood:      "🍖",
+	plugin.ItemToy:       "🧶",
+	plugin.ItemMedicine:  "💊",
+	plugin.ItemAccessory: "🎀",
+}
+
+// inventoryEntry is one row of the inventory list.
+type inventoryEntry struct {
+	item  plugin.Item
+	count int
+	worn  bool // equipped accessory
+}
+
+// InventoryModel lists the items the pet carries and lets the player pick
+// one to use. The item is used by the home screen once this screen is done;
+// picking an accessory puts it on or takes it off.
+type InventoryModel struct {
+	entries []inventoryEntry
+	theme   styles.Theme
+	i18n    *i18n.Manager
+	keyMap  keys.InventoryKeyMap
+	help    help.Model
+
+	cursor   int
+	selected string // item ID chosen with Use; empty when leaving with Back
+	width    int
+	height   int
+	done     bool
+}
+
+// NewInventoryModel creates an inventory screen for the pet's items.
+func NewInventoryModel(pet *game.Pet, reg *plugin.Registry, theme styles.Theme, i18nMgr *i18n.Manager) InventoryModel {
+	var entries []inventoryEntry
+	for _, id := range pet.InventoryIDs() {
+		item := plugin.Item{ID: id, Name: id}
+		if def := reg.GetItem(pet.Species, id); def != nil {
+			item = *def
+		}
+		entries = append(entries, inventoryEntry{item: item, count: pet.ItemCount(id), worn: pet.IsEquipped(id)})
+	}
+	return InventoryModel{
+		entries: entries,
+		theme:   theme,
+		i18n:    i18nMgr,
+		keyMap:  keys.NewInventoryKeyMap(i18nMgr),
+		help:    help.New(),
+	}
+}
+
+// SetSize updates terminal dimensions.
+func (m InventoryModel) SetSize(w, h int) InventoryModel {
+	m.width = w
+	m.height = h
+	return m
+}
+
+// IsDone returns true when the user leaves the inventory.
+func (m InventoryModel) IsDone() bool {
+	return m.done
+}
+
+// Selected returns the ID of the item to use, or "" if none was chosen.
+func (m InventoryModel) Selected() string {
+	return m.selected
+}
+
+// Update handles key input.
+func (m InventoryModel) Update(msg tea.Msg) (InventoryModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyPressMsg:
+		switch {
+		case key.Matches(msg, m.keyMap.Global.ToggleHelp):
+			m.help.ShowAll = !m.help.ShowAll
+		case key.Matches(msg, m.keyMap.Back):
+			m.done = true
+		case key.Matches(msg, m.keyMap.Up):
+			m.cursor = clamp(m.cursor-1, 0, max(len(m.entries)-1, 0))
+		case key.Matches(msg, m.keyMap.Down):
+			m.cursor = clamp(m.cursor+1, 0, max(len(m.entries)-1, 0))
+		case key.Matches(msg, m.keyMap.Use):
+			if len(m.entries) > 0 {
+				m.selected = m.entries[m.cursor].item.ID
+				m.done = true
+			}
+		}
+	}
+	return m, nil
+}
+
+// View renders the inventory.
+func (m InventoryModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(titleStyle.Render(m.i18n.T("ui.inventory.title")) + "\n\n")
+
+	if len(m.entries) == 0 {
+		b.WriteString("  " + textStyle.Render(m.i18n.T("ui.inventory.empty")) + "\n")
+	}
+	for i, e := range m.entries {
+		line := fmt.Sprintf("%s %s ×%d  %s", itemIcons[e.item.Kind], e.item.Name, e.count,
+			m.i18n.T("ui.inventory.kinds."+e.item.Kind))
+		if e.worn {
+			line += "  " + m.i18n.T("ui.inventory.worn")
+		}
+		if i == m.cursor {
+			b.WriteString("  " + successStyle.Render("▶ "+line) + "\n")
+		} else {
+			b.WriteString("    " + textStyle.Render(line) + "\n")
+		}
+	}
+
+	if len(m.entries) > 0 {
+		e := m.entries[m.cursor]
+		b.WriteString("\n")
+		if e.item.Description != "" {
+			b.WriteString("  " + mutedStyle.Render(e.item.Description) + "\n")
+		}
+		if len(e.item.Effects) > 0 {
+			b.WriteString("  " + mutedStyle.Render(formatItemEffects(m.i18n, e.item.Effects)) + "\n")
+		}
+	}
+
+	b.WriteString("\n")
+	b.WriteString(m.theme.HelpBar.Render(m.help.View(m.keyMap)) + "\n")
+
+	return b.String()
+}
+
+// formatItemEffects renders item effects as "Name +n" pairs, sorted by attribute.
+func formatItemEffects(i18nMgr *i18n.Manager, effects map[string]int) string {
+	parts := make([]string, 0, len(effects))
+	for _, attr := range slices.Sorted(maps.Keys(effects)) {
+		name := attr
+		if isCoreStat(attr) {
+			name = i18nMgr.T("game.stats." + attr)
+		}
+		parts = append(parts, fmt.Sprintf("%s %+d", name, effects[attr]))
+	}
+	return strings.Join(parts, "  ")
+}
@@ -0,0 +1,179 @@
+package screens
+
+import (
+	"clipet/internal/i18n"
+	"clipet/internal/plugin"
+	"clipet/internal/store"
+	"clipet/internal/tui/keys"
+	"clipet/internal/tui/styles"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/help"
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+)
+
+// MemorialModel is the memorial hall: the pets of the profile that have
+// passed away, newest first, with the details of the selected one.
+type MemorialModel struct {
+	entries  []store.MemorialEntry // newest first
+	registry *plugin.Registry
+	theme    styles.Theme
+	i18n     *i18n.Manager
+	keyMap   keys.MemorialKeyMap
+	help     help.Model
+
+	cursor int
+	width  int
+	height int
+	done   bool
+}
+
+// NewMemorialModel creates a memorial hall screen for entries given
+// oldest-first.
+func NewMemorialModel(entries []store.MemorialEntry, reg *plugin.Registry, theme styles.Theme, i18nMgr *i18n.Manager) MemorialModel {
+	newest := make([]store.MemorialEntry, len(entries))
+	for i, e := range entries {
+		newest[len(entries)-1-i] = e
+	}
+	return MemorialModel{
+		entries:  newest,
+		registry: reg,
+		theme:    theme,
+		i18n:     i18nMgr,
+		keyMap:   keys.NewMemorialKeyMap(i18nMgr),
+		help:     help.New(),
+	}
+}
+
+// SetSize updates terminal dimensions.
+func (m MemorialModel) SetSize(w, h int) MemorialModel {
+	m.width = w
+	m.height = h
+	return m
+}
+
+// IsDone returns true when the user leaves the memorial hall.
+func (m MemorialModel) IsDone() bool {
+	return m.done
+}
+
+// Update handles key input.
+func (m MemorialModel) Update(msg tea.Msg) (MemorialModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyPressMsg:
+		switch {
+		case key.Matches(msg, m.keyMap.Global.ToggleHelp):
+			m.help.ShowAll = !m.help.ShowAll
+		case key.Matches(msg, m.keyMap.Back):
+			m.done = true
+		case key.Matches(msg, m.keyMap.Up):
+			m.cursor = clamp(m.cursor-1, 0, max(len(m.entries)-1, 0))
+		case key.Matches(msg, m.keyMap.Down):
+			m.cursor = clamp(m.cursor+1, 0, max(len(m.entries)-1, 0))
+		}
+	}
+	return m, nil
+}
+
+// View renders the memorial hall.
+func (m MemorialModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(titleStyle.Render(m.i18n.T("ui.memorial.title")) + "\n\n")
+
+	if len(m.entries) == 0 {
+		b.WriteString("  " + textStyle.Render(m.i18n.T("ui.memorial.empty")) + "\n")
+	}
+
+	// Keep the selected entry in view, leaving room for its details
+	visible := max(m.height-16, 3)
+	start := clamp(m.cursor-visible+1, 0, max(len(m.entries)-visible, 0))
+	end := min(start+visible, len(m.entries))
+	for i, e := range m.entries[start:end] {
+		line := fmt.Sprintf("%s  %s · %s",
+			e.Name,
+			m.i18n.T("game.stats.generation", "generation", e.Generation),
+			m.stageName(e))
+		lifespan := mutedStyle.Render(m.lifespan(e))
+		if start+i == m.cursor {
+			b.WriteString("  " + successStyle.Render("▶ "+line) + "  " + lifespan + "\n")
+		} else {
+			b.WriteString("    " + textStyle.Render(line) + "  " + lifespan + "\n")
+		}
+	}
+
+	if len(m.entries) > 0 {
+		b.WriteString("\n" + m.details(m.entries[m.cursor]))
+	}
+
+	b.WriteString("\n")
+	b.WriteString(m.theme.HelpBar.Render(m.help.View(m.keyMap)) + "\n")
+
+	return b.String()
+}
+
+// details renders the selected entry: dates, ending and key stats.
+func (m MemorialModel) details(e store.MemorialEntry) string {
+	var b strings.Builder
+	species := e.Species
+	if sp := m.registry.GetSpecies(e.Species); sp != nil {
+		species = sp.Species.Name
+	}
+	b.WriteString("  " + textStyle.Render(fmt.Sprintf("🕯 %s · %s · %s – %s",
+		e.Name, species, e.Born.Local().Format("2006-01-02"), e.Died.Local().Format("2006-01-02"))) + "\n")
+	b.WriteString("  " + dangerStyle.Render(localizedEnding(m.registry, m.i18n, e.Species, e.EndingType, e.EndingMessage)) + "\n")
+	b.WriteString("  " + textStyle.Render(fmt.Sprintf("🍖 %d  😺 %d  💊 %d  💤 %d",
+		e.Stats["hunger"], e.Stats["happiness"], e.Stats["health"], e.Stats["energy"])) + "\n")
+	b.WriteString("  " + mutedStyle.Render(m.i18n.T("ui.memorial.stats",
+		"interactions", e.Stats["interactions"], "adventures", e.Stats["adventures"],
+		"games_won", e.Stats["games_won"], "dialogues", e.Stats["dialogues"])) + "\n")
+	if len(e.Traits) > 0 {
+		names := make([]string, len(e.Traits))
+		for i, id := range e.Traits {
+			names[i] = m.registry.GetTraitName(e.Species, id)
+		}
+		b.WriteString("  " + mutedStyle.Render(m.i18n.T("game.stats.traits")+" "+strings.Join(names, ", ")) + "\n")
+	}
+	if e.Successor != "" {
+		b.WriteString("  " + successStyle.Render(m.i18n.T("ui.memorial.successor", "name", e.Successor)) + "\n")
+	}
+	return b.String()
+}
+
+// stageName returns the display name of the entry's final stage.
+func (m MemorialModel) stageName(e store.MemorialEntry) string {
+	if stage := m.registry.GetStage(e.Species, e.StageID); stage != nil {
+		return stage.Name
+	}
+	return e.StageID
+}
+
+// lifespan formats how long the entry's pet lived.
+func (m MemorialModel) lifespan(e store.MemorialEntry) string {
+	hours := int(e.Lifespan().Hours())
+	return m.i18n.T("ui.memorial.lifespan", "days", hours/24, "hours", hours%24)
+}
+
+// localizedEnding returns the localized text of an ending.
+// Priority: 1) Plugin locale, 2) Core i18n, 3) Plugin-provided message.
+// Pets that died without an ending simply passed away.
+func localizedEnding(reg *plugin.Registry, i18nMgr *i18n.Manager, species, endingType, message string) string {
+	if endingType == "" {
+		if message != "" {
+			return message
+		}
+		return i18nMgr.T("ui.memorial.no_ending")
+	}
+	if msg := reg.GetEndingMessage(species, endingType); msg != "" {
+		return msg
+	}
+	if msg := i18nMgr.T("game.endings." + endingType); !strings.HasPrefix(msg, "game.endings.") {
+		return msg
+	}
+	if message != "" {
+		return message
+	}
+	return i18nMgr.T("game.endings.peaceful_rest")
+}
@@ -8,19 +8,22 @@ import (
 	"fmt"
 	"strings"
 
-	tea "charm.land/bubbletea/v2"
 	"charm.land/bubbles/v2/help"
 	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 )
 
 // OfflineSettlementModel is the offline settlement report screen.
 type OfflineSettlementModel struct {
 	results []game.DecayRoundResult
+	crises  []game.CrisisEvent
+	petName string             // shown when the household has several pets
+	neglect game.NeglectResult // happiness lost over neglected housemates
 	theme   styles.Theme
 	i18n    *i18n.Manager
-	keyMap keys.OfflineSettlementKeyMap
-	help   help.Model
+	keyMap  keys.OfflineSettlementKeyMap
+	help    help.Model
 
 	scrollOffset int // Current scroll position
 	maxVisible   int // Max visible lines (calculated from height)
@@ -55,9 +58,10 @@ var (
 )
 
 // NewOfflineSettlementModel creates a new offline settlement screen.
-func NewOfflineSettlementModel(results []game.DecayRoundResult, theme styles.Theme, i18nMgr *i18n.Manager) OfflineSettlementModel {
+func NewOfflineSettlementModel(results []game.DecayRoundResult, crises []game.CrisisEvent, theme styles.Theme, i18nMgr *i18n.Manager) OfflineSettlementModel {
 	return OfflineSettlementModel{
 		results: results,
+		crises:  crises,
 		theme:   theme,
 		i18n:    i18nMgr,
 		keyMap:  keys.NewOfflineSettlementKeyMap(i18nMgr),
@@ -65,6 +69,14 @@ func NewOfflineSettlementModel(results []game.DecayRoundResult, theme styles.The
 	}
 }
 
+// WithPet names the pet the report is about and adds the happiness it lost
+// over neglected housemates.
+func (m OfflineSettlementModel) WithPet(name string, neglect game.NeglectResult) OfflineSettlementModel {
+	m.petName = name
+	m.neglect = neglect
+	return m
+}
+
 // SetSize updates terminal dimensions.
 func (m OfflineSettlementModel) SetSize(w, h int) OfflineSettlementModel {
 	m.width = w
@@ -108,7 +120,7 @@ func (m OfflineSettlementModel) Update(msg tea.Msg) (OfflineSettlementModel, tea
 
 // View renders the offline settlement report.
 func (m OfflineSettlementModel) View() string {
-	if len(m.results) == 0 {
+	if len(m.results) == 0 && len(m.crises) == 0 && len(m.neglect.Housemates) == 0 {
 		return m.i18n.T("ui.offline_settlement.no_data")
 	}
 
@@ -117,6 +129,9 @@ func (m OfflineSettlementModel) View() string {
 	// Header
 	title := titleStyle.Render(m.i18n.T("ui.offline_settlement.title"))
 	b.WriteString(title + "\n\n")
+	if m.petName != "" {
+		b.WriteString("  " + textStyle.Render(m.i18n.T("ui.offline_settlement.pet", "name", m.petName)) + "\n\n")
+	}
 
 	// Summary line
 	criticalCount := 0
@@ -172,6 +187,27 @@ func (m OfflineSettlementModel) View() string {
 		}
 	}
 
+	// Crises that started or failed while away
+	if len(m.crises) > 0 {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, "  "+textStyle.Render(m.i18n.T("ui.offline_settlement.crises_title")))
+		for _, c := range m.crises {
+			lines = append(lines, "    "+m.crisisLine(c))
+		}
+	}
+
+	// Sadness over neglected housemates
+	if len(m.neglect.Housemates) > 0 {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, "  "+warningStyle.Render(m.i18n.T("ui.offline_settlement.neglect",
+			"names", strings.Join(m.neglect.Housemates, ", "),
+			"before", m.neglect.Happiness[0], "after", m.neglect.Happiness[1])))
+	}
+
 	// Apply scrolling
 	totalLines := len(lines)
 	startIdx := m.scrollOffset
@@ -212,6 +248,25 @@ func (m OfflineSettlementModel) View() string {
 	return b.String()
 }
 
+// crisisLine renders one crisis event of the report.
+func (m OfflineSettlementModel) crisisLine(c game.CrisisEvent) string {
+	at := c.At.Local().Format("01-02 15:04")
+	if c.Phase == game.CrisisFailed {
+		return dangerStyle.Render(m.i18n.T("ui.offline_settlement.crisis_failed",
+			"time", at, "name", c.Name, "text", c.Text))
+	}
+	actions := make([]string, len(c.Resolve))
+	for i, action := range c.Resolve {
+		actions[i] = action
+		if !strings.HasPrefix(action, "skill:") {
+			actions[i] = m.i18n.T("ui.home.actions." + action)
+		}
+	}
+	return warningStyle.Render(m.i18n.T("ui.offline_settlement.crisis_started",
+		"time", at, "name", c.Name, "actions", strings.Join(actions, "/"),
+		"deadline", c.Deadline.Local().Format("01-02 15:04")))
+}
+
 // maxScroll returns the maximum scroll offset.
 func (m OfflineSettlementModel) maxScroll() int {
 	// Count total lines (approximate)
@@ -224,6 +279,12 @@ func (m OfflineSettlementModel) maxScroll() int {
 			totalLines += 1 // Blank line
 		}
 	}
+	if len(m.crises) > 0 {
+		totalLines += 2 + len(m.crises) // Blank line, title, one line per crisis
+	}
+	if len(m.neglect.Housemates) > 0 {
+		totalLines += 2 // Blank line, neglect line
+	}
 
 	maxScroll := totalLines - m.maxVisible
 	if maxScroll < 0 {
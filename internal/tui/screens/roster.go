@@ -0,0 +1,144 @@
+package screens
+
+import (
+	"clipet/internal/game"
+	"clipet/internal/i18n"
+	"clipet/internal/plugin"
+	"clipet/internal/tui/keys"
+	"clipet/internal/tui/styles"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/help"
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+)
+
+// RosterModel lists the pets of the household and lets the player switch
+// focus to another pet or let the focused pet play with one. Both are
+// carried out by the app once this screen is done.
+type RosterModel struct {
+	pets     []*game.Pet
+	focus    int // index of the focused pet
+	registry *plugin.Registry
+	theme    styles.Theme
+	i18n     *i18n.Manager
+	keyMap   keys.RosterKeyMap
+	help     help.Model
+
+	cursor   int
+	focusTo  int // pet to focus, or -1
+	playWith int // pet to play with, or -1
+	message  string
+	width    int
+	height   int
+	done     bool
+}
+
+// NewRosterModel creates a roster screen with the cursor on the focused pet.
+func NewRosterModel(pets []*game.Pet, focus int, reg *plugin.Registry, theme styles.Theme, i18nMgr *i18n.Manager) RosterModel {
+	return RosterModel{
+		pets:     pets,
+		focus:    focus,
+		registry: reg,
+		theme:    theme,
+		i18n:     i18nMgr,
+		keyMap:   keys.NewRosterKeyMap(i18nMgr),
+		help:     help.New(),
+		cursor:   focus,
+		focusTo:  -1,
+		playWith: -1,
+	}
+}
+
+// SetSize updates terminal dimensions.
+func (m RosterModel) SetSize(w, h int) RosterModel {
+	m.width = w
+	m.height = h
+	return m
+}
+
+// IsDone returns true when the user leaves the roster.
+func (m RosterModel) IsDone() bool {
+	return m.done
+}
+
+// FocusTo returns the index of the pet to focus, or -1 if none was chosen.
+func (m RosterModel) FocusTo() int {
+	return m.focusTo
+}
+
+// PlayWith returns the index of the pet the focused pet should play with,
+// or -1 if none was chosen.
+func (m RosterModel) PlayWith() int {
+	return m.playWith
+}
+
+// Update handles key input.
+func (m RosterModel) Update(msg tea.Msg) (RosterModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyPressMsg:
+		m.message = ""
+		switch {
+		case key.Matches(msg, m.keyMap.Global.ToggleHelp):
+			m.help.ShowAll = !m.help.ShowAll
+		case key.Matches(msg, m.keyMap.Back):
+			m.done = true
+		case key.Matches(msg, m.keyMap.Up):
+			m.cursor = clamp(m.cursor-1, 0, max(len(m.pets)-1, 0))
+		case key.Matches(msg, m.keyMap.Down):
+			m.cursor = clamp(m.cursor+1, 0, max(len(m.pets)-1, 0))
+		case key.Matches(msg, m.keyMap.Focus):
+			if m.cursor != m.focus {
+				m.focusTo = m.cursor
+			}
+			m.done = true
+		case key.Matches(msg, m.keyMap.Play):
+			if m.cursor == m.focus {
+				m.message = m.i18n.T("ui.roster.play_self")
+				break
+			}
+			m.playWith = m.cursor
+			m.done = true
+		}
+	}
+	return m, nil
+}
+
+// View renders the roster.
+func (m RosterModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(titleStyle.Render(m.i18n.T("ui.roster.title")) + "  " +
+		mutedStyle.Render(m.i18n.T("ui.roster.count", "count", len(m.pets))) + "\n\n")
+
+	for i, pet := range m.pets {
+		stageName := pet.StageID
+		if stage := m.registry.GetStage(pet.Species, pet.StageID); stage != nil {
+			stageName = stage.Name
+		}
+		mood, moodStyle := moodDisplay(pet.MoodName(), m.theme, m.i18n)
+		if !pet.Alive {
+			mood, moodStyle = m.i18n.T("ui.roster.deceased"), mutedStyle
+		}
+
+		marker := "  "
+		if i == m.focus {
+			marker = "★ "
+		}
+		line := fmt.Sprintf("%s%s  %s · %s", marker, pet.Name, pet.Species, stageName)
+		if i == m.cursor {
+			b.WriteString("  " + successStyle.Render("▶ "+line) + "  " + moodStyle.Render(mood) + "\n")
+		} else {
+			b.WriteString("    " + textStyle.Render(line) + "  " + moodStyle.Render(mood) + "\n")
+		}
+	}
+
+	b.WriteString("\n")
+	if m.message != "" {
+		b.WriteString("  " + warningStyle.Render(m.message) + "\n\n")
+	}
+	b.WriteString(m.theme.HelpBar.Render(m.help.View(m.keyMap)) + "\n")
+
+	return b.String()
+}
@@ -0,0 +1,134 @@
+package screens
+
+import (
+	"clipet/internal/game"
+	"clipet/internal/i18n"
+	"clipet/internal/plugin"
+	"clipet/internal/tui/keys"
+	"clipet/internal/tui/styles"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/help"
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+)
+
+// ShopModel lists the items of the pet's species that are sold for coins
+// and lets the player pick one to buy. The purchase is made by the home
+// screen once this screen is done.
+type ShopModel struct {
+	items  []plugin.Item
+	owned  map[string]int
+	coins  int
+	theme  styles.Theme
+	i18n   *i18n.Manager
+	keyMap keys.ShopKeyMap
+	help   help.Model
+
+	cursor   int
+	selected string // item ID chosen with Buy; empty when leaving with Back
+	width    int
+	height   int
+	done     bool
+}
+
+// NewShopModel creates a shop screen for the pet's species.
+func NewShopModel(pet *game.Pet, theme styles.Theme, i18nMgr *i18n.Manager) ShopModel {
+	items := pet.ShopItems()
+	owned := make(map[string]int, len(items))
+	for _, item := range items {
+		owned[item.ID] = pet.ItemCount(item.ID)
+	}
+	return ShopModel{
+		items:  items,
+		owned:  owned,
+		coins:  pet.Coins,
+		theme:  theme,
+		i18n:   i18nMgr,
+		keyMap: keys.NewShopKeyMap(i18nMgr),
+		help:   help.New(),
+	}
+}
+
+// SetSize updates terminal dimensions.
+func (m ShopModel) SetSize(w, h int) ShopModel {
+	m.width = w
+	m.height = h
+	return m
+}
+
+// IsDone returns true when the user leaves the shop.
+func (m ShopModel) IsDone() bool {
+	return m.done
+}
+
+// Selected returns the ID of the item to buy, or "" if none was chosen.
+func (m ShopModel) Selected() string {
+	return m.selected
+}
+
+// Update handles key input.
+func (m ShopModel) Update(msg tea.Msg) (ShopModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyPressMsg:
+		switch {
+		case key.Matches(msg, m.keyMap.Global.ToggleHelp):
+			m.help.ShowAll = !m.help.ShowAll
+		case key.Matches(msg, m.keyMap.Back):
+			m.done = true
+		case key.Matches(msg, m.keyMap.Up):
+			m.cursor = clamp(m.cursor-1, 0, max(len(m.items)-1, 0))
+		case key.Matches(msg, m.keyMap.Down):
+			m.cursor = clamp(m.cursor+1, 0, max(len(m.items)-1, 0))
+		case key.Matches(msg, m.keyMap.Buy):
+			if len(m.items) > 0 {
+				m.selected = m.items[m.cursor].ID
+				m.done = true
+			}
+		}
+	}
+	return m, nil
+}
+
+// View renders the shop.
+func (m ShopModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(titleStyle.Render(m.i18n.T("ui.shop.title")) + "  " +
+		mutedStyle.Render(m.i18n.T("ui.shop.balance", "coins", m.coins)) + "\n\n")
+
+	if len(m.items) == 0 {
+		b.WriteString("  " + textStyle.Render(m.i18n.T("ui.shop.empty")) + "\n")
+	}
+	for i, item := range m.items {
+		line := fmt.Sprintf("%s %s  🪙 %d", itemIcons[item.Kind], item.Name, item.Price)
+		if n := m.owned[item.ID]; n > 0 {
+			line += "  " + m.i18n.T("ui.shop.owned", "count", n)
+		}
+		switch {
+		case i == m.cursor:
+			b.WriteString("  " + successStyle.Render("▶ "+line) + "\n")
+		case item.Price > m.coins:
+			b.WriteString("    " + mutedStyle.Render(line) + "\n")
+		default:
+			b.WriteString("    " + textStyle.Render(line) + "\n")
+		}
+	}
+
+	if len(m.items) > 0 {
+		item := m.items[m.cursor]
+		b.WriteString("\n")
+		if item.Description != "" {
+			b.WriteString("  " + mutedStyle.Render(item.Description) + "\n")
+		}
+		if len(item.Effects) > 0 {
+			b.WriteString("  " + mutedStyle.Render(formatItemEffects(m.i18n, item.Effects)) + "\n")
+		}
+	}
+
+	b.WriteString("\n")
+	b.WriteString(m.theme.HelpBar.Render(m.help.View(m.keyMap)) + "\n")
+
+	return b.String()
+}